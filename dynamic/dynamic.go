@@ -0,0 +1,866 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package dynamic provides protocol buffer messages whose type is described at
+run time by a DescriptorProto instead of by generated code.
+
+A *Message satisfies proto.Message and can be used with proto.Marshal,
+proto.Unmarshal, proto.MarshalText, proto.UnmarshalText, proto.Equal,
+proto.Merge, proto.Clone, proto.CheckInitialized and the jsonpb package:
+
+	types, err := dynamic.NewTypes(fds.File...)
+	if err != nil {
+		return err
+	}
+	m, err := types.New("example.Person")
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return err
+	}
+	fmt.Println(m.Get("name"))
+
+Field values are held as the Go types generated code would use for a
+single element: int32, int64, uint32, uint64, float32, float64, bool,
+string and []byte for scalars, int32 for enums, and proto.Message for
+messages and groups. Repeated fields hold a []interface{} of those, and map
+fields a map[interface{}]interface{}. Message fields whose type is not in
+the descriptors but is registered by generated code hold values of the
+generated type.
+
+Extensions are not interpreted; they are kept with the unknown fields.
+*/
+package dynamic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Types is a set of message types built from file descriptors.
+type Types struct {
+	msgs  map[string]*messageType // keyed by full name, without a leading dot
+	enums map[string]*enumType
+
+	// loose makes type references also match by a trailing part of their
+	// name; see NewMessage.
+	loose bool
+}
+
+// messageType describes a message type.
+type messageType struct {
+	name     string
+	desc     *descriptor.DescriptorProto
+	proto3   bool
+	fields   []*field // in declaration order
+	byNumber map[int32]*field
+	byName   map[string]*field // by .proto name, and by type name for groups
+	byJSON   map[string]*field
+	ordered  []*field // by field number
+}
+
+// field describes one field of a message type.
+type field struct {
+	desc     *descriptor.FieldDescriptorProto
+	number   int32
+	name     string // as in the .proto file; the type name for groups
+	jsonName string
+	kind     descriptor.FieldDescriptorProto_Type
+	repeated bool
+	required bool
+	packed   bool
+	proto3   bool
+	oneof    int32 // index of the containing oneof, or -1
+
+	msg    *messageType // dynamic message, group or map entry type
+	goType reflect.Type // generated message type, a pointer
+	enum   *enumType
+
+	key, val *field // for map fields
+	def      interface{}
+}
+
+// enumType describes an enum type.
+type enumType struct {
+	name   string
+	names  map[int32]string
+	values map[string]int32
+}
+
+// NewTypes builds the message and enum types declared in files. Type
+// references that the files do not resolve must name types registered by
+// generated code.
+func NewTypes(files ...*descriptor.FileDescriptorProto) (*Types, error) {
+	t := &Types{
+		msgs:  make(map[string]*messageType),
+		enums: make(map[string]*enumType),
+	}
+	for _, fd := range files {
+		prefix := fd.GetPackage()
+		if prefix != "" {
+			prefix += "."
+		}
+		proto3 := fd.GetSyntax() == "proto3"
+		for _, md := range fd.MessageType {
+			t.addMessage(prefix, md, proto3)
+		}
+		for _, ed := range fd.EnumType {
+			t.addEnum(prefix, ed)
+		}
+	}
+	if err := t.build(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NewMessage returns an empty message of the type described by md, which is
+// treated as a proto2 message. References to md and to its nested types may
+// use any package name; other references must name types registered by
+// generated code. Use NewTypes for proto3 messages or for messages that
+// refer to types declared elsewhere.
+func NewMessage(md *descriptor.DescriptorProto) (*Message, error) {
+	t := &Types{
+		msgs:  make(map[string]*messageType),
+		enums: make(map[string]*enumType),
+		loose: true,
+	}
+	t.addMessage("", md, false)
+	if err := t.build(); err != nil {
+		return nil, err
+	}
+	return t.New(md.GetName())
+}
+
+// New returns an empty message of the named type, such as "pkg.Message".
+func (t *Types) New(name string) (*Message, error) {
+	mt, ok := t.msgs[strings.TrimPrefix(name, ".")]
+	if !ok {
+		return nil, fmt.Errorf("dynamic: unknown message type %q", name)
+	}
+	return &Message{typ: mt}, nil
+}
+
+func (t *Types) addMessage(prefix string, md *descriptor.DescriptorProto, proto3 bool) {
+	name := prefix + md.GetName()
+	t.msgs[name] = &messageType{name: name, desc: md, proto3: proto3}
+	for _, nd := range md.NestedType {
+		t.addMessage(name+".", nd, proto3)
+	}
+	for _, ed := range md.EnumType {
+		t.addEnum(name+".", ed)
+	}
+}
+
+func (t *Types) addEnum(prefix string, ed *descriptor.EnumDescriptorProto) {
+	et := &enumType{
+		name:   prefix + ed.GetName(),
+		names:  make(map[int32]string),
+		values: make(map[string]int32),
+	}
+	for _, vd := range ed.Value {
+		if _, ok := et.names[vd.GetNumber()]; !ok {
+			et.names[vd.GetNumber()] = vd.GetName()
+		}
+		et.values[vd.GetName()] = vd.GetNumber()
+	}
+	t.enums[et.name] = et
+}
+
+// build fills in the fields of every message type.
+func (t *Types) build() error {
+	for _, mt := range t.msgs {
+		mt.byNumber = make(map[int32]*field)
+		mt.byName = make(map[string]*field)
+		mt.byJSON = make(map[string]*field)
+		for _, fd := range mt.desc.Field {
+			f, err := t.newField(mt, fd)
+			if err != nil {
+				return err
+			}
+			mt.fields = append(mt.fields, f)
+			mt.byNumber[f.number] = f
+			mt.byName[fd.GetName()] = f
+			mt.byName[f.name] = f
+			mt.byJSON[f.jsonName] = f
+		}
+		mt.ordered = append([]*field(nil), mt.fields...)
+		sort.Sort(byNumber(mt.ordered))
+	}
+	// Map entries are complete only once their own fields are built.
+	for _, mt := range t.msgs {
+		for _, f := range mt.fields {
+			if f.repeated && f.msg != nil && f.msg.desc.GetOptions().GetMapEntry() {
+				f.key, f.val = f.msg.byNumber[1], f.msg.byNumber[2]
+				if f.key == nil || f.val == nil {
+					return fmt.Errorf("dynamic: %s.%s: malformed map entry", mt.name, f.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (t *Types) newField(mt *messageType, fd *descriptor.FieldDescriptorProto) (*field, error) {
+	f := &field{
+		desc:     fd,
+		number:   fd.GetNumber(),
+		name:     fd.GetName(),
+		jsonName: fd.GetJsonName(),
+		kind:     fd.GetType(),
+		repeated: fd.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED,
+		required: fd.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED,
+		proto3:   mt.proto3,
+		oneof:    -1,
+	}
+	if f.jsonName == "" {
+		f.jsonName = f.name
+	}
+	if fd.OneofIndex != nil {
+		f.oneof = fd.GetOneofIndex()
+	}
+	if f.repeated && f.packable() {
+		if opts := fd.GetOptions(); opts != nil && opts.Packed != nil {
+			f.packed = opts.GetPacked()
+		} else {
+			f.packed = mt.proto3
+		}
+	}
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		name := strings.TrimPrefix(fd.GetTypeName(), ".")
+		if f.msg = t.lookupMessage(name); f.msg == nil {
+			if f.goType = proto.MessageType(name); f.goType == nil {
+				return nil, fmt.Errorf("dynamic: %s.%s: unknown message type %q", mt.name, f.name, name)
+			}
+		}
+		if f.kind == descriptor.FieldDescriptorProto_TYPE_GROUP {
+			// The text format names groups by their type.
+			f.name = name[strings.LastIndex(name, ".")+1:]
+		}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		name := strings.TrimPrefix(fd.GetTypeName(), ".")
+		if f.enum = t.lookupEnum(name); f.enum == nil {
+			return nil, fmt.Errorf("dynamic: %s.%s: unknown enum type %q", mt.name, f.name, name)
+		}
+	}
+	if fd.DefaultValue != nil {
+		def, err := f.parseDefault(fd.GetDefaultValue())
+		if err != nil {
+			return nil, fmt.Errorf("dynamic: %s.%s: bad default %q: %v", mt.name, f.name, fd.GetDefaultValue(), err)
+		}
+		f.def = def
+	}
+	return f, nil
+}
+
+func (t *Types) lookupMessage(name string) *messageType {
+	if mt, ok := t.msgs[name]; ok {
+		return mt
+	}
+	if t.loose && proto.MessageType(name) == nil {
+		for i := strings.Index(name, "."); i >= 0; i = strings.Index(name, ".") {
+			name = name[i+1:]
+			if mt, ok := t.msgs[name]; ok {
+				return mt
+			}
+		}
+	}
+	return nil
+}
+
+func (t *Types) lookupEnum(name string) *enumType {
+	if et, ok := t.enums[name]; ok {
+		return et
+	}
+	if m := proto.EnumValueMap(name); m != nil {
+		et := &enumType{name: name, names: make(map[int32]string), values: m}
+		for n, v := range m {
+			if old, ok := et.names[v]; !ok || n < old {
+				et.names[v] = n
+			}
+		}
+		t.enums[name] = et
+		return et
+	}
+	if t.loose {
+		for i := strings.Index(name, "."); i >= 0; i = strings.Index(name, ".") {
+			name = name[i+1:]
+			if et, ok := t.enums[name]; ok {
+				return et
+			}
+		}
+	}
+	return nil
+}
+
+// byNumber sorts fields by field number.
+type byNumber []*field
+
+func (s byNumber) Len() int           { return len(s) }
+func (s byNumber) Less(i, j int) bool { return s[i].number < s[j].number }
+func (s byNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// packable reports whether repeated values of f's kind may be packed.
+func (f *field) packable() bool {
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+	return true
+}
+
+func (f *field) isMap() bool     { return f.key != nil }
+func (f *field) isMessage() bool { return f.msg != nil || f.goType != nil }
+
+// newMessage returns an empty message of f's type.
+func (f *field) newMessage() proto.Message {
+	if f.msg != nil {
+		return &Message{typ: f.msg}
+	}
+	return reflect.New(f.goType.Elem()).Interface().(proto.Message)
+}
+
+// zero returns the value an unset singular field reports.
+func (f *field) zero() interface{} {
+	if f.def != nil {
+		return f.def
+	}
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return float64(0)
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return float32(0)
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return int64(0)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return uint64(0)
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return int32(0)
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(0)
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return false
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return ""
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return []byte(nil)
+	}
+	return nil
+}
+
+// parseDefault parses a default value as written in a FieldDescriptorProto.
+func (f *field) parseDefault(s string) (interface{}, error) {
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return s, nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		// The default is C-escaped, like a quoted text format string.
+		tok, err := proto.NewTextScanner(`"` + s + `"`).Scan()
+		return []byte(tok.Unquoted), err
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if v, ok := f.enum.values[s]; ok {
+			return v, nil
+		}
+		return nil, errors.New("unknown enum value")
+	}
+	return f.parseScalar(s)
+}
+
+// parseScalar parses the text form of a numeric or bool value.
+func (f *field) parseScalar(s string) (interface{}, error) {
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		bits := 64
+		if f.kind == descriptor.FieldDescriptorProto_TYPE_FLOAT {
+			bits = 32
+		}
+		var x float64
+		switch strings.ToLower(s) {
+		case "inf", "infinity", "+inf", "+infinity":
+			x = math.Inf(1)
+		case "-inf", "-infinity":
+			x = math.Inf(-1)
+		case "nan":
+			x = math.NaN()
+		default:
+			var err error
+			if x, err = strconv.ParseFloat(strings.TrimRight(s, "fF"), bits); err != nil {
+				return nil, err
+			}
+		}
+		if bits == 32 {
+			return float32(x), nil
+		}
+		return x, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return strconv.ParseInt(s, 0, 64)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return strconv.ParseUint(s, 0, 64)
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		x, err := strconv.ParseInt(s, 0, 32)
+		return int32(x), err
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		x, err := strconv.ParseUint(s, 0, 32)
+		return uint32(x), err
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		switch s {
+		case "true", "True", "t", "1":
+			return true, nil
+		case "false", "False", "f", "0":
+			return false, nil
+		}
+		return nil, errors.New("invalid bool")
+	}
+	return nil, fmt.Errorf("cannot parse a %v", f.kind)
+}
+
+// A Message is a protocol buffer message of a type described at run time.
+// The zero Message has no type; it can only be the destination of
+// proto.Merge, which gives it the type of the source.
+type Message struct {
+	typ     *messageType
+	values  map[int32]interface{}
+	unknown []byte
+}
+
+// TypeName returns the full name of m's type, such as "pkg.Message".
+func (m *Message) TypeName() string {
+	if m.typ == nil {
+		return ""
+	}
+	return m.typ.name
+}
+
+// MessageDescriptor returns the descriptor m was built from.
+func (m *Message) MessageDescriptor() *descriptor.DescriptorProto {
+	if m.typ == nil {
+		return nil
+	}
+	return m.typ.desc
+}
+
+// Reset clears all of m's fields, keeping its type.
+func (m *Message) Reset() {
+	m.values = nil
+	m.unknown = nil
+}
+
+// String returns m in the compact text format.
+func (m *Message) String() string {
+	var b bytes.Buffer
+	m.writeText(&textWriter{buf: &b, compact: true})
+	return b.String()
+}
+
+// ProtoMessage satisfies the proto.Message interface.
+func (*Message) ProtoMessage() {}
+
+func (m *Message) field(name string) (*field, error) {
+	if m.typ == nil {
+		return nil, errors.New("dynamic: message has no type")
+	}
+	f, ok := m.typ.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("dynamic: %s has no field %q", m.typ.name, name)
+	}
+	return f, nil
+}
+
+// get returns the value of f and whether it is set. Unlike the stored
+// value, an empty repeated field or a zero proto3 scalar is not set.
+func (m *Message) get(f *field) (interface{}, bool) {
+	v, ok := m.values[f.number]
+	if !ok {
+		return nil, false
+	}
+	switch x := v.(type) {
+	case []interface{}:
+		return v, len(x) > 0
+	case map[interface{}]interface{}:
+		return v, len(x) > 0
+	}
+	if f.proto3 && f.oneof < 0 && !f.isMessage() && isZero(v) {
+		return v, false
+	}
+	return v, true
+}
+
+func isZero(v interface{}) bool {
+	if b, ok := v.([]byte); ok {
+		return len(b) == 0
+	}
+	return v == reflect.Zero(reflect.TypeOf(v)).Interface()
+}
+
+// set stores v in f, clearing the other fields of f's oneof.
+func (m *Message) set(f *field, v interface{}) {
+	if f.oneof >= 0 {
+		for _, g := range m.typ.fields {
+			if g.oneof == f.oneof && g != f {
+				delete(m.values, g.number)
+			}
+		}
+	}
+	if m.values == nil {
+		m.values = make(map[int32]interface{})
+	}
+	m.values[f.number] = v
+}
+
+// Has reports whether the named field is set. Repeated and map fields are
+// set when they are not empty, and proto3 scalars when they are not zero.
+func (m *Message) Has(name string) bool {
+	f, err := m.field(name)
+	if err != nil {
+		return false
+	}
+	_, ok := m.get(f)
+	return ok
+}
+
+// Get returns the value of the named field, or nil if m has no such field.
+// An unset scalar field reports its default value, and an unset message,
+// repeated or map field reports nil.
+func (m *Message) Get(name string) interface{} {
+	f, err := m.field(name)
+	if err != nil {
+		return nil
+	}
+	if v, ok := m.values[f.number]; ok {
+		return v
+	}
+	if f.repeated || f.isMessage() {
+		return nil
+	}
+	return f.zero()
+}
+
+// Set sets the named field to v, which must hold the type Get would
+// report. Repeated fields also accept a slice of the element type, and map
+// fields a map of the key and value types. Setting a field to nil clears it.
+func (m *Message) Set(name string, v interface{}) error {
+	f, err := m.field(name)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		delete(m.values, f.number)
+		return nil
+	}
+	cv, err := f.convert(v)
+	if err != nil {
+		return fmt.Errorf("dynamic: %s.%s: %v", m.typ.name, f.name, err)
+	}
+	m.set(f, cv)
+	return nil
+}
+
+// Clear clears the named field.
+func (m *Message) Clear(name string) {
+	if f, err := m.field(name); err == nil {
+		delete(m.values, f.number)
+	}
+}
+
+// NewField returns an empty message of the type of the named message,
+// group or map-value field.
+func (m *Message) NewField(name string) (proto.Message, error) {
+	f, err := m.field(name)
+	if err != nil {
+		return nil, err
+	}
+	if f.isMap() {
+		f = f.val
+	}
+	if !f.isMessage() {
+		return nil, fmt.Errorf("dynamic: %s.%s is not a message field", m.typ.name, f.name)
+	}
+	return f.newMessage(), nil
+}
+
+// convert checks that v may be stored in f, returning the value to store.
+func (f *field) convert(v interface{}) (interface{}, error) {
+	if f.isMap() {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Map {
+			return nil, fmt.Errorf("want a map, got %T", v)
+		}
+		mv := make(map[interface{}]interface{}, rv.Len())
+		for _, k := range rv.MapKeys() {
+			ck, err := f.key.convertSingle(k.Interface())
+			if err != nil {
+				return nil, err
+			}
+			cv, err := f.val.convertSingle(rv.MapIndex(k).Interface())
+			if err != nil {
+				return nil, err
+			}
+			mv[ck] = cv
+		}
+		return mv, nil
+	}
+	if f.repeated {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice || rv.Type() == reflect.TypeOf([]byte(nil)) && f.kind != descriptor.FieldDescriptorProto_TYPE_BYTES {
+			return nil, fmt.Errorf("want a slice, got %T", v)
+		}
+		sv := make([]interface{}, rv.Len())
+		for i := range sv {
+			cv, err := f.convertSingle(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			sv[i] = cv
+		}
+		return sv, nil
+	}
+	return f.convertSingle(v)
+}
+
+func (f *field) convertSingle(v interface{}) (interface{}, error) {
+	if f.isMessage() {
+		if dm, ok := v.(*Message); ok && f.msg != nil && dm.typ == f.msg {
+			return v, nil
+		}
+		if f.goType != nil && reflect.TypeOf(v) == f.goType {
+			return v, nil
+		}
+		want := f.goType
+		if f.msg != nil {
+			want = reflect.TypeOf((*Message)(nil))
+		}
+		return nil, fmt.Errorf("want %v, got %T", want, v)
+	}
+	zero := f.zero()
+	if f.kind == descriptor.FieldDescriptorProto_TYPE_ENUM {
+		zero = int32(0)
+	}
+	want := reflect.TypeOf(zero)
+	rv := reflect.ValueOf(v)
+	if rv.Type() == want {
+		return v, nil
+	}
+	// Accept named types, such as generated enums, of the right kind.
+	if rv.Kind() == want.Kind() && rv.Kind() != reflect.Slice {
+		return rv.Convert(want).Interface(), nil
+	}
+	return nil, fmt.Errorf("want %v, got %T", want, v)
+}
+
+// Equal reports whether m and other, which must be a *Message, hold equal
+// values of the same type. It follows the rules of proto.Equal.
+func (m *Message) Equal(other proto.Message) bool {
+	o, ok := other.(*Message)
+	if !ok || m.typ != o.typ {
+		return false
+	}
+	if m.typ != nil {
+		for _, f := range m.typ.fields {
+			v1, ok1 := m.get(f)
+			v2, ok2 := o.get(f)
+			if ok1 != ok2 {
+				return false
+			}
+			if ok1 && !equalValue(f, v1, v2) {
+				return false
+			}
+		}
+	}
+	return bytes.Equal(m.unknown, o.unknown)
+}
+
+func equalValue(f *field, v1, v2 interface{}) bool {
+	switch x1 := v1.(type) {
+	case []interface{}:
+		x2 := v2.([]interface{})
+		if len(x1) != len(x2) {
+			return false
+		}
+		for i := range x1 {
+			if !equalSingle(x1[i], x2[i]) {
+				return false
+			}
+		}
+		return true
+	case map[interface{}]interface{}:
+		x2 := v2.(map[interface{}]interface{})
+		if len(x1) != len(x2) {
+			return false
+		}
+		for k, e1 := range x1 {
+			e2, ok := x2[k]
+			if !ok || !equalSingle(e1, e2) {
+				return false
+			}
+		}
+		return true
+	}
+	return equalSingle(v1, v2)
+}
+
+func equalSingle(v1, v2 interface{}) bool {
+	switch x1 := v1.(type) {
+	case []byte:
+		return bytes.Equal(x1, v2.([]byte))
+	case proto.Message:
+		return proto.Equal(x1, v2.(proto.Message))
+	}
+	return v1 == v2
+}
+
+// Merge merges src, which must be a *Message of the same type, into m,
+// following the rules of proto.Merge. If m has no type it takes src's.
+func (m *Message) Merge(src proto.Message) {
+	s := src.(*Message)
+	if s == nil {
+		return
+	}
+	if m.typ == nil {
+		m.typ = s.typ
+	} else if m.typ != s.typ {
+		panic("proto: type mismatch")
+	}
+	if m.typ != nil {
+		for _, f := range m.typ.fields {
+			v, ok := s.get(f)
+			if !ok {
+				continue
+			}
+			switch x := v.(type) {
+			case []interface{}:
+				old, _ := m.values[f.number].([]interface{})
+				for _, e := range x {
+					old = append(old, cloneSingle(e))
+				}
+				m.set(f, old)
+			case map[interface{}]interface{}:
+				old, _ := m.values[f.number].(map[interface{}]interface{})
+				if old == nil {
+					old = make(map[interface{}]interface{}, len(x))
+				}
+				for k, e := range x {
+					old[k] = cloneSingle(e)
+				}
+				m.set(f, old)
+			case proto.Message:
+				if old, ok := m.values[f.number].(proto.Message); ok {
+					proto.Merge(old, x)
+				} else {
+					m.set(f, proto.Clone(x))
+				}
+			default:
+				m.set(f, cloneSingle(v))
+			}
+		}
+	}
+	if len(s.unknown) > 0 {
+		m.unknown = append([]byte(nil), s.unknown...)
+	}
+}
+
+// MissingRequired returns the paths of the required fields that are not set
+// in m or in the messages it holds, in the form proto.CheckInitialized
+// reports them, which makes m a proto.RequiredChecker.
+func (m *Message) MissingRequired() []string {
+	if m.typ == nil {
+		return nil
+	}
+	var missing []string
+	for _, f := range m.typ.fields {
+		name := f.desc.GetName()
+		v, ok := m.get(f)
+		if !ok {
+			if f.required {
+				missing = append(missing, name)
+			}
+			continue
+		}
+		switch x := v.(type) {
+		case []interface{}:
+			for i, e := range x {
+				missing = appendMissing(missing, name+"["+strconv.Itoa(i)+"]", e)
+			}
+		case map[interface{}]interface{}:
+			for _, k := range sortedKeys(x) {
+				key := fmt.Sprint(k)
+				if s, ok := k.(string); ok {
+					key = strconv.Quote(s)
+				}
+				missing = appendMissing(missing, name+"["+key+"]", x[k])
+			}
+		default:
+			missing = appendMissing(missing, name, v)
+		}
+	}
+	return missing
+}
+
+// appendMissing appends to missing the paths of the unset required fields
+// of v, if it is a message, prefixed by path.
+func appendMissing(missing []string, path string, v interface{}) []string {
+	pb, ok := v.(proto.Message)
+	if !ok {
+		return missing
+	}
+	if err, ok := proto.CheckInitialized(pb).(*proto.RequiredNotSetError); ok {
+		for _, f := range err.Fields() {
+			missing = append(missing, path+"."+f)
+		}
+	}
+	return missing
+}
+
+func cloneSingle(v interface{}) interface{} {
+	switch x := v.(type) {
+	case []byte:
+		return append([]byte(nil), x...)
+	case proto.Message:
+		return proto.Clone(x)
+	}
+	return v
+}
@@ -0,0 +1,541 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/dynamic"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb3 "github.com/golang/protobuf/proto/proto3_proto"
+	tpb "github.com/golang/protobuf/proto/testdata"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	anypb "github.com/golang/protobuf/ptypes/any"
+)
+
+func testTypes(t *testing.T) *dynamic.Types {
+	fd2, _ := descriptor.ForMessage(&tpb.GoTest{})
+	fd3, _ := descriptor.ForMessage(&pb3.Message{})
+	types, err := dynamic.NewTypes(fd2, fd3)
+	if err != nil {
+		t.Fatalf("NewTypes: %v", err)
+	}
+	return types
+}
+
+func newGoTest() *tpb.GoTest {
+	return &tpb.GoTest{
+		Kind:                    tpb.GoTest_TIME.Enum(),
+		Table:                   proto.String("hello"),
+		RequiredField:           &tpb.GoTestField{Label: proto.String("label"), Type: proto.String("type")},
+		RepeatedField:           []*tpb.GoTestField{{Label: proto.String("a"), Type: proto.String("b")}},
+		F_BoolRequired:          proto.Bool(true),
+		F_Int32Required:         proto.Int32(-3),
+		F_Int64Required:         proto.Int64(-64),
+		F_Fixed32Required:       proto.Uint32(32),
+		F_Fixed64Required:       proto.Uint64(1 << 40),
+		F_Uint32Required:        proto.Uint32(1 << 31),
+		F_Uint64Required:        proto.Uint64(1 << 63),
+		F_FloatRequired:         proto.Float32(3.25),
+		F_DoubleRequired:        proto.Float64(-1e100),
+		F_StringRequired:        proto.String("a \"string\"\n\x01é"),
+		F_BytesRequired:         []byte("\x00\xffbytes"),
+		F_Sint32Required:        proto.Int32(-32),
+		F_Sint64Required:        proto.Int64(math.MinInt64),
+		F_Int32Repeated:         []int32{1, -2, 3},
+		F_StringRepeated:        []string{"x", ""},
+		F_BytesRepeated:         [][]byte{[]byte("y"), {}},
+		F_Sint64Repeated:        []int64{-1, 1},
+		F_FloatOptional:         proto.Float32(0.1),
+		F_Int32Defaulted:        proto.Int32(32),
+		F_BoolRepeatedPacked:    []bool{true, false},
+		F_Fixed32RepeatedPacked: []uint32{7, 8},
+		F_DoubleRepeatedPacked:  []float64{1.5, -2},
+		F_Sint32RepeatedPacked:  []int32{-5, 5},
+		Requiredgroup:           &tpb.GoTest_RequiredGroup{RequiredField: proto.String("required")},
+		Repeatedgroup: []*tpb.GoTest_RepeatedGroup{
+			{RequiredField: proto.String("one")},
+			{RequiredField: proto.String("two")},
+		},
+	}
+}
+
+func newProto3() *pb3.Message {
+	return &pb3.Message{
+		Name:         "Rowan",
+		Hilarity:     pb3.Message_SLAPSTICK,
+		HeightInCm:   178,
+		Data:         []byte("data"),
+		ResultCount:  -47,
+		TrueScotsman: true,
+		Score:        8.5,
+		Key:          []uint64{1, 1 << 60},
+		ShortKey:     []int32{-1},
+		Nested:       &pb3.Nested{Bunny: "Monty", Cute: true},
+		RFunny:       []pb3.Message_Humour{pb3.Message_PUNS, 7},
+		Terrain: map[string]*pb3.Nested{
+			"hills": {Bunny: "Peter"},
+			"dale":  {Cute: true},
+		},
+		Proto2Field: &tpb.SubDefaults{N: proto.Int64(12)},
+		Proto2Value: map[string]*tpb.SubDefaults{"k": {N: proto.Int64(1)}},
+		Anything:    &anypb.Any{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte("\n\x03Bun")},
+		Submessage:  &pb3.Message{Name: "sub", Key: []uint64{3}},
+		Children:    []*pb3.Message{{Name: "child"}, {}},
+	}
+}
+
+var roundTripTests = []proto.Message{
+	newGoTest(),
+	newProto3(),
+	&pb3.MessageWithMap{ByteMapping: map[bool][]byte{false: []byte("no"), true: nil}},
+	&pb3.IntMaps{Maps: []*pb3.IntMap{{Rtt: map[int32]int32{-1: 1, 2: -2}}}},
+	&tpb.Communique{MakeMeCry: proto.Bool(true), Union: &tpb.Communique_Number{Number: 0}},
+	&tpb.Communique{Union: &tpb.Communique_Msg{Msg: &tpb.Strings{StringField: proto.String("s")}}},
+	&tpb.MyMessage{
+		Count:     proto.Int32(7),
+		Pet:       []string{"cat", "dog"},
+		Bikeshed:  tpb.MyMessage_BLUE.Enum(),
+		Somegroup: &tpb.MyMessage_SomeGroup{GroupField: proto.Int32(8)},
+		Inner:     &tpb.InnerMessage{Host: proto.String("host")},
+	},
+	&tpb.Defaults{},
+}
+
+func TestRoundTrip(t *testing.T) {
+	types := testTypes(t)
+	for _, want := range roundTripTests {
+		name := proto.MessageName(want)
+		dm, err := types.New(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := proto.MarshalDeterministic(want)
+		if err != nil {
+			t.Fatalf("%s: Marshal: %v", name, err)
+		}
+		if err := proto.Unmarshal(data, dm); err != nil {
+			t.Errorf("%s: Unmarshal: %v", name, err)
+			continue
+		}
+
+		got, err := proto.Marshal(dm)
+		if err != nil {
+			t.Errorf("%s: Marshal: %v", name, err)
+		} else if !bytes.Equal(got, data) {
+			t.Errorf("%s: Marshal = %q, want %q", name, got, data)
+		}
+
+		if got, want := proto.MarshalTextString(dm), proto.MarshalTextString(want); got != want {
+			t.Errorf("%s: MarshalTextString =\n%s\nwant\n%s", name, got, want)
+		}
+		if got, want := dm.String(), proto.CompactTextString(want); got != want {
+			t.Errorf("%s: String = %s, want %s", name, got, want)
+		}
+		dm2, _ := types.New(name)
+		if err := proto.UnmarshalText(proto.MarshalTextString(want), dm2); err != nil {
+			t.Errorf("%s: UnmarshalText: %v", name, err)
+		} else if !proto.Equal(dm, dm2) {
+			t.Errorf("%s: UnmarshalText = %v, want %v", name, dm2, dm)
+		}
+
+		for _, m := range []jsonpb.Marshaler{{}, {OrigName: true}, {EnumsAsInts: true}, {Indent: "  "}} {
+			got, err := m.MarshalToString(dm)
+			if err != nil {
+				t.Errorf("%s: %+v.MarshalToString: %v", name, m, err)
+				continue
+			}
+			want, _ := m.MarshalToString(want)
+			if m.Indent != "" {
+				// jsonpb lays out empty objects differently; compare content.
+				got, want = compactJSON(got), compactJSON(want)
+			}
+			if got != want {
+				t.Errorf("%s: %+v.MarshalToString = %s, want %s", name, m, got, want)
+			}
+			dm3, _ := types.New(name)
+			if err := jsonpb.UnmarshalString(got, dm3); err != nil {
+				t.Errorf("%s: UnmarshalString(%s): %v", name, got, err)
+			} else if !proto.Equal(dm, dm3) {
+				t.Errorf("%s: UnmarshalString(%s) = %v, want %v", name, got, dm3, dm)
+			}
+		}
+
+		if c := proto.Clone(dm); !proto.Equal(c, dm) {
+			t.Errorf("%s: Clone = %v, want %v", name, c, dm)
+		}
+	}
+}
+
+func compactJSON(s string) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		return err.Error()
+	}
+	return b.String()
+}
+
+func TestUnknownFields(t *testing.T) {
+	types := testTypes(t)
+	m := &tpb.MyMessage{Count: proto.Int32(1)}
+	if err := proto.SetExtension(m, tpb.E_Greeting, []string{"hi", "there"}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm, _ := types.New("testdata.MyMessage")
+	if err := proto.Unmarshal(data, dm); err != nil {
+		t.Fatal(err)
+	}
+	got, err := proto.Marshal(dm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := new(tpb.MyMessage)
+	if err := proto.Unmarshal(got, m2); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, m2) {
+		t.Errorf("Marshal lost unknown fields: got %v, want %v", m2, m)
+	}
+	want := "count: 1\n/* 13 unknown bytes */\n106: \"hi\"\n106: \"there\"\n"
+	if got := proto.MarshalTextString(dm); got != want {
+		t.Errorf("MarshalTextString =\n%s\nwant\n%s", got, want)
+	}
+
+	// Unknown fields, including embedded messages, print as they do for
+	// generated messages.
+	m = &tpb.MyMessage{Count: proto.Int32(1), XXX_unrecognized: []byte("\xa2\x06\x04\x08\x07\x10\x01")}
+	data, err = proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm, _ = types.New("testdata.MyMessage")
+	if err := proto.Unmarshal(data, dm); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := proto.MarshalTextString(dm), proto.MarshalTextString(m); got != want {
+		t.Errorf("MarshalTextString =\n%s\nwant\n%s", got, want)
+	}
+	// They parse back, as they do for generated messages.
+	dm2, _ := types.New("testdata.MyMessage")
+	if err := proto.UnmarshalText(proto.MarshalTextString(dm), dm2); err != nil {
+		t.Errorf("UnmarshalText: %v", err)
+	} else if !proto.Equal(dm2, dm) {
+		t.Errorf("UnmarshalText = %v, want %v", dm2, dm)
+	}
+
+	// Merge replaces the unknown fields, as proto.Merge does for generated
+	// messages.
+	dst := &tpb.GoTestField{XXX_unrecognized: []byte("\x28\x01")}
+	src := &tpb.GoTestField{XXX_unrecognized: []byte("\x28\x02")}
+	ddst, _ := types.New("testdata.GoTestField")
+	dsrc, _ := types.New("testdata.GoTestField")
+	proto.Unmarshal(dst.XXX_unrecognized, ddst)
+	proto.Unmarshal(src.XXX_unrecognized, dsrc)
+	proto.Merge(dst, src)
+	proto.Merge(ddst, dsrc)
+	got, _ = proto.Marshal(ddst)
+	if want := dst.XXX_unrecognized; !bytes.Equal(got, want) {
+		t.Errorf("after Merge, encoding = %q, want %q", got, want)
+	}
+}
+
+func TestEqualMergeClone(t *testing.T) {
+	types := testTypes(t)
+	a, _ := types.New("proto3_proto.Message")
+	b, _ := types.New("proto3_proto.Message")
+	if !proto.Equal(a, b) {
+		t.Errorf("empty messages are not equal")
+	}
+	if err := a.Set("name", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if proto.Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = true", a, b)
+	}
+	// A zero proto3 scalar is the same as an unset one.
+	if err := b.Set("height_in_cm", uint32(0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Set("name", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(a, b) {
+		t.Errorf("Equal(%v, %v) = false", a, b)
+	}
+	other, _ := types.New("proto3_proto.Nested")
+	if proto.Equal(a, other) {
+		t.Errorf("messages of different types are equal")
+	}
+
+	if err := a.Set("key", []uint64{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Set("terrain", map[string]*pb3.Nested{"a": {Bunny: "a"}}); err == nil {
+		t.Errorf("Set of a generated type for a dynamic field succeeded")
+	}
+	n, err := a.NewField("terrain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.(*dynamic.Message).Set("bunny", "b")
+	if err := a.Set("terrain", map[string]proto.Message{"a": n}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Set("key", []interface{}{uint64(3)}); err != nil {
+		t.Fatal(err)
+	}
+	proto.Merge(b, a)
+	if got, want := b.String(), `name:"x" key:3 key:1 key:2 terrain:<key:"a" value:<bunny:"b" > > `; got != want {
+		t.Errorf("after Merge, b = %s, want %s", got, want)
+	}
+
+	c := proto.Clone(b).(*dynamic.Message)
+	if !proto.Equal(b, c) {
+		t.Errorf("Clone(%v) = %v", b, c)
+	}
+	c.Get("terrain").(map[interface{}]interface{})["a"].(*dynamic.Message).Set("bunny", "c")
+	if proto.Equal(b, c) {
+		t.Errorf("Clone shares a nested message with its source")
+	}
+}
+
+func TestProto3Optional(t *testing.T) {
+	types := testTypes(t)
+	m, _ := types.New("proto3_proto.OptionalScalars")
+	if err := m.Set("count", int32(0)); err != nil {
+		t.Fatal(err)
+	}
+	// Unlike a plain proto3 scalar, a zero optional field is set.
+	if !m.Has("count") || m.Has("label") {
+		t.Errorf("Has(count), Has(label) = %v, %v; want true, false", m.Has("count"), m.Has("label"))
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "\x08\x00"; string(b) != want {
+		t.Errorf("Marshal = %q, want %q", b, want)
+	}
+	gen := new(pb3.OptionalScalars)
+	if err := proto.Unmarshal(b, gen); err != nil {
+		t.Fatal(err)
+	}
+	if gen.Count == nil || gen.Label != nil {
+		t.Errorf("generated message = %v, want only count set", gen)
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	types := testTypes(t)
+	m, _ := types.New("testdata.Defaults")
+	if got, want := m.Get("F_Int32"), int32(32); got != want {
+		t.Errorf("Get(F_Int32) = %v, want %v", got, want)
+	}
+	if got, want := m.Get("F_Bytes"), "Bignose"; string(got.([]byte)) != want {
+		t.Errorf("Get(F_Bytes) = %q, want %q", got, want)
+	}
+	if got, want := m.Get("F_Enum"), int32(tpb.Defaults_GREEN); got != want {
+		t.Errorf("Get(F_Enum) = %v, want %v", got, want)
+	}
+	if got := m.Get("F_Nan").(float32); !math.IsNaN(float64(got)) {
+		t.Errorf("Get(F_Nan) = %v, want NaN", got)
+	}
+	if m.Has("F_Int32") {
+		t.Errorf("Has(F_Int32) = true before Set")
+	}
+	if err := m.Set("F_Enum", tpb.Defaults_BLUE); err != nil {
+		t.Errorf("Set(F_Enum) with a generated enum: %v", err)
+	}
+	if err := m.Set("F_Int32", "32"); err == nil {
+		t.Errorf("Set(F_Int32) with a string succeeded")
+	}
+	if err := m.Set("no_such_field", 1); err == nil {
+		t.Errorf("Set of an unknown field succeeded")
+	}
+	sub, err := m.NewField("sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sub.(*dynamic.Message).Set("n", int64(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("sub", &tpb.SubDefaults{}); err == nil {
+		t.Errorf("Set(sub) with a generated message succeeded")
+	}
+	if err := m.Set("sub", sub); err != nil {
+		t.Errorf("Set(sub): %v", err)
+	}
+	if got, want := m.String(), "F_Enum:BLUE sub:<n:3 > "; got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+
+	c, _ := types.New("testdata.Communique")
+	c.Set("number", int32(4))
+	c.Set("name", "n")
+	if c.Has("number") || !c.Has("name") {
+		t.Errorf("setting a oneof field did not clear the others: %v", c)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	md := &descpb.DescriptorProto{
+		Name: proto.String("Node"),
+		Field: []*descpb.FieldDescriptorProto{{
+			Name:     proto.String("id"),
+			Number:   proto.Int32(1),
+			Label:    descpb.FieldDescriptorProto_LABEL_REQUIRED.Enum(),
+			Type:     descpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+			JsonName: proto.String("id"),
+		}, {
+			Name:     proto.String("children"),
+			Number:   proto.Int32(2),
+			Label:    descpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			Type:     descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".example.Node"),
+			JsonName: proto.String("children"),
+		}},
+	}
+	m, err := dynamic.NewMessage(md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const text = "id: 1\nchildren: <\n  id: 2\n>\nchildren: <\n  id: 3\n>\n"
+	if err := proto.UnmarshalText(text, m); err != nil {
+		t.Fatal(err)
+	}
+	if got := proto.MarshalTextString(m); got != text {
+		t.Errorf("MarshalTextString =\n%s\nwant\n%s", got, text)
+	}
+	js, err := new(jsonpb.Marshaler).MarshalToString(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":"1","children":[{"id":"2"},{"id":"3"}]}`; js != want {
+		t.Errorf("JSON = %s, want %s", js, want)
+	}
+
+	if err := proto.UnmarshalText("children: <>", m); err == nil || !strings.Contains(err.Error(), "required") {
+		t.Errorf("UnmarshalText without required fields: got error %v", err)
+	}
+	if err := proto.UnmarshalText("id: 1\nname: 2", m); err == nil || err.Error() != `line 2: unknown field name "name" in Node` {
+		t.Errorf("UnmarshalText with an unknown field: got error %v", err)
+	} else if pe := err.(*proto.ParseError); pe.Column != 1 || pe.Source != "name: 2" {
+		t.Errorf("UnmarshalText with an unknown field: got column %d of %q, want 1 of %q", pe.Column, pe.Source, "name: 2")
+	}
+	if err := proto.UnmarshalText("id: x", m); err == nil || err.Error() != `line 1.4: invalid int64: x` {
+		t.Errorf("UnmarshalText with a bad value: got error %v", err)
+	}
+	if err := proto.UnmarshalText("id: 1 /* 2 unknown bytes", m); err == nil || err.Error() != `line 1.6: unterminated comment` {
+		t.Errorf("UnmarshalText with an unterminated comment: got error %v", err)
+	}
+	if err := jsonpb.UnmarshalString(`{"id":1,"extra":2}`, m); err == nil {
+		t.Errorf("jsonpb.UnmarshalString with an unknown field succeeded")
+	}
+}
+
+func TestCheckInitialized(t *testing.T) {
+	gen := newGoTest()
+	gen.Kind = nil
+	gen.RequiredField.Type = nil
+	gen.RepeatedField = []*tpb.GoTestField{{Label: proto.String("r")}}
+	b, err := proto.Marshal(gen)
+	if _, ok := err.(*proto.RequiredNotSetError); !ok {
+		t.Fatalf("Marshal: %v", err)
+	}
+	m, _ := testTypes(t).New("testdata.GoTest")
+	proto.Unmarshal(b, m)
+
+	want := proto.CheckInitialized(gen).(*proto.RequiredNotSetError).Fields()
+	err = proto.CheckInitialized(m)
+	if e, ok := err.(*proto.RequiredNotSetError); !ok || !reflect.DeepEqual(e.Fields(), want) {
+		t.Errorf("CheckInitialized = %v, want fields %q", err, want)
+	}
+	if len(want) != 3 {
+		t.Errorf("generated CheckInitialized fields = %q, want 3", want)
+	}
+
+	b, err = proto.Marshal(newGoTest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Reset()
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.CheckInitialized(m); err != nil {
+		t.Errorf("CheckInitialized of a complete message = %v", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	types := testTypes(t)
+	a, _ := types.New("proto3_proto.Message")
+	b, _ := types.New("proto3_proto.Message")
+	a.Set("name", "x")
+	b.Set("name", "x")
+	if d := proto.Diff(a, b); d != nil {
+		t.Errorf("Diff of equal messages = %v", d)
+	}
+	b.Set("name", "y")
+	d := proto.Diff(a, b)
+	if len(d) != 1 || d[0].Path != "" || d[0].Old != a || d[0].New != b {
+		t.Errorf("Diff = %v, want a single difference for the whole message", d)
+	}
+}
+
+func TestEqualWith(t *testing.T) {
+	types := testTypes(t)
+	a, _ := types.New("proto3_proto.Message")
+	b, _ := types.New("proto3_proto.Message")
+	a.Set("name", "x")
+	b.Set("name", "x")
+	if !proto.EqualWith(a, b) {
+		t.Errorf("EqualWith without options = false")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("EqualWith with options did not panic")
+		}
+	}()
+	proto.EqualWith(a, b, proto.IgnoreFields("name"))
+}
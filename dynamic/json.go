@@ -0,0 +1,328 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+// JSON marshaling and parsing of dynamic messages, for the jsonpb package.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// MarshalJSONPB returns the JSON form of m, honouring the options of jm.
+// It satisfies jsonpb.JSONPBMarshaler.
+func (m *Message) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	if m.typ == nil {
+		return nil, errNoType
+	}
+	var b bytes.Buffer
+	if err := m.writeJSON(&b, jm); err != nil {
+		return nil, err
+	}
+	if jm.Indent == "" {
+		return b.Bytes(), nil
+	}
+	var ib bytes.Buffer
+	if err := json.Indent(&ib, b.Bytes(), "", jm.Indent); err != nil {
+		return nil, err
+	}
+	return ib.Bytes(), nil
+}
+
+func (m *Message) writeJSON(b *bytes.Buffer, jm *jsonpb.Marshaler) error {
+	b.WriteByte('{')
+	first := true
+	for _, f := range m.typ.fields {
+		v, ok := m.get(f)
+		if !ok {
+			if !jm.EmitDefaults || f.oneof >= 0 {
+				continue
+			}
+			switch {
+			case f.isMap():
+				v = map[interface{}]interface{}{}
+			case f.repeated:
+				v = []interface{}{}
+			case f.isMessage() || !f.proto3:
+				v = nil
+			default:
+				v = f.zero()
+			}
+		}
+		if !first {
+			b.WriteByte(',')
+		}
+		first = false
+		name := f.jsonName
+		if jm.OrigName {
+			name = f.name
+		}
+		b.WriteString(strconv.Quote(name))
+		b.WriteByte(':')
+		if err := writeJSONValue(b, jm, f, v); err != nil {
+			return err
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+func writeJSONValue(b *bytes.Buffer, jm *jsonpb.Marshaler, f *field, v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case []interface{}:
+		b.WriteByte('[')
+		for i, e := range x {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := writeJSONSingle(b, jm, f, e); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	case map[interface{}]interface{}:
+		b.WriteByte('{')
+		for i, k := range sortedKeys(x) {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Quote(fmt.Sprint(k)))
+			b.WriteByte(':')
+			if err := writeJSONSingle(b, jm, f.val, x[k]); err != nil {
+				return err
+			}
+		}
+		b.WriteByte('}')
+	default:
+		return writeJSONSingle(b, jm, f, v)
+	}
+	return nil
+}
+
+func writeJSONSingle(b *bytes.Buffer, jm *jsonpb.Marshaler, f *field, v interface{}) error {
+	switch x := v.(type) {
+	case *Message:
+		return x.writeJSON(b, jm)
+	case proto.Message:
+		// Indentation is applied to the whole message at the end.
+		sub := *jm
+		sub.Indent = ""
+		s, err := sub.MarshalToString(x)
+		if err != nil {
+			return err
+		}
+		b.WriteString(s)
+		return nil
+	case int32:
+		if f.enum != nil && !jm.EnumsAsInts {
+			if name, ok := f.enum.names[x]; ok {
+				b.WriteString(strconv.Quote(name))
+				return nil
+			}
+		}
+	case int64, uint64:
+		fmt.Fprintf(b, `"%d"`, x)
+		return nil
+	case float32:
+		if writeJSONFloat(b, float64(x)) {
+			return nil
+		}
+	case float64:
+		if writeJSONFloat(b, x) {
+			return nil
+		}
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b.Write(data)
+	return nil
+}
+
+// writeJSONFloat writes the string forms of NaN and the infinities.
+func writeJSONFloat(b *bytes.Buffer, x float64) bool {
+	switch {
+	case math.IsNaN(x):
+		b.WriteString(`"NaN"`)
+	case math.IsInf(x, 1):
+		b.WriteString(`"Infinity"`)
+	case math.IsInf(x, -1):
+		b.WriteString(`"-Infinity"`)
+	default:
+		return false
+	}
+	return true
+}
+
+// UnmarshalJSONPB merges the JSON object in data into m, accepting both the
+// JSON and the .proto names of fields. It satisfies jsonpb.JSONPBUnmarshaler.
+func (m *Message) UnmarshalJSONPB(ju *jsonpb.Unmarshaler, data []byte) error {
+	if m.typ == nil {
+		return errNoType
+	}
+	return m.readJSON(ju, data)
+}
+
+func (m *Message) readJSON(ju *jsonpb.Unmarshaler, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name, raw := range fields {
+		f, ok := m.typ.byJSON[name]
+		if !ok {
+			f, ok = m.typ.byName[name]
+		}
+		if !ok {
+			if ju.AllowUnknownFields {
+				continue
+			}
+			return fmt.Errorf("unknown field %q in %v", name, m.typ.name)
+		}
+		if string(raw) == "null" {
+			continue
+		}
+		v, err := readJSONValue(ju, f, raw)
+		if err != nil {
+			return err
+		}
+		m.set(f, v)
+	}
+	return nil
+}
+
+func readJSONValue(ju *jsonpb.Unmarshaler, f *field, raw json.RawMessage) (interface{}, error) {
+	switch {
+	case f.isMap():
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, err
+		}
+		mv := make(map[interface{}]interface{}, len(obj))
+		for ks, rv := range obj {
+			var k interface{} = ks
+			if f.key.kind != descriptor.FieldDescriptorProto_TYPE_STRING {
+				var err error
+				if k, err = f.key.parseScalar(ks); err != nil {
+					return nil, fmt.Errorf("bad map key %q for %s: %v", ks, f.name, err)
+				}
+			}
+			v, err := readJSONSingle(ju, f.val, rv)
+			if err != nil {
+				return nil, err
+			}
+			mv[k] = v
+		}
+		return mv, nil
+	case f.repeated:
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, err
+		}
+		sv := make([]interface{}, len(list))
+		for i, rv := range list {
+			v, err := readJSONSingle(ju, f, rv)
+			if err != nil {
+				return nil, err
+			}
+			sv[i] = v
+		}
+		return sv, nil
+	}
+	return readJSONSingle(ju, f, raw)
+}
+
+func readJSONSingle(ju *jsonpb.Unmarshaler, f *field, raw json.RawMessage) (interface{}, error) {
+	if f.isMessage() {
+		msg := f.newMessage()
+		if dm, ok := msg.(*Message); ok {
+			return dm, dm.readJSON(ju, raw)
+		}
+		return msg, ju.Unmarshal(bytes.NewReader(raw), msg)
+	}
+	s := string(raw)
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		var x string
+		err := json.Unmarshal(raw, &x)
+		return x, err
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		var x string
+		if err := json.Unmarshal(raw, &x); err != nil {
+			return nil, err
+		}
+		b, err := base64.StdEncoding.DecodeString(x)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(x)
+		}
+		return b, err
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		var x bool
+		err := json.Unmarshal(raw, &x)
+		return x, err
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if strings.HasPrefix(s, `"`) {
+			name, _ := strconv.Unquote(s)
+			if x, ok := f.enum.values[name]; ok {
+				return x, nil
+			}
+			return nil, fmt.Errorf("unknown value %s for enum %s", s, f.enum.name)
+		}
+	}
+	// Numbers may be quoted; 64-bit integers always are.
+	if strings.HasPrefix(s, `"`) {
+		var err error
+		if s, err = strconv.Unquote(s); err != nil {
+			return nil, err
+		}
+	}
+	v, err := f.parseScalar(s)
+	if err != nil {
+		return nil, fmt.Errorf("bad value %s for field %s: %v", raw, f.name, err)
+	}
+	return v, nil
+}
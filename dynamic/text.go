@@ -0,0 +1,530 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+// Text format marshaling and parsing of dynamic messages.
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// MarshalText returns m in the text format, laid out as
+// proto.MarshalTextString lays out generated messages.
+func (m *Message) MarshalText() ([]byte, error) {
+	if m.typ == nil {
+		return nil, errNoType
+	}
+	var b bytes.Buffer
+	m.writeText(&textWriter{buf: &b, complete: true})
+	return b.Bytes(), nil
+}
+
+// textWriter indents the lines written to it.
+type textWriter struct {
+	buf      *bytes.Buffer
+	compact  bool // write one line, with spaces in place of newlines
+	complete bool // at the start of a line
+	ind      int
+}
+
+func (w *textWriter) WriteString(s string) {
+	if w.compact {
+		w.buf.WriteString(strings.Replace(s, "\n", " ", -1))
+		return
+	}
+	for s != "" {
+		line := s
+		if i := strings.IndexByte(s, '\n'); i >= 0 {
+			line = s[:i+1]
+		}
+		if w.complete {
+			w.buf.WriteString(strings.Repeat("  ", w.ind))
+		}
+		w.buf.WriteString(line)
+		w.complete = line[len(line)-1] == '\n'
+		s = s[len(line):]
+	}
+}
+
+// space writes the separator that follows a field name.
+func (w *textWriter) space() {
+	if !w.compact {
+		w.WriteString(" ")
+	}
+}
+
+func (m *Message) writeText(w *textWriter) {
+	if m.typ == nil {
+		return
+	}
+	for _, f := range m.typ.fields {
+		v, ok := m.get(f)
+		if !ok {
+			continue
+		}
+		switch x := v.(type) {
+		case []interface{}:
+			for _, e := range x {
+				writeField(w, f, e)
+			}
+		case map[interface{}]interface{}:
+			for _, k := range sortedKeys(x) {
+				writeName(w, f)
+				w.WriteString("<")
+				if !w.compact {
+					w.WriteString("\n")
+				}
+				w.ind++
+				w.WriteString("key:")
+				w.space()
+				writeValue(w, f.key, k)
+				w.WriteString("\nvalue:")
+				w.space()
+				writeValue(w, f.val, x[k])
+				w.WriteString("\n")
+				w.ind--
+				w.WriteString(">\n")
+			}
+		default:
+			writeField(w, f, v)
+		}
+	}
+	if len(m.unknown) > 0 {
+		writeUnknown(w, m.unknown)
+	}
+}
+
+func writeName(w *textWriter, f *field) {
+	w.WriteString(f.name)
+	if f.kind != descriptor.FieldDescriptorProto_TYPE_GROUP {
+		w.WriteString(":")
+	}
+	w.space()
+}
+
+func writeField(w *textWriter, f *field, v interface{}) {
+	writeName(w, f)
+	writeValue(w, f, v)
+	w.WriteString("\n")
+}
+
+func writeValue(w *textWriter, f *field, v interface{}) {
+	switch x := v.(type) {
+	case proto.Message:
+		bra, ket := "<", ">"
+		if f.kind == descriptor.FieldDescriptorProto_TYPE_GROUP {
+			bra, ket = "{", "}"
+		}
+		w.WriteString(bra)
+		if !w.compact {
+			w.WriteString("\n")
+		}
+		w.ind++
+		if dm, ok := x.(*Message); ok {
+			dm.writeText(w)
+		} else if w.compact {
+			w.WriteString(proto.CompactTextString(x))
+		} else {
+			w.WriteString(proto.MarshalTextString(x))
+		}
+		w.ind--
+		w.WriteString(ket)
+	case string:
+		writeString(w, x)
+	case []byte:
+		writeString(w, string(x))
+	case float32:
+		writeFloat(w, float64(x), fmt.Sprint(x))
+	case float64:
+		writeFloat(w, x, fmt.Sprint(x))
+	case int32:
+		if f.enum != nil {
+			if name, ok := f.enum.names[x]; ok {
+				w.WriteString(name)
+				return
+			}
+		}
+		w.WriteString(strconv.FormatInt(int64(x), 10))
+	default:
+		w.WriteString(fmt.Sprint(v))
+	}
+}
+
+func writeFloat(w *textWriter, x float64, s string) {
+	switch {
+	case math.IsInf(x, 1):
+		s = "inf"
+	case math.IsInf(x, -1):
+		s = "-inf"
+	case math.IsNaN(x):
+		s = "nan"
+	}
+	w.WriteString(s)
+}
+
+// writeString writes s quoted, with octal escapes for unprintable bytes.
+func writeString(w *textWriter, s string) {
+	var b bytes.Buffer
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if c >= 0x20 && c < 0x7f {
+				b.WriteByte(c)
+			} else {
+				fmt.Fprintf(&b, "\\%03o", c)
+			}
+		}
+	}
+	b.WriteByte('"')
+	w.WriteString(b.String())
+}
+
+// writeUnknown writes unknown fields by number, as proto.MarshalText does.
+func writeUnknown(w *textWriter, b []byte) {
+	if !w.compact {
+		w.WriteString(fmt.Sprintf("/* %d unknown bytes */\n", len(b)))
+	}
+	var buf bytes.Buffer
+	tm := proto.TextMarshaler{Compact: w.compact}
+	tm.MarshalUnknown(&buf, b) // writes to a bytes.Buffer cannot fail
+	w.WriteString(buf.String())
+}
+
+// UnmarshalText replaces the contents of m with the text format message in
+// text. Fields written by number, as MarshalText writes unknown fields, are
+// kept as unknown fields. Errors are reported as *proto.ParseError, except
+// for required fields that are not set.
+func (m *Message) UnmarshalText(text []byte) error {
+	if m.typ == nil {
+		return errNoType
+	}
+	m.Reset()
+	p := &textParser{src: string(text), sc: proto.NewTextScanner(string(text))}
+	return p.readMessage(m, "")
+}
+
+// textParser reads dynamic messages from the tokens of a proto.TextScanner.
+type textParser struct {
+	src    string
+	sc     *proto.TextScanner
+	cur    proto.TextToken
+	ahead  *proto.TextToken // a token read past the current one
+	backed bool
+	err    error
+}
+
+func (p *textParser) errorf(format string, a ...interface{}) error {
+	if p.err == nil {
+		p.err = p.sc.Errorf(p.cur, format, a...)
+	}
+	return p.err
+}
+
+// back makes the next call to next return the current token again.
+func (p *textParser) back() { p.backed = true }
+
+// next returns the next token, which has an empty value at the end of the
+// input. Adjacent quoted strings are joined into one token.
+func (p *textParser) next() *proto.TextToken {
+	if p.backed || p.err != nil {
+		p.backed = false
+		return &p.cur
+	}
+	p.cur = p.scan()
+	for p.err == nil && quoted(&p.cur) {
+		t := p.scan()
+		if !quoted(&t) {
+			p.ahead = &t
+			break
+		}
+		p.cur.Value += " " + t.Value
+		p.cur.Unquoted += t.Unquoted
+	}
+	return &p.cur
+}
+
+func (p *textParser) scan() proto.TextToken {
+	if t := p.ahead; t != nil {
+		p.ahead = nil
+		return *t
+	}
+	t, err := p.sc.Scan()
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	return t
+}
+
+func quoted(t *proto.TextToken) bool {
+	return t.Value != "" && (t.Value[0] == '"' || t.Value[0] == '\'')
+}
+
+// isFieldNumber reports whether s is a field written by number.
+func isFieldNumber(s string) bool {
+	return s != "" && '0' <= s[0] && s[0] <= '9'
+}
+
+// readMessage reads fields into m up to terminator, or to the end of the
+// input if terminator is empty.
+func (p *textParser) readMessage(m *Message, terminator string) error {
+	var errNotSet error
+	for {
+		tok := p.next()
+		if p.err != nil {
+			return p.err
+		}
+		if tok.Value == terminator {
+			break
+		}
+		if tok.Value == "" {
+			return p.errorf("unexpected EOF, expected %q", terminator)
+		}
+		if tok.Value == "[" {
+			return p.errorf("extension and Any names are not supported by dynamic messages")
+		}
+		if isFieldNumber(tok.Value) {
+			b, err := p.sc.ScanUnknown(*tok)
+			if err != nil {
+				p.err = err
+				return err
+			}
+			m.unknown = append(m.unknown, b...)
+			if tok = p.next(); tok.Value != ";" && tok.Value != "," {
+				p.back()
+			}
+			continue
+		}
+		f, ok := m.typ.byName[tok.Value]
+		if !ok {
+			return p.errorf("unknown field name %q in %v", tok.Value, m.typ.name)
+		}
+		if tok = p.next(); tok.Value != ":" {
+			if !f.isMessage() {
+				return p.errorf("expected ':', found %q", tok.Value)
+			}
+			p.back()
+		}
+		if _, set := m.values[f.number]; set && !f.repeated {
+			return p.errorf("non-repeated field %q was repeated", f.name)
+		}
+		var err error
+		if tok = p.next(); tok.Value == "[" && f.repeated {
+			err = p.readList(m, f)
+		} else {
+			p.back()
+			err = p.readField(m, f)
+		}
+		if notSet(err) {
+			if errNotSet == nil {
+				errNotSet = err
+			}
+		} else if err != nil {
+			return err
+		}
+		if tok = p.next(); tok.Value != ";" && tok.Value != "," {
+			p.back()
+		}
+	}
+	if err := m.checkRequired(); err != nil {
+		return err
+	}
+	return errNotSet
+}
+
+// readList reads the values of a repeated field written as a list,
+// after the opening '['.
+func (p *textParser) readList(m *Message, f *field) error {
+	if tok := p.next(); tok.Value == "]" {
+		return nil
+	}
+	p.back()
+	var errNotSet error
+	for {
+		if err := p.readField(m, f); notSet(err) {
+			if errNotSet == nil {
+				errNotSet = err
+			}
+		} else if err != nil {
+			return err
+		}
+		switch tok := p.next(); tok.Value {
+		case "]":
+			return errNotSet
+		case ",":
+		default:
+			return p.errorf("expected ',' or ']', found %q", tok.Value)
+		}
+	}
+}
+
+// readField reads one value of f into m.
+func (p *textParser) readField(m *Message, f *field) error {
+	var (
+		v   interface{}
+		err error
+	)
+	switch {
+	case f.isMap():
+		entry := &Message{typ: f.msg}
+		if err = p.readNested(f, entry); err != nil && !notSet(err) {
+			return err
+		}
+		k, ok := entry.values[1]
+		if !ok {
+			k = f.key.zero()
+		}
+		v, ok = entry.values[2]
+		if !ok {
+			if f.val.isMessage() {
+				v = f.val.newMessage()
+			} else {
+				v = f.val.zero()
+			}
+		}
+		mv, _ := m.values[f.number].(map[interface{}]interface{})
+		if mv == nil {
+			mv = make(map[interface{}]interface{})
+		}
+		mv[k] = v
+		m.set(f, mv)
+		return err
+	case f.isMessage():
+		msg := f.newMessage()
+		if err = p.readNested(f, msg); err != nil && !notSet(err) {
+			return err
+		}
+		v = msg
+	default:
+		if v, err = p.readScalar(f); err != nil {
+			return err
+		}
+	}
+	if f.repeated {
+		old, _ := m.values[f.number].([]interface{})
+		v = append(old, v)
+	}
+	m.set(f, v)
+	return err
+}
+
+// readNested reads a message value of f, braces included, into msg.
+func (p *textParser) readNested(f *field, msg proto.Message) error {
+	tok := p.next()
+	var terminator string
+	switch tok.Value {
+	case "{":
+		terminator = "}"
+	case "<":
+		terminator = ">"
+	default:
+		return p.errorf("expected '{' or '<', found %q", tok.Value)
+	}
+	if dm, ok := msg.(*Message); ok {
+		return p.readMessage(dm, terminator)
+	}
+
+	// Hand the text of a generated message to proto.UnmarshalText.
+	start, line := tok.Offset+len(tok.Value), tok.Line
+	for depth := 1; depth > 0; {
+		tok = p.next()
+		switch tok.Value {
+		case "{", "<":
+			depth++
+		case "}", ">":
+			depth--
+		case "":
+			return p.errorf("unexpected EOF, expected %q", terminator)
+		}
+		if p.err != nil {
+			return p.err
+		}
+	}
+	err := proto.UnmarshalText(p.src[start:tok.Offset], msg)
+	if pe, ok := err.(*proto.ParseError); ok {
+		// Locate the error in the whole input.
+		at := proto.TextToken{Line: pe.Line + line - 1, Offset: pe.Offset + start}
+		loc := p.sc.Errorf(at, "%s", pe.Message)
+		loc.Path, loc.Suggestions = pe.Path, pe.Suggestions
+		p.err = loc
+		return loc
+	}
+	return err
+}
+
+// readScalar reads a value of a scalar or enum field.
+func (p *textParser) readScalar(f *field) (interface{}, error) {
+	tok := p.next()
+	if p.err != nil {
+		return nil, p.err
+	}
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		if quoted(tok) {
+			return tok.Unquoted, nil
+		}
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if quoted(tok) {
+			return []byte(tok.Unquoted), nil
+		}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if x, ok := f.enum.values[tok.Value]; ok {
+			return x, nil
+		}
+		if x, err := strconv.ParseInt(tok.Value, 0, 32); err == nil {
+			return int32(x), nil
+		}
+		return nil, p.errorf("unrecognized enum value %q for %v", tok.Value, f.enum.name)
+	default:
+		if x, err := f.parseScalar(tok.Value); err == nil && !quoted(tok) {
+			return x, nil
+		}
+	}
+	return nil, p.errorf("invalid %v: %v", strings.ToLower(strings.TrimPrefix(f.kind.String(), "TYPE_")), tok.Value)
+}
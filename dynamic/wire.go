@@ -0,0 +1,484 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+// Wire format encoding and decoding of dynamic messages.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+var errNoType = errors.New("dynamic: message has no type")
+
+// requiredNotSetError reports a required field that is not set. Like
+// proto.RequiredNotSetError, it does not stop encoding or decoding.
+type requiredNotSetError struct {
+	field string
+}
+
+func (e *requiredNotSetError) Error() string {
+	return fmt.Sprintf("proto: required field %q not set", e.field)
+}
+
+// notSet reports whether err only says that a required field is not set.
+func notSet(err error) bool {
+	switch err.(type) {
+	case *requiredNotSetError, *proto.RequiredNotSetError:
+		return true
+	}
+	return false
+}
+
+// Marshal returns the wire format encoding of m, with fields in number
+// order and map entries sorted by key. If a required field is not set, it
+// returns the encoding of the rest of m along with an error.
+func (m *Message) Marshal() ([]byte, error) {
+	if m.typ == nil {
+		return nil, errNoType
+	}
+	p := proto.NewBuffer(nil)
+	err := m.encode(p)
+	if err != nil && !notSet(err) {
+		return nil, err
+	}
+	return p.Bytes(), err
+}
+
+// encode writes the fields of m to p.
+func (m *Message) encode(p *proto.Buffer) error {
+	var errNotSet error
+	record := func(err error) error {
+		if notSet(err) {
+			if errNotSet == nil {
+				errNotSet = err
+			}
+			return nil
+		}
+		return err
+	}
+	for _, f := range m.typ.ordered {
+		v, ok := m.get(f)
+		if !ok {
+			if f.required {
+				record(&requiredNotSetError{m.typ.name + "." + f.name})
+			}
+			continue
+		}
+		switch x := v.(type) {
+		case []interface{}:
+			if f.packed {
+				q := proto.NewBuffer(nil)
+				for _, e := range x {
+					encodeScalar(q, f.kind, e)
+				}
+				encodeTag(p, f.number, proto.WireBytes)
+				p.EncodeRawBytes(q.Bytes())
+				break
+			}
+			for _, e := range x {
+				if err := record(encodeValue(p, f, e)); err != nil {
+					return err
+				}
+			}
+		case map[interface{}]interface{}:
+			for _, k := range sortedKeys(x) {
+				q := proto.NewBuffer(nil)
+				encodeValue(q, f.key, k)
+				// Like generated code, leave out empty bytes values.
+				if b, ok := x[k].([]byte); !ok || len(b) > 0 {
+					if err := record(encodeValue(q, f.val, x[k])); err != nil {
+						return err
+					}
+				}
+				encodeTag(p, f.number, proto.WireBytes)
+				p.EncodeRawBytes(q.Bytes())
+			}
+		default:
+			if err := record(encodeValue(p, f, v)); err != nil {
+				return err
+			}
+		}
+	}
+	p.SetBuf(append(p.Bytes(), m.unknown...))
+	return errNotSet
+}
+
+// encodeValue writes a single value of f, with its tag.
+func encodeValue(p *proto.Buffer, f *field, v interface{}) error {
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		data, err := proto.Marshal(v.(proto.Message))
+		if err != nil && !notSet(err) {
+			return err
+		}
+		encodeTag(p, f.number, proto.WireBytes)
+		p.EncodeRawBytes(data)
+		return err
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		data, err := proto.Marshal(v.(proto.Message))
+		if err != nil && !notSet(err) {
+			return err
+		}
+		encodeTag(p, f.number, proto.WireStartGroup)
+		p.SetBuf(append(p.Bytes(), data...))
+		encodeTag(p, f.number, proto.WireEndGroup)
+		return err
+	}
+	encodeTag(p, f.number, wireType(f.kind))
+	encodeScalar(p, f.kind, v)
+	return nil
+}
+
+// encodeScalar writes a scalar value without a tag.
+func encodeScalar(p *proto.Buffer, kind descriptor.FieldDescriptorProto_Type, v interface{}) {
+	switch kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		p.EncodeFixed64(math.Float64bits(v.(float64)))
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		p.EncodeFixed32(uint64(math.Float32bits(v.(float32))))
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		p.EncodeVarint(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		p.EncodeVarint(v.(uint64))
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		p.EncodeVarint(uint64(v.(int32)))
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		p.EncodeVarint(uint64(v.(uint32)))
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		p.EncodeFixed64(v.(uint64))
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		p.EncodeFixed32(uint64(v.(uint32)))
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		p.EncodeFixed64(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		p.EncodeFixed32(uint64(uint32(v.(int32))))
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		p.EncodeZigzag64(uint64(v.(int64)))
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		p.EncodeZigzag32(uint64(v.(int32)))
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if v.(bool) {
+			p.EncodeVarint(1)
+		} else {
+			p.EncodeVarint(0)
+		}
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		p.EncodeStringBytes(v.(string))
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		p.EncodeRawBytes(v.([]byte))
+	default:
+		panic(fmt.Sprintf("dynamic: unexpected field type %v", kind))
+	}
+}
+
+// wireType returns the wire type of a single value of kind.
+func wireType(kind descriptor.FieldDescriptorProto_Type) int {
+	switch kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return proto.WireFixed64
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return proto.WireFixed32
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return proto.WireBytes
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return proto.WireStartGroup
+	}
+	return proto.WireVarint
+}
+
+func encodeTag(p *proto.Buffer, num int32, wire int) {
+	p.EncodeVarint(uint64(num)<<3 | uint64(wire))
+}
+
+// sortedKeys returns the keys of a map field in ascending order.
+func sortedKeys(m map[interface{}]interface{}) []interface{} {
+	keys := make([]interface{}, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Sort(keySlice(keys))
+	return keys
+}
+
+type keySlice []interface{}
+
+func (s keySlice) Len() int      { return len(s) }
+func (s keySlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s keySlice) Less(i, j int) bool {
+	b := s[j]
+	switch x := s[i].(type) {
+	case int32:
+		return x < b.(int32)
+	case int64:
+		return x < b.(int64)
+	case uint32:
+		return x < b.(uint32)
+	case uint64:
+		return x < b.(uint64)
+	case bool:
+		return !x && b.(bool)
+	case string:
+		return x < b.(string)
+	}
+	return false
+}
+
+// Unmarshal replaces the contents of m with the wire format message in b.
+// Fields that m's type does not declare are kept as unknown fields.
+func (m *Message) Unmarshal(b []byte) error {
+	if m.typ == nil {
+		return errNoType
+	}
+	m.Reset()
+	if err := m.unmarshal(b); err != nil {
+		return err
+	}
+	return m.checkRequired()
+}
+
+// checkRequired reports the first required field of m that is not set.
+func (m *Message) checkRequired() error {
+	for _, f := range m.typ.fields {
+		if _, ok := m.values[f.number]; f.required && !ok {
+			return &requiredNotSetError{m.typ.name + "." + f.name}
+		}
+	}
+	return nil
+}
+
+// unmarshal merges the wire format message in b into m.
+func (m *Message) unmarshal(b []byte) error {
+	var errNotSet, err error
+	serr := proto.Scan(b, func(rf proto.RawField) bool {
+		f := m.typ.byNumber[int32(rf.Number)]
+		if f == nil {
+			m.unknown = append(m.unknown, rf.Raw...)
+			return true
+		}
+		ferr := m.decodeField(f, rf)
+		if notSet(ferr) {
+			if errNotSet == nil {
+				errNotSet = ferr
+			}
+		} else if ferr != nil {
+			err = ferr
+			return false
+		}
+		return true
+	})
+	if serr != nil {
+		return serr
+	}
+	if err != nil {
+		return err
+	}
+	return errNotSet
+}
+
+// decodeField decodes the value of f in rf.
+func (m *Message) decodeField(f *field, rf proto.RawField) error {
+	if f.repeated && f.packable() && rf.WireType == proto.WireBytes {
+		n, err := packedLen(f.kind, rf.Value)
+		if err != nil {
+			return err
+		}
+		old, _ := m.values[f.number].([]interface{})
+		p := proto.NewBuffer(rf.Value)
+		for i := 0; i < n; i++ {
+			v, err := decodeScalar(f.kind, p)
+			if err != nil {
+				return err
+			}
+			old = append(old, v)
+		}
+		m.set(f, old)
+		return nil
+	}
+	if want := wireType(f.kind); rf.WireType != want {
+		return fmt.Errorf("proto: bad wiretype for field %s.%s: got wiretype %d, want %d", m.typ.name, f.name, rf.WireType, want)
+	}
+	if f.isMap() {
+		k, v, err := decodeEntry(f, rf.Value)
+		if err != nil && !notSet(err) {
+			return err
+		}
+		mv, _ := m.values[f.number].(map[interface{}]interface{})
+		if mv == nil {
+			mv = make(map[interface{}]interface{})
+		}
+		mv[k] = v
+		m.set(f, mv)
+		return err
+	}
+	var (
+		v   interface{}
+		err error
+	)
+	switch f.kind {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE, descriptor.FieldDescriptorProto_TYPE_GROUP:
+		var msg proto.Message
+		if old, ok := m.values[f.number].(proto.Message); ok && !f.repeated {
+			msg = old
+		} else {
+			msg = f.newMessage()
+		}
+		if err = unmarshalMerge(rf.Value, msg); err != nil && !notSet(err) {
+			return err
+		}
+		v = msg
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		v = string(rf.Value)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		v = append([]byte{}, rf.Value...)
+	default:
+		if v, err = decodeScalar(f.kind, proto.NewBuffer(rf.Value)); err != nil {
+			return err
+		}
+	}
+	if f.repeated {
+		old, _ := m.values[f.number].([]interface{})
+		v = append(old, v)
+	}
+	m.set(f, v)
+	return err
+}
+
+// unmarshalMerge merges the wire format message in b into msg.
+func unmarshalMerge(b []byte, msg proto.Message) error {
+	if dm, ok := msg.(*Message); ok {
+		if err := dm.unmarshal(b); err != nil {
+			return err
+		}
+		return dm.checkRequired()
+	}
+	return proto.UnmarshalMerge(b, msg)
+}
+
+// decodeEntry decodes a map entry of f.
+func decodeEntry(f *field, b []byte) (k, v interface{}, err error) {
+	entry := &Message{typ: f.msg}
+	err = entry.unmarshal(b)
+	if err != nil && !notSet(err) {
+		return nil, nil, err
+	}
+	k, _ = entry.values[1]
+	if k == nil {
+		k = f.key.zero()
+	}
+	v, _ = entry.values[2]
+	if v == nil {
+		if f.val.isMessage() {
+			v = f.val.newMessage()
+		} else {
+			v = f.val.zero()
+		}
+	}
+	return k, v, err
+}
+
+// packedLen returns the number of values of kind in the packed encoding b.
+func packedLen(kind descriptor.FieldDescriptorProto_Type, b []byte) (int, error) {
+	switch wireType(kind) {
+	case proto.WireFixed64:
+		if len(b)%8 != 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return len(b) / 8, nil
+	case proto.WireFixed32:
+		if len(b)%4 != 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return len(b) / 4, nil
+	}
+	// Each varint ends with the only one of its bytes that has the high
+	// bit clear.
+	if len(b) > 0 && b[len(b)-1] >= 0x80 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := 0
+	for _, c := range b {
+		if c < 0x80 {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// decodeScalar decodes a fixed-size or varint value of kind from p.
+func decodeScalar(kind descriptor.FieldDescriptorProto_Type, p *proto.Buffer) (interface{}, error) {
+	var (
+		x   uint64
+		err error
+	)
+	switch kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		x, err = p.DecodeFixed64()
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		x, err = p.DecodeFixed32()
+	case descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_SINT32:
+		x, err = p.DecodeZigzag64()
+	default:
+		x, err = p.DecodeVarint()
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return math.Float64frombits(x), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return math.Float32frombits(uint32(x)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return int64(x), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_ENUM,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return int32(x), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(x), nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return x != 0, nil
+	}
+	return x, nil
+}
@@ -72,6 +72,10 @@ type Marshaler struct {
 
 	// Whether to use the original (.proto) name for fields.
 	OrigName bool
+
+	// Registry resolves the message types of google.protobuf.Any values
+	// and the extensions to render. If nil, proto.GlobalRegistry is used.
+	Registry *proto.Registry
 }
 
 // JSONPBMarshaler is implemented by protobuf messages that customize the
@@ -127,6 +131,7 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 		return out.err
 	}
 
+	proto.ResolveLazy(v)
 	s := reflect.ValueOf(v).Elem()
 
 	// Handle well-known types.
@@ -153,6 +158,25 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			out.write(x)
 			out.write(`s"`)
 			return out.err
+		case "FieldMask":
+			// "In JSON, a field mask is encoded as a single string where paths are
+			//  separated by a comma. Fields name in each path are converted
+			//  to/from lower-camel naming conventions."
+			paths := s.Field(0).Interface().([]string)
+			camel := make([]string, len(paths))
+			for i, p := range paths {
+				c, err := fieldMaskPathToCamel(p)
+				if err != nil {
+					return err
+				}
+				camel[i] = c
+			}
+			b, err := json.Marshal(strings.Join(camel, ","))
+			if err != nil {
+				return err
+			}
+			out.write(string(b))
+			return out.err
 		case "Struct", "ListValue":
 			// Let marshalValue handle the `Struct.fields` map or the `ListValue.values` slice.
 			// TODO: pass the correct Properties if needed.
@@ -213,6 +237,15 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			}
 		}
 
+		// Like an unset oneof, an unset proto3 optional field is
+		// never emitted, even with EmitDefaults.
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Slice:
+			if value.IsNil() && jsonProperties(valueField, false).Proto3Optional {
+				continue
+			}
+		}
+
 		if !m.EmitDefaults {
 			switch value.Kind() {
 			case reflect.Bool:
@@ -261,7 +294,7 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 
 	// Handle proto2 extensions.
 	if ep, ok := v.(proto.Message); ok {
-		extensions := proto.RegisteredExtensions(v)
+		extensions := m.Registry.RegisteredExtensions(v)
 		// Sort extensions for stable output.
 		ids := make([]int32, 0, len(extensions))
 		for id, desc := range extensions {
@@ -326,7 +359,7 @@ func (m *Marshaler) marshalAny(out *errWriter, any proto.Message, indent string)
 	if slash := strings.LastIndex(mname, "/"); slash >= 0 {
 		mname = mname[slash+1:]
 	}
-	mt := proto.MessageType(mname)
+	mt := m.Registry.MessageType(mname)
 	if mt == nil {
 		return fmt.Errorf("unknown message type %q", mname)
 	}
@@ -553,6 +586,11 @@ type Unmarshaler struct {
 	// Whether to allow messages to contain unknown fields, as opposed to
 	// failing to unmarshal.
 	AllowUnknownFields bool
+
+	// Registry resolves the message types of google.protobuf.Any values,
+	// extension names and enum value names. If nil, proto.GlobalRegistry
+	// is used.
+	Registry *proto.Registry
 }
 
 // UnmarshalNext unmarshals the next protocol buffer from a JSON object stream.
@@ -644,7 +682,7 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			if slash := strings.LastIndex(mname, "/"); slash >= 0 {
 				mname = mname[slash+1:]
 			}
-			mt := proto.MessageType(mname)
+			mt := u.Registry.MessageType(mname)
 			if mt == nil {
 				return fmt.Errorf("unknown message type %q", mname)
 			}
@@ -719,6 +757,28 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			target.Field(0).SetInt(int64(t.Unix()))
 			target.Field(1).SetInt(int64(t.Nanosecond()))
 			return nil
+		case "FieldMask":
+			if string(inputValue) == "null" {
+				target.Field(0).Set(reflect.Zero(target.Field(0).Type()))
+				return nil
+			}
+
+			var str string
+			if err := json.Unmarshal(inputValue, &str); err != nil {
+				return fmt.Errorf("bad FieldMask: %v", err)
+			}
+			var paths []string
+			if str != "" {
+				for _, p := range strings.Split(str, ",") {
+					sp, err := fieldMaskPathFromCamel(p)
+					if err != nil {
+						return err
+					}
+					paths = append(paths, sp)
+				}
+			}
+			target.Field(0).Set(reflect.ValueOf(paths))
+			return nil
 		case "Struct":
 			if string(inputValue) == "null" {
 				// Interpret a null struct as empty.
@@ -783,7 +843,7 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 	// The case of an enum appearing as a number is handled
 	// at the bottom of this function.
 	if inputValue[0] == '"' && prop != nil && prop.Enum != "" {
-		vmap := proto.EnumValueMap(prop.Enum)
+		vmap := u.Registry.EnumValueMap(prop.Enum)
 		// Don't need to do unquoting; valid enum names
 		// are from a limited character set.
 		s := inputValue[1 : len(inputValue)-1]
@@ -861,7 +921,7 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 		// Handle proto2 extensions.
 		if len(jsonFields) > 0 {
 			if ep, ok := target.Addr().Interface().(proto.Message); ok {
-				for _, ext := range proto.RegisteredExtensions(ep) {
+				for _, ext := range u.Registry.RegisteredExtensions(ep) {
 					name := fmt.Sprintf("[%s]", ext.Name)
 					raw, ok := jsonFields[name]
 					if !ok {
@@ -976,6 +1036,46 @@ func acceptedJSONFieldNames(prop *proto.Properties) fieldNames {
 	return opts
 }
 
+// fieldMaskPathToCamel converts a FieldMask path such as "user.display_name"
+// to its JSON form "user.displayName". Paths that would not convert back
+// unchanged are rejected.
+func fieldMaskPathToCamel(path string) (string, error) {
+	b := make([]byte, 0, len(path))
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case 'A' <= c && c <= 'Z':
+			return "", fmt.Errorf("field mask path %q can't be represented in JSON", path)
+		case c == '_':
+			if i+1 >= len(path) || path[i+1] < 'a' || path[i+1] > 'z' {
+				return "", fmt.Errorf("field mask path %q can't be represented in JSON", path)
+			}
+			i++
+			b = append(b, path[i]-'a'+'A')
+		default:
+			b = append(b, c)
+		}
+	}
+	return string(b), nil
+}
+
+// fieldMaskPathFromCamel is the inverse of fieldMaskPathToCamel.
+func fieldMaskPathFromCamel(path string) (string, error) {
+	b := make([]byte, 0, len(path)+4)
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '_':
+			return "", fmt.Errorf("bad FieldMask: path %q is not lowerCamelCase", path)
+		case 'A' <= c && c <= 'Z':
+			b = append(b, '_', c-'A'+'a')
+		default:
+			b = append(b, c)
+		}
+	}
+	return string(b), nil
+}
+
 // Writer wrapper inspired by https://blog.golang.org/errors-are-values
 type errWriter struct {
 	writer io.Writer
@@ -45,6 +45,7 @@ import (
 	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
 	anypb "github.com/golang/protobuf/ptypes/any"
 	durpb "github.com/golang/protobuf/ptypes/duration"
+	fmpb "github.com/golang/protobuf/ptypes/field_mask"
 	stpb "github.com/golang/protobuf/ptypes/struct"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	wpb "github.com/golang/protobuf/ptypes/wrappers"
@@ -348,6 +349,10 @@ var marshalingTests = []struct {
 			proto3pb.Message_SLAPSTICK,
 		}},
 		`{"rFunny":[1,2]}`},
+	{"proto3 optional unset", Marshaler{EmitDefaults: true}, &proto3pb.OptionalScalars{}, `{}`},
+	{"proto3 optional zero values", Marshaler{},
+		&proto3pb.OptionalScalars{Count: proto.Int32(0), Label: proto.String(""), Blob: []byte{}},
+		`{"count":0,"label":"","blob":""}`},
 	{"empty value", marshaler, &pb.Simple3{}, `{}`},
 	{"empty value emitted", Marshaler{EmitDefaults: true}, &pb.Simple3{}, `{"dub":0}`},
 	{"empty repeated emitted", Marshaler{EmitDefaults: true}, &pb.SimpleSlice3{}, `{"slices":[]}`},
@@ -387,6 +392,8 @@ var marshalingTests = []struct {
 	{"Any with WKT", marshaler, anyWellKnown, anyWellKnownJSON},
 	{"Any with WKT and indent", marshalerAllOptions, anyWellKnown, anyWellKnownPrettyJSON},
 	{"Duration", marshaler, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 3}}, `{"dur":"3.000s"}`},
+	{"FieldMask", marshaler, &fmpb.FieldMask{Paths: []string{"user.display_name", "photo"}}, `"user.displayName,photo"`},
+	{"empty FieldMask", marshaler, &fmpb.FieldMask{}, `""`},
 	{"Struct", marshaler, &pb.KnownTypes{St: &stpb.Struct{
 		Fields: map[string]*stpb.Value{
 			"one": {Kind: &stpb.Value_StringValue{"loneliest number"}},
@@ -442,6 +449,14 @@ func TestMarshaling(t *testing.T) {
 	}
 }
 
+func TestMarshalingFieldMaskBadPath(t *testing.T) {
+	for _, path := range []string{"displayName", "display__name", "display_name_", "x_1"} {
+		if _, err := marshaler.MarshalToString(&fmpb.FieldMask{Paths: []string{path}}); err == nil {
+			t.Errorf("FieldMask path %q: no error", path)
+		}
+	}
+}
+
 func TestMarshalingWithJSONPBMarshaler(t *testing.T) {
 	rawJson := `{ "foo": "bar", "baz": [0, 1, 2, 3] }`
 	msg := dynamicMessage{rawJson: rawJson}
@@ -471,6 +486,8 @@ var unmarshalingTests = []struct {
 	{"unknown field with allowed option", Unmarshaler{AllowUnknownFields: true}, `{"unknown": "foo"}`, new(pb.Simple)},
 	{"proto3 enum string", Unmarshaler{}, `{"hilarity":"PUNS"}`, &proto3pb.Message{Hilarity: proto3pb.Message_PUNS}},
 	{"proto3 enum value", Unmarshaler{}, `{"hilarity":1}`, &proto3pb.Message{Hilarity: proto3pb.Message_PUNS}},
+	{"proto3 optional zero values", Unmarshaler{}, `{"count":0,"enabled":false}`,
+		&proto3pb.OptionalScalars{Count: proto.Int32(0), Enabled: proto.Bool(false)}},
 	{"unknown enum value object",
 		Unmarshaler{},
 		"{\n  \"color\": 1000,\n  \"r_color\": [\n    \"RED\"\n  ]\n}",
@@ -513,6 +530,10 @@ var unmarshalingTests = []struct {
 
 	{"Duration", Unmarshaler{}, `{"dur":"3.000s"}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 3}}},
 	{"null Duration", Unmarshaler{}, `{"dur":null}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 0}}},
+	{"FieldMask", Unmarshaler{}, `"user.displayName,photo"`, &fmpb.FieldMask{Paths: []string{"user.display_name", "photo"}}},
+	{"empty FieldMask", Unmarshaler{}, `""`, &fmpb.FieldMask{}},
+	{"FieldMask in Any", Unmarshaler{}, `{"@type":"type.googleapis.com/google.protobuf.FieldMask","value":"a.bC"}`,
+		&anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.FieldMask", Value: []byte("\n\x05a.b_c")}},
 	{"Timestamp", Unmarshaler{}, `{"ts":"2014-05-13T16:53:20.021Z"}`, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}}},
 	{"PreEpochTimestamp", Unmarshaler{}, `{"ts":"1969-12-31T23:59:58.999999995Z"}`, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: -2, Nanos: 999999995}}},
 	{"ZeroTimeTimestamp", Unmarshaler{}, `{"ts":"0001-01-01T00:00:00Z"}`, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: -62135596800, Nanos: 0}}},
@@ -640,6 +661,8 @@ var unmarshalingShouldError = []struct {
 	{"gibberish", "{adskja123;l23=-=", new(pb.Simple)},
 	{"unknown field", `{"unknown": "foo"}`, new(pb.Simple)},
 	{"unknown enum name", `{"hilarity":"DAVE"}`, new(proto3pb.Message)},
+	{"FieldMask with underscore", `"user.display_name"`, new(fmpb.FieldMask)},
+	{"FieldMask not a string", `["photo"]`, new(fmpb.FieldMask)},
 }
 
 func TestUnmarshalingBadInput(t *testing.T) {
@@ -663,6 +686,42 @@ func TestUnmarshalWithJSONPBUnmarshaler(t *testing.T) {
 	}
 }
 
+func TestRegistry(t *testing.T) {
+	reg := proto.NewRegistry()
+	if err := reg.RegisterType((*pb.Simple)(nil), "test.Simple"); err != nil {
+		t.Fatal(err)
+	}
+	msg := &pb.KnownTypes{
+		An: &anypb.Any{
+			TypeUrl: "type.googleapis.com/test.Simple",
+			Value:   anySimple.An.Value,
+		},
+	}
+	const want = `{"an":{"@type":"type.googleapis.com/test.Simple","oBool":true}}`
+
+	if _, err := new(Marshaler).MarshalToString(msg); err == nil {
+		t.Error("Marshal resolved an Any type only present in a private registry")
+	}
+	got, err := (&Marshaler{Registry: reg}).MarshalToString(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if err := UnmarshalString(want, new(pb.KnownTypes)); err == nil {
+		t.Error("Unmarshal resolved an Any type only present in a private registry")
+	}
+	out := new(pb.KnownTypes)
+	if err := (&Unmarshaler{Registry: reg}).Unmarshal(strings.NewReader(want), out); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(out, msg) {
+		t.Errorf("got %v, want %v", out, msg)
+	}
+}
+
 // dynamicMessage implements protobuf.Message but is not a normal generated message type.
 // It provides implementations of JSONPBMarshaler and JSONPBUnmarshaler for JSON support.
 type dynamicMessage struct {
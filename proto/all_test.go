@@ -45,6 +45,8 @@ import (
 	"time"
 
 	. "github.com/golang/protobuf/proto"
+	fpb "github.com/golang/protobuf/proto/fastpath_proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
 	. "github.com/golang/protobuf/proto/testdata"
 )
 
@@ -1946,6 +1948,193 @@ func TestMapFieldMarshal(t *testing.T) {
 	(new(Buffer)).DebugPrint("Dump of b", b)
 }
 
+func TestMapFieldMarshalDeterministic(t *testing.T) {
+	m := &MessageWithMap{
+		NameMapping: map[int32]string{
+			8: "Dave",
+			1: "Rob",
+			4: "Ian",
+		},
+	}
+	b, err := MarshalDeterministic(m)
+	if err != nil {
+		t.Fatalf("MarshalDeterministic: %v", err)
+	}
+	want := "\n\a\b\x01\x12\x03Rob" + "\n\a\b\x04\x12\x03Ian" + "\n\b\b\x08\x12\x04Dave"
+	if string(b) != want {
+		t.Errorf("MarshalDeterministic:\n got %q\nwant %q", b, want)
+	}
+
+	m = &MessageWithMap{
+		NameMapping: make(map[int32]string),
+		MsgMapping:  make(map[int64]*FloatingPoint),
+		ByteMapping: map[bool][]byte{true: []byte("yes"), false: []byte("no")},
+		StrToStr:    make(map[string]string),
+	}
+	for i := 0; i < 50; i++ {
+		m.NameMapping[int32(i*7-100)] = fmt.Sprint(i)
+		m.MsgMapping[int64(i*13-300)] = &FloatingPoint{F: Float64(float64(i))}
+		m.StrToStr[fmt.Sprint("k", i)] = fmt.Sprint("v", i)
+	}
+	want0, err := MarshalDeterministic(m)
+	if err != nil {
+		t.Fatalf("MarshalDeterministic: %v", err)
+	}
+	if len(want0) != Size(m) {
+		t.Errorf("len(MarshalDeterministic(m)) = %d, want Size(m) = %d", len(want0), Size(m))
+	}
+	for i := 0; i < 1000; i++ {
+		b, err := MarshalDeterministic(m)
+		if err != nil {
+			t.Fatalf("MarshalDeterministic: %v", err)
+		}
+		if !bytes.Equal(b, want0) {
+			t.Fatalf("MarshalDeterministic #%d differs from #0:\n got %q\nwant %q", i, b, want0)
+		}
+	}
+}
+
+func TestExtensionMarshalDeterministic(t *testing.T) {
+	extMap := &ExtensionDesc{
+		ExtendedType:  (*MyMessage)(nil),
+		ExtensionType: (*MessageWithMap)(nil),
+		Field:         200,
+		Name:          "testdata.map_ext",
+		Tag:           "bytes,200,opt,name=map_ext",
+	}
+	mm := &MessageWithMap{NameMapping: make(map[int32]string)}
+	for i := 0; i < 50; i++ {
+		mm.NameMapping[int32(i)] = fmt.Sprint(i)
+	}
+	m := &MyMessage{Count: Int32(4)}
+	if err := SetExtension(m, extMap, mm); err != nil {
+		t.Fatal(err)
+	}
+	inner, err := MarshalDeterministic(mm)
+	if err != nil {
+		t.Fatalf("MarshalDeterministic: %v", err)
+	}
+	// Extensions are written before the other fields.
+	want := NewBuffer(nil)
+	want.EncodeVarint(200<<3 | WireBytes)
+	want.EncodeRawBytes(inner)
+	want.EncodeVarint(1<<3 | WireVarint)
+	want.EncodeVarint(4)
+	for i := 0; i < 100; i++ {
+		b, err := MarshalDeterministic(m)
+		if err != nil {
+			t.Fatalf("MarshalDeterministic: %v", err)
+		}
+		if !bytes.Equal(b, want.Bytes()) {
+			t.Fatalf("MarshalDeterministic #%d:\n got %q\nwant %q", i, b, want.Bytes())
+		}
+	}
+}
+
+func TestMarshalDeterministicUnrecognized(t *testing.T) {
+	// Unknown fields 3, 2, 3 (a varint, a string and another varint).
+	unrec := []byte("\x18\x01\x12\x02hi\x18\x02")
+	m := &OldMessage_Nested{
+		Name:             String("Nigel"),
+		XXX_unrecognized: unrec,
+	}
+	b, err := Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := "\x0a\x05Nigel" + string(unrec); string(b) != want {
+		t.Errorf("Marshal:\n got %q\nwant %q", b, want)
+	}
+
+	b, err = MarshalDeterministic(m)
+	if err != nil {
+		t.Fatalf("MarshalDeterministic: %v", err)
+	}
+	if want := "\x0a\x05Nigel" + "\x12\x02hi\x18\x01\x18\x02"; string(b) != want {
+		t.Errorf("MarshalDeterministic:\n got %q\nwant %q", b, want)
+	}
+	if string(m.XXX_unrecognized) != string(unrec) {
+		t.Errorf("MarshalDeterministic modified XXX_unrecognized: %q", m.XXX_unrecognized)
+	}
+}
+
+func TestMarshalAppend(t *testing.T) {
+	pb := initGoTest(true)
+	want, err := Marshal(pb)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	prefix := []byte("prefix")
+	dst := append(make([]byte, 0, 2*len(want)), prefix...)
+	b, err := MarshalAppend(dst, pb)
+	if err != nil {
+		t.Fatalf("MarshalAppend: %v", err)
+	}
+	if !bytes.Equal(b, append(prefix, want...)) {
+		t.Errorf("MarshalAppend:\n got %q\nwant %q", b, append(prefix, want...))
+	}
+	if &b[0] != &dst[:1][0] {
+		t.Error("MarshalAppend reallocated dst despite sufficient capacity")
+	}
+
+	// A second call must not disturb the result of the first.
+	saved := append([]byte(nil), b...)
+	if _, err := MarshalAppend(nil, initGoTest(false)); err != nil {
+		t.Fatalf("MarshalAppend: %v", err)
+	}
+	if !bytes.Equal(b, saved) {
+		t.Error("MarshalAppend result changed by a later call")
+	}
+
+	// Errors still return what was written.
+	b, err = MarshalAppend(prefix, new(GoTest))
+	if _, ok := err.(*RequiredNotSetError); !ok {
+		t.Errorf("MarshalAppend of incomplete message: err = %v; want RequiredNotSetError", err)
+	}
+	if !bytes.HasPrefix(b, prefix) {
+		t.Errorf("MarshalAppend of incomplete message dropped dst: %q", b)
+	}
+}
+
+func TestStats(t *testing.T) {
+	SetStatsEnabled(true)
+	defer SetStatsEnabled(false)
+
+	const n = 100
+	pb := initGoTest(true)
+	before := GetStats()
+	for i := 0; i < n; i++ {
+		b, err := Marshal(pb)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		if err := Unmarshal(b, new(GoTest)); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+	}
+	after := GetStats()
+	if got := after.Encode - before.Encode; got != n {
+		t.Errorf("Encode count = %d; want %d", got, n)
+	}
+	if got := after.Decode - before.Decode; got < n {
+		t.Errorf("Decode count = %d; want at least %d", got, n)
+	}
+	gets, news := after.PoolGet-before.PoolGet, after.PoolNew-before.PoolNew
+	if gets < 2*n {
+		t.Errorf("PoolGet count = %d; want at least %d", gets, 2*n)
+	}
+	if news > gets {
+		t.Errorf("PoolNew count = %d exceeds PoolGet count %d", news, gets)
+	}
+
+	SetStatsEnabled(false)
+	before = GetStats()
+	Marshal(pb)
+	if after := GetStats(); after != before {
+		t.Errorf("stats changed while disabled: %+v -> %+v", before, after)
+	}
+}
+
 func TestMapFieldRoundTrips(t *testing.T) {
 	m := &MessageWithMap{
 		NameMapping: map[int32]string{
@@ -2132,6 +2321,138 @@ func TestInefficientPackedBool(t *testing.T) {
 	}
 }
 
+func TestTableMarshalMatchesReflect(t *testing.T) {
+	ext := &MyMessage{Count: Int32(4)}
+	if err := SetExtension(ext, E_Ext_More, &Ext{Data: String("extension")}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []Message{
+		initGoTest(true),
+		testMsg(),
+		bytesMsg(),
+		ext,
+		&MoreRepeated{Bools: []bool{true, false}, BoolsPacked: []bool{false, true}, IntsPacked: []int32{-1, 300}, Int64SPacked: []int64{-1, 1 << 40}, Fixeds: []uint32{5}},
+		&GroupNew{G: &GroupNew_G{X: Int32(7), Y: Int32(8)}},
+		&MessageWithMap{NameMapping: map[int32]string{1: "one"}},
+		&Communique{Union: &Communique_Msg{&Strings{StringField: String("deep")}}},
+		&MessageList{Message: []*MessageList_Message{{Name: String("a"), Count: Int32(1)}}},
+		nestedMsg(20),
+		nestedOneofMsg(20),
+	}
+	for _, pb := range tests {
+		want, err := MarshalReflect(pb)
+		if err != nil {
+			t.Errorf("MarshalReflect(%T): %v", pb, err)
+			continue
+		}
+		got, err := Marshal(pb)
+		if err != nil {
+			t.Errorf("Marshal(%T): %v", pb, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Marshal(%T) = %x, want %x", pb, got, want)
+		}
+		if n, want := Size(pb), SizeReflect(pb); n != want {
+			t.Errorf("Size(%T) = %d, want %d", pb, n, want)
+		}
+	}
+}
+
+// countingMarshaler counts the calls to its Marshal method.
+type countingMarshaler struct {
+	fakeMarshaler
+	calls int
+}
+
+func (c *countingMarshaler) Marshal() ([]byte, error) {
+	c.calls++
+	return c.fakeMarshaler.Marshal()
+}
+
+// sizingMarshaler is a countingMarshaler that also reports its size.
+type sizingMarshaler struct {
+	countingMarshaler
+	sizes int
+}
+
+func (s *sizingMarshaler) Size() int {
+	s.sizes++
+	return len(s.b)
+}
+
+type msgWithCountingMarshalers struct {
+	M []*countingMarshaler `protobuf:"bytes,1,rep,name=m"`
+	S *sizingMarshaler     `protobuf:"bytes,2,opt,name=s"`
+}
+
+func (m *msgWithCountingMarshalers) String() string { return CompactTextString(m) }
+func (m *msgWithCountingMarshalers) ProtoMessage()  {}
+func (m *msgWithCountingMarshalers) Reset()         {}
+
+// Sub-messages that implement Marshaler are encoded once per Marshal.
+func TestMarshalerEncodedOnce(t *testing.T) {
+	m := &msgWithCountingMarshalers{
+		M: []*countingMarshaler{
+			{fakeMarshaler: fakeMarshaler{b: []byte{8, 1}}},
+			{fakeMarshaler: fakeMarshaler{b: []byte{8, 2}}},
+		},
+		S: &sizingMarshaler{countingMarshaler: countingMarshaler{fakeMarshaler: fakeMarshaler{b: []byte{8, 3}}}},
+	}
+	got, err := Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{10, 2, 8, 1, 10, 2, 8, 2, 18, 2, 8, 3}; !bytes.Equal(got, want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+	for i, c := range m.M {
+		if c.calls != 1 {
+			t.Errorf("M[%d].Marshal called %d times, want 1", i, c.calls)
+		}
+	}
+	if m.S.calls != 1 || m.S.sizes != 1 {
+		t.Errorf("S: Marshal called %d times and Size %d times, want 1 and 1", m.S.calls, m.S.sizes)
+	}
+}
+
+// nestedSizers nests messages whose sub-messages report their own size.
+type nestedSizers struct {
+	Next *nestedSizers    `protobuf:"bytes,1,opt,name=next"`
+	S    *sizingMarshaler `protobuf:"bytes,2,opt,name=s"`
+}
+
+func (m *nestedSizers) String() string { return CompactTextString(m) }
+func (m *nestedSizers) ProtoMessage()  {}
+func (m *nestedSizers) Reset()         {}
+
+// Each sub-message is sized once per Marshal or Size, however deeply it is
+// nested.
+func TestSizedOncePerMarshal(t *testing.T) {
+	var m *nestedSizers
+	var leaves []*sizingMarshaler
+	for i := 0; i < 20; i++ {
+		s := &sizingMarshaler{countingMarshaler: countingMarshaler{fakeMarshaler: fakeMarshaler{b: []byte{8, byte(i)}}}}
+		leaves = append(leaves, s)
+		m = &nestedSizers{Next: m, S: s}
+	}
+	if _, err := Marshal(m); err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range leaves {
+		if s.sizes != 1 || s.calls != 1 {
+			t.Errorf("level %d: Size called %d times and Marshal %d times by Marshal, want 1 and 1", len(leaves)-i, s.sizes, s.calls)
+		}
+		s.sizes, s.calls = 0, 0
+	}
+	Size(m)
+	for i, s := range leaves {
+		if s.sizes != 1 || s.calls != 0 {
+			t.Errorf("level %d: Size called %d times and Marshal %d times by Size, want 1 and 0", len(leaves)-i, s.sizes, s.calls)
+		}
+	}
+}
+
 // Benchmarks
 
 func testMsg() *GoTest {
@@ -2146,6 +2467,38 @@ func testMsg() *GoTest {
 	return pb
 }
 
+// nestedMsg returns a message nested depth levels deep. Every level also
+// holds sub-messages in a repeated field and a map.
+func nestedMsg(depth int) *proto3pb.Message {
+	m := &proto3pb.Message{Name: "leaf"}
+	for i := 0; i < depth; i++ {
+		m = &proto3pb.Message{
+			Name:        "level",
+			ResultCount: int64(i),
+			Submessage:  m,
+			Children:    []*proto3pb.Message{{Name: "child", Key: []uint64{uint64(i)}}},
+			Terrain:     map[string]*proto3pb.Nested{"k": {Bunny: "bunny"}},
+		}
+	}
+	return m
+}
+
+// nestedOneofMsg returns a message nested depth levels deep, where every
+// level also sets a oneof sub-message and a group.
+func nestedOneofMsg(depth int) *fpb.Outer {
+	m := &fpb.Outer{Id: Int32(0)}
+	for i := 1; i <= depth; i++ {
+		m = &fpb.Outer{
+			Id:     Int32(int32(i)),
+			Child:  m,
+			Choice: &fpb.Outer_Nested{Nested: &fpb.Inner{Values: []int64{int64(i)}}},
+			Group:  &fpb.Outer_Group{Value: Int32(int32(i))},
+			Inners: []*fpb.Inner{{Id: Int32(int32(i))}},
+		}
+	}
+	return m
+}
+
 func bytesMsg() *GoTest {
 	pb := initGoTest(true)
 	buf := make([]byte, 4000)
@@ -2174,6 +2527,15 @@ func benchmarkBufferMarshal(b *testing.B, pb Message) {
 	})
 }
 
+func benchmarkMarshalAppend(b *testing.B, pb Message) {
+	var buf []byte
+	benchmarkMarshal(b, pb, func(pb0 Message) ([]byte, error) {
+		var err error
+		buf, err = MarshalAppend(buf[:0], pb0)
+		return buf, err
+	})
+}
+
 func benchmarkSize(b *testing.B, pb Message) {
 	benchmarkMarshal(b, pb, func(pb0 Message) ([]byte, error) {
 		Size(pb)
@@ -2208,7 +2570,7 @@ func benchmarkBufferUnmarshal(b *testing.B, pb Message) {
 	})
 }
 
-// Benchmark{Marshal,BufferMarshal,Size,Unmarshal,BufferUnmarshal}{,Bytes}
+// Benchmark{Marshal,BufferMarshal,MarshalAppend,Size,Unmarshal,BufferUnmarshal}{,Bytes}
 
 func BenchmarkMarshal(b *testing.B) {
 	benchmarkMarshal(b, testMsg(), Marshal)
@@ -2218,10 +2580,37 @@ func BenchmarkBufferMarshal(b *testing.B) {
 	benchmarkBufferMarshal(b, testMsg())
 }
 
+func BenchmarkMarshalAppend(b *testing.B) {
+	benchmarkMarshalAppend(b, testMsg())
+}
+
 func BenchmarkSize(b *testing.B) {
 	benchmarkSize(b, testMsg())
 }
 
+func BenchmarkMarshalNested(b *testing.B) {
+	benchmarkMarshal(b, nestedMsg(100), Marshal)
+}
+
+func BenchmarkBufferMarshalNested(b *testing.B) {
+	benchmarkBufferMarshal(b, nestedMsg(100))
+}
+
+func BenchmarkSizeNested(b *testing.B) {
+	benchmarkSize(b, nestedMsg(100))
+}
+
+func BenchmarkMarshalReflect(b *testing.B) {
+	benchmarkMarshal(b, testMsg(), MarshalReflect)
+}
+
+func BenchmarkSizeReflect(b *testing.B) {
+	benchmarkMarshal(b, testMsg(), func(pb Message) ([]byte, error) {
+		SizeReflect(pb)
+		return nil, nil
+	})
+}
+
 func BenchmarkUnmarshal(b *testing.B) {
 	benchmarkUnmarshal(b, testMsg(), Unmarshal)
 }
@@ -2242,6 +2631,10 @@ func BenchmarkSizeBytes(b *testing.B) {
 	benchmarkSize(b, bytesMsg())
 }
 
+func BenchmarkMarshalReflectBytes(b *testing.B) {
+	benchmarkMarshal(b, bytesMsg(), MarshalReflect)
+}
+
 func BenchmarkUnmarshalBytes(b *testing.B) {
 	benchmarkUnmarshal(b, bytesMsg(), Unmarshal)
 }
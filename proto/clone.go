@@ -48,15 +48,28 @@ func Clone(pb Message) Message {
 	}
 
 	out := reflect.New(in.Type().Elem())
+	if m, ok := out.Interface().(Merger); ok {
+		m.Merge(pb)
+		return m.(Message)
+	}
 	// out is empty so a merge is a deep copy.
 	mergeStruct(out.Elem(), in.Elem())
 	return out.Interface().(Message)
 }
 
+// Merger is the interface representing messages that can merge another
+// message of the same type into themselves, such as messages whose fields
+// are not described by struct tags. Merge and Clone use it in place of
+// reflection; Clone calls it on a newly allocated zero value.
+type Merger interface {
+	Merge(src Message)
+}
+
 // Merge merges src into dst.
 // Required and optional fields that are set in src will be set to that value in dst.
 // Elements of repeated fields will be appended.
 // Merge panics if src and dst are not the same type, or if dst is nil.
+// If dst implements Merger, its Merge method does the work.
 func Merge(dst, src Message) {
 	in := reflect.ValueOf(src)
 	out := reflect.ValueOf(dst)
@@ -71,10 +84,16 @@ func Merge(dst, src Message) {
 		// Merging nil into non-nil is a quiet no-op
 		return
 	}
+	if m, ok := dst.(Merger); ok {
+		m.Merge(src)
+		return
+	}
 	mergeStruct(out.Elem(), in.Elem())
 }
 
 func mergeStruct(out, in reflect.Value) {
+	resolveLazy(out)
+	resolveLazy(in)
 	sprop := GetProperties(in.Type())
 	for i := 0; i < in.NumField(); i++ {
 		f := in.Type().Field(i)
@@ -86,13 +105,7 @@ func mergeStruct(out, in reflect.Value) {
 
 	if emIn, ok := extendable(in.Addr().Interface()); ok {
 		emOut, _ := extendable(out.Addr().Interface())
-		mIn, muIn := emIn.extensionsRead()
-		if mIn != nil {
-			mOut := emOut.extensionsWrite()
-			muIn.Lock()
-			mergeExtension(mOut, mIn)
-			muIn.Unlock()
-		}
+		mergeExtensions(emOut, emIn)
 	}
 
 	uf := in.FieldByName("XXX_unrecognized")
@@ -211,6 +224,28 @@ func mergeAny(out, in reflect.Value, viaPtr bool, prop *Properties) {
 	}
 }
 
+// XXX_MergeExtensions merges the extensions of src into dst, which must be
+// the same extendable message type.
+// It is for the internal use of Merge methods generated with fastpath=true.
+func XXX_MergeExtensions(dst, src Message) {
+	emIn, ok := extendable(src)
+	if !ok {
+		return
+	}
+	emOut, _ := extendable(dst)
+	mergeExtensions(emOut, emIn)
+}
+
+func mergeExtensions(emOut, emIn extendableProto) {
+	mIn, muIn := emIn.extensionsRead()
+	if mIn != nil {
+		mOut := emOut.extensionsWrite()
+		muIn.Lock()
+		mergeExtension(mOut, mIn)
+		muIn.Unlock()
+	}
+}
+
 func mergeExtension(out, in map[int32]Extension) {
 	for extNum, eIn := range in {
 		eOut := Extension{desc: eIn.desc}
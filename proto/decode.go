@@ -41,6 +41,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"sync/atomic"
 )
 
 // errOverflow is returned when an integer is too large to be represented.
@@ -50,6 +51,94 @@ var errOverflow = errors.New("proto: integer overflow")
 // wire type is encountered. It does not get returned to user code.
 var ErrInternalBadWireType = errors.New("proto: internal error: bad wiretype for oneof")
 
+// DecodeLimitError is the error returned by Unmarshal when the input exceeds
+// one of the limits set with Buffer.SetMaxDepth, Buffer.SetMaxMessageSize or
+// Buffer.SetMaxRepeatedCount.
+//
+// The limits apply only to the messages this package decodes. A message
+// that implements Unmarshaler decodes itself, whether it is the message
+// being unmarshaled or embedded in one, so neither it nor anything inside
+// it is checked against the limits.
+type DecodeLimitError struct {
+	// Field is the path of the field that exceeded the limit, relative to
+	// the message being unmarshaled, in .proto field names with the index
+	// of repeated field elements, like "children[2].submessage". It is
+	// empty if the message as a whole is too large.
+	Field string
+	// Limit describes the limit that was exceeded: "depth", "size" or
+	// "repeated count".
+	Limit string
+	// Max is the configured value of the limit.
+	Max int
+}
+
+func (e *DecodeLimitError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("proto: message exceeds maximum %s %d", e.Limit, e.Max)
+	}
+	return fmt.Sprintf("proto: field %q exceeds maximum %s %d", e.Field, e.Limit, e.Max)
+}
+
+// addFieldContext prefixes the path of a *DecodeLimitError with name, the
+// field of the enclosing message that was being decoded. Other errors are
+// returned unchanged.
+func addFieldContext(err error, name string) error {
+	if le, ok := err.(*DecodeLimitError); ok {
+		switch {
+		case le.Field == "":
+			le.Field = name
+		case le.Field[0] == '[':
+			le.Field = name + le.Field
+		default:
+			le.Field = name + "." + le.Field
+		}
+	}
+	return err
+}
+
+// addIndexContext prefixes the path of a *DecodeLimitError with the index i
+// of the repeated field element that was being decoded. Other errors are
+// returned unchanged.
+func addIndexContext(err error, i int) error {
+	if _, ok := err.(*DecodeLimitError); ok {
+		return addFieldContext(err, fmt.Sprintf("[%d]", i))
+	}
+	return err
+}
+
+// checkSize reports whether the undecoded input is within the size limit.
+func (o *Buffer) checkSize() error {
+	if o.maxSize > 0 && len(o.buf)-o.index > o.maxSize {
+		return &DecodeLimitError{Limit: "size", Max: o.maxSize}
+	}
+	return nil
+}
+
+// checkRepeated reports whether a repeated field holding n elements is
+// within the repeated count limit.
+func (o *Buffer) checkRepeated(n int) error {
+	if o.maxRepeated > 0 && n > o.maxRepeated {
+		return &DecodeLimitError{Limit: "repeated count", Max: o.maxRepeated}
+	}
+	return nil
+}
+
+// enter records that decoding is descending into an embedded message or
+// group, and reports whether doing so exceeds the depth limit. Each
+// successful call must be matched by a call to leave.
+func (o *Buffer) enter() error {
+	if o.maxDepth > 0 && o.depth >= o.maxDepth {
+		return &DecodeLimitError{Limit: "depth", Max: o.maxDepth}
+	}
+	o.depth++
+	return nil
+}
+
+// leave undoes a successful call to enter.
+func (o *Buffer) leave() {
+	o.depth--
+}
+
 // The fundamental decoders that interpret bytes on the wire.
 // Those that take integer types all return uint64 and are
 // therefore of type valueDecoder.
@@ -290,7 +379,7 @@ func (p *Buffer) DecodeRawBytes(alloc bool) (buf []byte, err error) {
 
 	if !alloc {
 		// todo: check if can get more uses of alloc=false
-		buf = p.buf[p.index:end]
+		buf = p.buf[p.index:end:end]
 		p.index += nb
 		return
 	}
@@ -322,7 +411,7 @@ func (o *Buffer) skipAndSave(t reflect.Type, tag, wire int, base structPointer,
 		return err
 	}
 
-	if !unrecField.IsValid() {
+	if !unrecField.IsValid() || o.discardUnknown {
 		return nil
 	}
 
@@ -409,7 +498,11 @@ func UnmarshalMerge(buf []byte, pb Message) error {
 	if u, ok := pb.(Unmarshaler); ok {
 		return u.Unmarshal(buf)
 	}
-	return NewBuffer(buf).Unmarshal(pb)
+	p := getBuffer()
+	p.buf = buf
+	err := p.Unmarshal(pb)
+	putBuffer(p)
+	return err
 }
 
 // DecodeMessage reads a count-delimited message from the Buffer.
@@ -418,7 +511,14 @@ func (p *Buffer) DecodeMessage(pb Message) error {
 	if err != nil {
 		return err
 	}
-	return NewBuffer(enc).Unmarshal(pb)
+	if err := p.enter(); err != nil {
+		return err
+	}
+	defer p.leave()
+	q := NewBuffer(enc)
+	q.aliasBuffer, q.discardUnknown = p.aliasBuffer, p.discardUnknown
+	q.maxDepth, q.maxSize, q.maxRepeated, q.depth = p.maxDepth, p.maxSize, p.maxRepeated, p.depth
+	return q.Unmarshal(pb)
 }
 
 // DecodeGroup reads a tag-delimited group from the Buffer.
@@ -427,6 +527,10 @@ func (p *Buffer) DecodeGroup(pb Message) error {
 	if err != nil {
 		return err
 	}
+	if err := p.enter(); err != nil {
+		return err
+	}
+	defer p.leave()
 	return p.unmarshalType(typ.Elem(), GetProperties(typ.Elem()), true, base)
 }
 
@@ -437,6 +541,10 @@ func (p *Buffer) DecodeGroup(pb Message) error {
 //
 // Unlike proto.Unmarshal, this does not reset pb before starting to unmarshal.
 func (p *Buffer) Unmarshal(pb Message) error {
+	if err := p.checkSize(); err != nil {
+		return err
+	}
+
 	// If the object can unmarshal itself, let it.
 	if u, ok := pb.(Unmarshaler); ok {
 		err := u.Unmarshal(p.buf[p.index:])
@@ -449,10 +557,13 @@ func (p *Buffer) Unmarshal(pb Message) error {
 		return err
 	}
 
-	err = p.unmarshalType(typ.Elem(), GetProperties(typ.Elem()), false, base)
+	sprop := GetProperties(typ.Elem())
+	start := p.index
+	err = p.unmarshalType(typ.Elem(), sprop, false, base)
 
-	if collectStats {
-		stats.Decode++
+	if collectStats() {
+		atomic.AddUint64(&stats.Decode, 1)
+		sprop.stats.addDecode(p.index - start)
 	}
 
 	return err
@@ -477,7 +588,7 @@ func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group
 				if required > 0 {
 					// Not enough information to determine the exact field.
 					// (See below.)
-					return &RequiredNotSetError{"{Unknown}"}
+					return &RequiredNotSetError{field: "{Unknown}"}
 				}
 				return nil // input is satisfied
 			}
@@ -512,6 +623,9 @@ func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group
 					err = fmt.Errorf("bad wiretype for oneof field in %T", m)
 				}
 				if ok {
+					if err != nil {
+						err = addFieldContext(err, prop.oneofFieldName(tag))
+					}
 					continue
 				}
 			}
@@ -536,7 +650,7 @@ func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group
 		}
 		decErr := dec(o, p, base)
 		if decErr != nil && !state.shouldContinue(decErr, p) {
-			err = decErr
+			err = addFieldContext(decErr, p.OrigName)
 		}
 		if err == nil && p.Required {
 			// Successfully decoded a required field.
@@ -568,7 +682,7 @@ func (o *Buffer) unmarshalType(st reflect.Type, prop *StructProperties, is_group
 			// Not enough information to determine the exact field. If we use extra
 			// CPU, we could determine the field only if the missing required field
 			// has a tag <= 64 and we check reqFields.
-			return &RequiredNotSetError{"{Unknown}"}
+			return &RequiredNotSetError{field: "{Unknown}"}
 		}
 	}
 	return err
@@ -671,7 +785,7 @@ func (o *Buffer) dec_proto3_string(p *Properties, base structPointer) error {
 
 // Decode a slice of bytes ([]byte).
 func (o *Buffer) dec_slice_byte(p *Properties, base structPointer) error {
-	b, err := o.DecodeRawBytes(true)
+	b, err := o.DecodeRawBytes(!o.aliasBuffer)
 	if err != nil {
 		return err
 	}
@@ -687,7 +801,7 @@ func (o *Buffer) dec_slice_bool(p *Properties, base structPointer) error {
 	}
 	v := structPointer_BoolSlice(base, p.field)
 	*v = append(*v, u != 0)
-	return nil
+	return o.checkRepeated(len(*v))
 }
 
 // Decode a slice of bools ([]bool) in packed format.
@@ -711,6 +825,9 @@ func (o *Buffer) dec_slice_packed_bool(p *Properties, base structPointer) error
 			return err
 		}
 		y = append(y, u != 0)
+		if err := o.checkRepeated(len(y)); err != nil {
+			return err
+		}
 	}
 
 	*v = y
@@ -723,8 +840,9 @@ func (o *Buffer) dec_slice_int32(p *Properties, base structPointer) error {
 	if err != nil {
 		return err
 	}
-	structPointer_Word32Slice(base, p.field).Append(uint32(u))
-	return nil
+	v := structPointer_Word32Slice(base, p.field)
+	v.Append(uint32(u))
+	return o.checkRepeated(v.Len())
 }
 
 // Decode a slice of int32s ([]int32) in packed format.
@@ -747,6 +865,9 @@ func (o *Buffer) dec_slice_packed_int32(p *Properties, base structPointer) error
 			return err
 		}
 		v.Append(uint32(u))
+		if err := o.checkRepeated(v.Len()); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -757,9 +878,9 @@ func (o *Buffer) dec_slice_int64(p *Properties, base structPointer) error {
 	if err != nil {
 		return err
 	}
-
-	structPointer_Word64Slice(base, p.field).Append(u)
-	return nil
+	v := structPointer_Word64Slice(base, p.field)
+	v.Append(u)
+	return o.checkRepeated(v.Len())
 }
 
 // Decode a slice of int64s ([]int64) in packed format.
@@ -782,6 +903,9 @@ func (o *Buffer) dec_slice_packed_int64(p *Properties, base structPointer) error
 			return err
 		}
 		v.Append(u)
+		if err := o.checkRepeated(v.Len()); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -794,18 +918,18 @@ func (o *Buffer) dec_slice_string(p *Properties, base structPointer) error {
 	}
 	v := structPointer_StringSlice(base, p.field)
 	*v = append(*v, s)
-	return nil
+	return o.checkRepeated(len(*v))
 }
 
 // Decode a slice of slice of bytes ([][]byte).
 func (o *Buffer) dec_slice_slice_byte(p *Properties, base structPointer) error {
-	b, err := o.DecodeRawBytes(true)
+	b, err := o.DecodeRawBytes(!o.aliasBuffer)
 	if err != nil {
 		return err
 	}
 	v := structPointer_BytesSlice(base, p.field)
 	*v = append(*v, b)
-	return nil
+	return o.checkRepeated(len(*v))
 }
 
 // Decode a map field.
@@ -878,7 +1002,7 @@ func (o *Buffer) dec_new_map(p *Properties, base structPointer) error {
 	}
 
 	v.SetMapIndex(keyelem, valelem)
-	return nil
+	return o.checkRepeated(v.Len())
 }
 
 // Decode a group.
@@ -888,8 +1012,16 @@ func (o *Buffer) dec_struct_group(p *Properties, base structPointer) error {
 		// allocate new nested message
 		bas = toStructPointer(reflect.New(p.stype))
 		structPointer_SetStructPointer(base, p.field, bas)
+		if collectStats() {
+			p.sprop.stats.addAlloc()
+		}
 	}
-	return o.unmarshalType(p.stype, p.sprop, true, bas)
+	if err := o.enter(); err != nil {
+		return err
+	}
+	err := o.unmarshalType(p.stype, p.sprop, true, bas)
+	o.leave()
+	return err
 }
 
 // Decode an embedded message.
@@ -899,11 +1031,18 @@ func (o *Buffer) dec_struct_message(p *Properties, base structPointer) (err erro
 		return e
 	}
 
+	if p.Lazy && structPointer_Lazy(base, p.lazyField).add(p, base, raw, o) {
+		// The encoding is kept until the field is read.
+		return nil
+	}
 	bas := structPointer_GetStructPointer(base, p.field)
 	if structPointer_IsNil(bas) {
 		// allocate new nested message
 		bas = toStructPointer(reflect.New(p.stype))
 		structPointer_SetStructPointer(base, p.field, bas)
+		if collectStats() {
+			p.sprop.stats.addAlloc()
+		}
 	}
 
 	// If the object can unmarshal itself, let it.
@@ -912,6 +1051,9 @@ func (o *Buffer) dec_struct_message(p *Properties, base structPointer) (err erro
 		return iv.(Unmarshaler).Unmarshal(raw)
 	}
 
+	if err := o.enter(); err != nil {
+		return err
+	}
 	obuf := o.buf
 	oi := o.index
 	o.buf = raw
@@ -920,6 +1062,7 @@ func (o *Buffer) dec_struct_message(p *Properties, base structPointer) (err erro
 	err = o.unmarshalType(p.stype, p.sprop, false, bas)
 	o.buf = obuf
 	o.index = oi
+	o.leave()
 
 	return err
 }
@@ -938,11 +1081,23 @@ func (o *Buffer) dec_slice_struct_group(p *Properties, base structPointer) error
 func (o *Buffer) dec_slice_struct(p *Properties, is_group bool, base structPointer) error {
 	v := reflect.New(p.stype)
 	bas := toStructPointer(v)
-	structPointer_StructPointerSlice(base, p.field).Append(bas)
+	if collectStats() {
+		p.sprop.stats.addAlloc()
+	}
+	s := structPointer_StructPointerSlice(base, p.field)
+	s.Append(bas)
+	i := s.Len() - 1
+	if err := o.checkRepeated(s.Len()); err != nil {
+		return err
+	}
 
 	if is_group {
+		if err := o.enter(); err != nil {
+			return addIndexContext(err, i)
+		}
 		err := o.unmarshalType(p.stype, p.sprop, is_group, bas)
-		return err
+		o.leave()
+		return addIndexContext(err, i)
 	}
 
 	raw, err := o.DecodeRawBytes(false)
@@ -956,6 +1111,9 @@ func (o *Buffer) dec_slice_struct(p *Properties, is_group bool, base structPoint
 		return iv.(Unmarshaler).Unmarshal(raw)
 	}
 
+	if err := o.enter(); err != nil {
+		return addIndexContext(err, i)
+	}
 	obuf := o.buf
 	oi := o.index
 	o.buf = raw
@@ -965,6 +1123,7 @@ func (o *Buffer) dec_slice_struct(p *Properties, is_group bool, base structPoint
 
 	o.buf = obuf
 	o.index = oi
+	o.leave()
 
-	return err
+	return addIndexContext(err, i)
 }
@@ -44,6 +44,159 @@ var (
 	msgBlackhole   = new(tpb.Message)
 )
 
+func TestUnmarshalAliasBuffer(t *testing.T) {
+	raw, err := proto.Marshal(&tpb.Message{Data: []byte("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := new(tpb.Message)
+	b := proto.NewBuffer(raw)
+	b.SetAliasBuffer(true)
+	if err := b.Unmarshal(m); err != nil {
+		t.Fatal(err)
+	}
+	if string(m.Data) != "hello" {
+		t.Fatalf("Data = %q, want %q", m.Data, "hello")
+	}
+	if cap(m.Data) != len(m.Data) {
+		t.Errorf("cap(Data) = %d, want %d", cap(m.Data), len(m.Data))
+	}
+	raw[len(raw)-1] = '!'
+	if string(m.Data) != "hell!" {
+		t.Errorf("Data = %q after modifying input, want it to alias the input", m.Data)
+	}
+
+	// Without aliasing the decoded bytes must be an independent copy.
+	raw, _ = proto.Marshal(&tpb.Message{Data: []byte("hello")})
+	m = new(tpb.Message)
+	if err := proto.Unmarshal(raw, m); err != nil {
+		t.Fatal(err)
+	}
+	raw[len(raw)-1] = '!'
+	if string(m.Data) != "hello" {
+		t.Errorf("Data = %q after modifying input, want a copy", m.Data)
+	}
+}
+
+func TestUnmarshalAliasBufferMap(t *testing.T) {
+	raw, err := proto.Marshal(&tpb.MessageWithMap{ByteMapping: map[bool][]byte{true: []byte("abc")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(tpb.MessageWithMap)
+	b := proto.NewBuffer(raw)
+	b.SetAliasBuffer(true)
+	if err := b.Unmarshal(m); err != nil {
+		t.Fatal(err)
+	}
+	raw[len(raw)-1] = 'z'
+	if got := string(m.ByteMapping[true]); got != "abz" {
+		t.Errorf("ByteMapping[true] = %q after modifying input, want it to alias the input", got)
+	}
+}
+
+func TestUnmarshalMaxDepth(t *testing.T) {
+	m := &tpb.Message{Name: "leaf"}
+	for i := 0; i < 5; i++ {
+		m = &tpb.Message{Submessage: m}
+	}
+	raw, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := proto.NewBuffer(raw)
+	b.SetMaxDepth(5)
+	if err := b.Unmarshal(new(tpb.Message)); err != nil {
+		t.Errorf("Unmarshal with depth limit 5: %v", err)
+	}
+
+	b = proto.NewBuffer(raw)
+	b.SetMaxDepth(3)
+	err = b.Unmarshal(new(tpb.Message))
+	le, ok := err.(*proto.DecodeLimitError)
+	if !ok {
+		t.Fatalf("Unmarshal with depth limit 3: got %v, want *DecodeLimitError", err)
+	}
+	if want := "submessage.submessage.submessage.submessage"; le.Field != want || le.Limit != "depth" || le.Max != 3 {
+		t.Errorf("got %+v, want Field %q, Limit depth, Max 3", le, want)
+	}
+}
+
+func TestUnmarshalMaxDepthRepeated(t *testing.T) {
+	deep := &tpb.Message{Submessage: &tpb.Message{Submessage: &tpb.Message{}}}
+	m := &tpb.Message{Children: []*tpb.Message{{}, {Children: []*tpb.Message{{}, {}, deep}}}}
+	raw, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := proto.NewBuffer(raw)
+	b.SetMaxDepth(3)
+	err = b.Unmarshal(new(tpb.Message))
+	le, ok := err.(*proto.DecodeLimitError)
+	if !ok {
+		t.Fatalf("Unmarshal with depth limit 3: got %v, want *DecodeLimitError", err)
+	}
+	if want := "children[1].children[2].submessage.submessage"; le.Field != want {
+		t.Errorf("Field = %q, want %q", le.Field, want)
+	}
+}
+
+func TestUnmarshalMaxMessageSize(t *testing.T) {
+	raw, err := proto.Marshal(&tpb.Message{Data: make([]byte, 100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := proto.NewBuffer(raw)
+	b.SetMaxMessageSize(50)
+	err = b.Unmarshal(new(tpb.Message))
+	if le, ok := err.(*proto.DecodeLimitError); !ok || le.Field != "" || le.Limit != "size" {
+		t.Errorf("got %v, want size *DecodeLimitError", err)
+	}
+
+	b = proto.NewBuffer(raw)
+	b.SetMaxMessageSize(len(raw))
+	if err := b.Unmarshal(new(tpb.Message)); err != nil {
+		t.Errorf("Unmarshal at exactly the size limit: %v", err)
+	}
+}
+
+func TestUnmarshalMaxRepeatedCount(t *testing.T) {
+	tests := []struct {
+		m     *tpb.Message
+		field string
+	}{
+		{&tpb.Message{ShortKey: []int32{1, 2, 3, 4}}, "short_key"},
+		{&tpb.Message{Key: []uint64{1, 2, 3, 4}}, "key"},
+		{&tpb.Message{Children: make([]*tpb.Message, 4)}, "children"},
+		{&tpb.Message{Terrain: map[string]*tpb.Nested{"a": {}, "b": {}, "c": {}, "d": {}}}, "terrain"},
+		{&tpb.Message{Submessage: &tpb.Message{RFunny: make([]tpb.Message_Humour, 4)}}, "submessage.r_funny"},
+	}
+	for _, tc := range tests {
+		for i := range tc.m.Children {
+			tc.m.Children[i] = new(tpb.Message)
+		}
+		raw, err := proto.Marshal(tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := proto.NewBuffer(raw)
+		b.SetMaxRepeatedCount(4)
+		if err := b.Unmarshal(new(tpb.Message)); err != nil {
+			t.Errorf("%s: Unmarshal at the limit: %v", tc.field, err)
+		}
+
+		b = proto.NewBuffer(raw)
+		b.SetMaxRepeatedCount(3)
+		err = b.Unmarshal(new(tpb.Message))
+		if le, ok := err.(*proto.DecodeLimitError); !ok || le.Field != tc.field || le.Limit != "repeated count" {
+			t.Errorf("%s: got %v, want repeated count *DecodeLimitError for %q", tc.field, err, tc.field)
+		}
+	}
+}
+
 // BenchmarkVarint32ArraySmall shows the performance on an array of small int32 fields (1 and
 // 2 bytes long).
 func BenchmarkVarint32ArraySmall(b *testing.B) {
@@ -0,0 +1,132 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Streams of length-delimited messages.
+ */
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+)
+
+// A DelimitedWriter writes a stream of messages to an io.Writer, each one
+// preceded by its length encoded as a varint. This is the framing used by
+// Java's MessageLite.writeDelimitedTo and parseDelimitedFrom, and by the
+// C++ util::SerializeDelimitedToOstream.
+type DelimitedWriter struct {
+	w   io.Writer
+	buf Buffer
+}
+
+// NewDelimitedWriter returns a DelimitedWriter that writes to w.
+func NewDelimitedWriter(w io.Writer) *DelimitedWriter {
+	return &DelimitedWriter{w: w}
+}
+
+// WriteMsg writes the length of pb followed by its wire-format encoding.
+// If pb cannot be marshaled, nothing is written.
+func (w *DelimitedWriter) WriteMsg(pb Message) error {
+	w.buf.Reset()
+	var err error
+	if m, ok := pb.(Marshaler); ok {
+		var data []byte
+		if data, err = m.Marshal(); err == nil {
+			err = w.buf.EncodeRawBytes(data)
+		}
+	} else {
+		err = w.buf.EncodeMessage(pb)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(w.buf.buf)
+	return err
+}
+
+// A DelimitedReader reads a stream of messages written by a DelimitedWriter
+// or by Java's writeDelimitedTo.
+type DelimitedReader struct {
+	r       byteReader
+	maxSize int
+	data    []byte // reused to hold each message
+	buf     Buffer
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// NewDelimitedReader returns a DelimitedReader that reads from r and rejects
+// any message longer than maxSize bytes; zero means no limit. If r does not
+// implement io.ByteReader it is wrapped in a bufio.Reader, which may read
+// past the last message returned.
+func NewDelimitedReader(r io.Reader, maxSize int) *DelimitedReader {
+	br, ok := r.(byteReader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &DelimitedReader{r: br, maxSize: maxSize}
+}
+
+// ReadMsg reads the next message from the stream into pb, which is reset
+// first. At the end of the stream it returns io.EOF; if the stream ends in
+// the middle of a message it returns io.ErrUnexpectedEOF. A message longer
+// than the reader's maximum size is reported as a *DecodeLimitError.
+func (r *DelimitedReader) ReadMsg(pb Message) error {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+	if r.maxSize > 0 && n > uint64(r.maxSize) {
+		return &DecodeLimitError{Limit: "size", Max: r.maxSize}
+	}
+	if int(n) < 0 || uint64(int(n)) != n {
+		return errOverflow
+	}
+	if cap(r.data) < int(n) {
+		r.data = make([]byte, n)
+	}
+	data := r.data[:n]
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	pb.Reset()
+	r.buf.SetBuf(data)
+	return r.buf.Unmarshal(pb)
+}
@@ -0,0 +1,116 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func TestDelimitedRoundTrip(t *testing.T) {
+	msgs := []*pb.GoTestField{
+		{Label: proto.String("one"), Type: proto.String("1")},
+		{Label: proto.String(""), Type: proto.String("")},
+		{Label: proto.String(string(make([]byte, 300))), Type: proto.String("big")},
+	}
+	var b bytes.Buffer
+	w := proto.NewDelimitedWriter(&b)
+	for _, m := range msgs {
+		if err := w.WriteMsg(m); err != nil {
+			t.Fatalf("WriteMsg(%v): %v", m, err)
+		}
+	}
+
+	// The stream is each message's length as a varint followed by the message.
+	var want []byte
+	for _, m := range msgs {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, proto.EncodeVarint(uint64(len(data)))...)
+		want = append(want, data...)
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Fatalf("stream = %q, want %q", b.Bytes(), want)
+	}
+
+	// Read through a plain io.Reader to exercise the bufio wrapping.
+	r := proto.NewDelimitedReader(struct{ io.Reader }{&b}, 1024)
+	for _, m := range msgs {
+		got := new(pb.GoTestField)
+		if err := r.ReadMsg(got); err != nil {
+			t.Fatalf("ReadMsg: %v", err)
+		}
+		if !proto.Equal(got, m) {
+			t.Errorf("ReadMsg = %v, want %v", got, m)
+		}
+	}
+	if err := r.ReadMsg(new(pb.GoTestField)); err != io.EOF {
+		t.Errorf("ReadMsg at end of stream = %v, want io.EOF", err)
+	}
+}
+
+func TestDelimitedReaderErrors(t *testing.T) {
+	data, err := proto.Marshal(&pb.GoTestField{Label: proto.String("label"), Type: proto.String("type")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream := append(proto.EncodeVarint(uint64(len(data))), data...)
+
+	r := proto.NewDelimitedReader(bytes.NewReader(stream), len(data)-1)
+	err = r.ReadMsg(new(pb.GoTestField))
+	if le, ok := err.(*proto.DecodeLimitError); !ok || le.Limit != "size" {
+		t.Errorf("ReadMsg of oversized message = %v, want size *DecodeLimitError", err)
+	}
+
+	r = proto.NewDelimitedReader(bytes.NewReader(stream[:len(stream)-1]), 0)
+	if err := r.ReadMsg(new(pb.GoTestField)); err != io.ErrUnexpectedEOF {
+		t.Errorf("ReadMsg of truncated message = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestDelimitedWriterRequiredNotSet(t *testing.T) {
+	var b bytes.Buffer
+	w := proto.NewDelimitedWriter(&b)
+	err := w.WriteMsg(&pb.GoTestField{Label: proto.String("label")})
+	if _, ok := err.(*proto.RequiredNotSetError); !ok {
+		t.Errorf("WriteMsg = %v, want *RequiredNotSetError", err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("WriteMsg wrote %q after failing", b.Bytes())
+	}
+}
@@ -0,0 +1,394 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A FieldDiff describes a single difference between two messages, as
+// reported by Diff.
+//
+// Path is a dotted path of original (.proto) field names leading from the
+// root message to the differing value. Repeated elements are indexed as
+// "others[2]", map entries as "name_mapping[7]" or "str_map[\"k\"]",
+// extensions are written "[pkg.ext_name]", and unknown fields are reported
+// under "XXX_unrecognized". The path is empty when the messages themselves
+// are of different types or one of them is nil.
+//
+// Old holds the value from the first message and New the value from the
+// second. Scalar fields are dereferenced, so an optional int32 field holds
+// an int32; message fields hold the message pointer. A nil Old or New means
+// the field is unset in that message.
+type FieldDiff struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// String renders d as "path: old -> new".
+func (d FieldDiff) String() string {
+	path := d.Path
+	if path == "" {
+		path = "<message>"
+	}
+	return path + ": " + diffValueString(d.Old) + " -> " + diffValueString(d.New)
+}
+
+// FormatDiff renders diffs one per line, in the order Diff returned them.
+func FormatDiff(diffs []FieldDiff) string {
+	var b bytes.Buffer
+	for _, d := range diffs {
+		b.WriteString(d.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func diffValueString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "<unset>"
+	case Message:
+		if reflect.ValueOf(v).IsNil() {
+			return "<nil>"
+		}
+		return "{" + strings.TrimSpace(CompactTextString(v)) + "}"
+	case string:
+		return strconv.Quote(v)
+	case []byte:
+		return strconv.Quote(string(v))
+	}
+	return fmt.Sprint(v)
+}
+
+// Diff compares a and b field by field and returns the differences, in
+// field order. It follows the same rules as Equal, so Diff returns no
+// differences exactly when Equal(a, b) is true. In particular, messages that
+// implement Equaler but are not generated structs, such as dynamic
+// messages, are compared by their Equal method and reported as a whole.
+func Diff(a, b Message) []FieldDiff {
+	var d differ
+	if a == nil || b == nil {
+		if a != b {
+			d.report("", a, b)
+		}
+		return d.diffs
+	}
+	v1, v2 := reflect.ValueOf(a), reflect.ValueOf(b)
+	if v1.Type() != v2.Type() {
+		d.report("", a, b)
+		return d.diffs
+	}
+	if v1.Kind() == reflect.Ptr && (v1.IsNil() || v2.IsNil()) {
+		if v1.IsNil() != v2.IsNil() {
+			d.report("", a, b)
+		}
+		return d.diffs
+	}
+	d.diffMessage("", a, b)
+	return d.diffs
+}
+
+// diffMessage compares the non-nil messages a and b, of the same type.
+// Like Equal, it defers to the Equal method of messages that are not
+// generated structs; a difference found that way is reported for the
+// message as a whole.
+func (d *differ) diffMessage(path string, a, b Message) {
+	v1, v2 := reflect.ValueOf(a), reflect.ValueOf(b)
+	if v1.Kind() == reflect.Ptr {
+		v1, v2 = v1.Elem(), v2.Elem()
+	}
+	if e, ok := a.(Equaler); ok && !isGeneratedStruct(v1) {
+		if !e.Equal(b) {
+			d.report(path, a, b)
+		}
+		return
+	}
+	if v1.Kind() != reflect.Struct {
+		d.report(path, a, b)
+		return
+	}
+	d.diffStruct(path, v1, v2)
+}
+
+// differ accumulates the results of Diff.
+type differ struct {
+	diffs []FieldDiff
+}
+
+func (d *differ) report(path string, old, new interface{}) {
+	d.diffs = append(d.diffs, FieldDiff{Path: path, Old: old, New: new})
+}
+
+// joinPath appends the field name to the dotted path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// diffValueOf returns the value reported in a FieldDiff for v:
+// nil when unset, the pointee for scalar pointers and v itself otherwise.
+func diffValueOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Elem().Kind() != reflect.Struct {
+			return v.Elem().Interface()
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	return v.Interface()
+}
+
+// v1 and v2 are known to have the same type.
+func (d *differ) diffStruct(path string, v1, v2 reflect.Value) {
+	resolveLazy(v1)
+	resolveLazy(v2)
+	sprop := GetProperties(v1.Type())
+	for i := 0; i < v1.NumField(); i++ {
+		f := v1.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		f1, f2 := v1.Field(i), v2.Field(i)
+		if f.Type.Kind() == reflect.Interface {
+			d.diffOneof(path, f1, f2)
+			continue
+		}
+		d.diffAny(joinPath(path, sprop.Prop[i].OrigName), f1, f2, sprop.Prop[i])
+	}
+
+	if em1 := v1.FieldByName("XXX_InternalExtensions"); em1.IsValid() {
+		em2 := v2.FieldByName("XXX_InternalExtensions")
+		x1 := em1.Interface().(XXX_InternalExtensions)
+		x2 := em2.Interface().(XXX_InternalExtensions)
+		m1, _ := x1.extensionsRead()
+		m2, _ := x2.extensionsRead()
+		d.diffExtensions(path, v1.Type(), m1, m2)
+	}
+
+	if em1 := v1.FieldByName("XXX_extensions"); em1.IsValid() {
+		em2 := v2.FieldByName("XXX_extensions")
+		d.diffExtensions(path, v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension))
+	}
+
+	if uf := v1.FieldByName("XXX_unrecognized"); uf.IsValid() {
+		u1, u2 := uf.Bytes(), v2.FieldByName("XXX_unrecognized").Bytes()
+		if !bytes.Equal(u1, u2) {
+			d.report(joinPath(path, "XXX_unrecognized"), u1, u2)
+		}
+	}
+}
+
+// diffOneof compares two oneof interface fields. When different members
+// are set, each side is reported under its own field name.
+func (d *differ) diffOneof(path string, f1, f2 reflect.Value) {
+	if f1.IsNil() && f2.IsNil() {
+		return
+	}
+	if !f1.IsNil() && !f2.IsNil() && f1.Elem().Type() == f2.Elem().Type() {
+		name, inner1 := oneofMember(f1)
+		_, inner2 := oneofMember(f2)
+		d.diffAny(joinPath(path, name), inner1, inner2, nil)
+		return
+	}
+	if !f1.IsNil() {
+		name, inner := oneofMember(f1)
+		d.report(joinPath(path, name), diffValueOf(inner), nil)
+	}
+	if !f2.IsNil() {
+		name, inner := oneofMember(f2)
+		d.report(joinPath(path, name), nil, diffValueOf(inner))
+	}
+}
+
+// oneofMember returns the original field name and the value held by the
+// non-nil oneof interface field f.
+func oneofMember(f reflect.Value) (string, reflect.Value) {
+	inner := f.Elem().Elem() // interface -> *T -> T
+	props := new(Properties)
+	props.Parse(inner.Type().Field(0).Tag.Get("protobuf"))
+	return props.OrigName, inner.Field(0)
+}
+
+// v1 and v2 are known to have the same type.
+// prop may be nil.
+func (d *differ) diffAny(path string, v1, v2 reflect.Value, prop *Properties) {
+	switch v1.Kind() {
+	case reflect.Ptr:
+		if v1.IsNil() || v2.IsNil() {
+			if v1.IsNil() != v2.IsNil() {
+				d.report(path, diffValueOf(v1), diffValueOf(v2))
+			}
+			return
+		}
+		if b1, ok := v1.Interface().(raw); ok {
+			// RawMessage
+			if b2 := v2.Interface().(raw); !bytes.Equal(b1.Bytes(), b2.Bytes()) {
+				d.report(path, b1.Bytes(), b2.Bytes())
+			}
+			return
+		}
+		if m1, ok := v1.Interface().(Message); ok {
+			d.diffMessage(path, m1, v2.Interface().(Message))
+			return
+		}
+		if v1.Elem().Kind() == reflect.Struct {
+			d.diffStruct(path, v1.Elem(), v2.Elem())
+			return
+		}
+		if !exactEqual.equalAny(v1.Elem(), v2.Elem(), prop, "") {
+			d.report(path, diffValueOf(v1), diffValueOf(v2))
+		}
+	case reflect.Slice:
+		if v1.Type().Elem().Kind() == reflect.Uint8 {
+			if !exactEqual.equalAny(v1, v2, prop, "") {
+				d.report(path, v1.Interface(), v2.Interface())
+			}
+			return
+		}
+		n := v1.Len()
+		if v2.Len() > n {
+			n = v2.Len()
+		}
+		for i := 0; i < n; i++ {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= v1.Len():
+				d.report(p, nil, diffValueOf(v2.Index(i)))
+			case i >= v2.Len():
+				d.report(p, diffValueOf(v1.Index(i)), nil)
+			default:
+				d.diffAny(p, v1.Index(i), v2.Index(i), prop)
+			}
+		}
+	case reflect.Map:
+		keys := v1.MapKeys()
+		for _, k := range v2.MapKeys() {
+			if !v1.MapIndex(k).IsValid() {
+				keys = append(keys, k)
+			}
+		}
+		sort.Sort(mapKeys(keys))
+		for _, k := range keys {
+			p := path + "[" + diffValueString(k.Interface()) + "]"
+			e1, e2 := v1.MapIndex(k), v2.MapIndex(k)
+			switch {
+			case !e1.IsValid():
+				d.report(p, nil, diffValueOf(e2))
+			case !e2.IsValid():
+				d.report(p, diffValueOf(e1), nil)
+			default:
+				d.diffAny(p, e1, e2, nil)
+			}
+		}
+	default:
+		if !exactEqual.equalAny(v1, v2, prop, "") {
+			d.report(path, diffValueOf(v1), diffValueOf(v2))
+		}
+	}
+}
+
+// base is the struct type that the extensions are based on.
+func (d *differ) diffExtensions(path string, base reflect.Type, em1, em2 map[int32]Extension) {
+	var nums []int
+	for extNum := range em1 {
+		nums = append(nums, int(extNum))
+	}
+	for extNum := range em2 {
+		if _, ok := em1[extNum]; !ok {
+			nums = append(nums, int(extNum))
+		}
+	}
+	sort.Ints(nums)
+
+	for _, n := range nums {
+		extNum := int32(n)
+		var desc *ExtensionDesc
+		if m := GlobalRegistry.extensions(base); m != nil {
+			desc = m[extNum]
+		}
+		name := "[" + strconv.Itoa(n) + "]"
+		if desc != nil {
+			name = "[" + desc.Name + "]"
+		}
+		p := joinPath(path, name)
+
+		e1, ok1 := em1[extNum]
+		e2, ok2 := em2[extNum]
+		v1, err1 := diffExtensionValue(e1, ok1, desc)
+		v2, err2 := diffExtensionValue(e2, ok2, desc)
+		switch {
+		case err1 != nil || err2 != nil:
+			// Undecodable; compare the encodings.
+			if !ok1 || !ok2 || !bytes.Equal(e1.enc, e2.enc) {
+				d.report(p, e1.enc, e2.enc)
+			}
+		case v1 == nil || v2 == nil:
+			if v1 != nil || v2 != nil {
+				d.report(p, diffValueOf(reflect.ValueOf(v1)), diffValueOf(reflect.ValueOf(v2)))
+			}
+		default:
+			d.diffAny(p, reflect.ValueOf(v1), reflect.ValueOf(v2), nil)
+		}
+	}
+}
+
+// diffExtensionValue returns the decoded value of e, or nil if it is absent.
+func diffExtensionValue(e Extension, ok bool, desc *ExtensionDesc) (interface{}, error) {
+	if !ok {
+		return nil, nil
+	}
+	if e.value != nil {
+		return e.value, nil
+	}
+	if desc == nil {
+		return nil, fmt.Errorf("proto: unknown extension")
+	}
+	return decodeExtension(e.enc, desc)
+}
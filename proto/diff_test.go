@@ -0,0 +1,171 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func extendedMessage(more *pb.Ext, number int32) *pb.MyMessage {
+	m := &pb.MyMessage{Count: proto.Int32(1)}
+	if more != nil {
+		if err := proto.SetExtension(m, pb.E_Ext_More, more); err != nil {
+			panic(err)
+		}
+	}
+	if number != 0 {
+		if err := proto.SetExtension(m, pb.E_Ext_Number, proto.Int32(number)); err != nil {
+			panic(err)
+		}
+	}
+	return m
+}
+
+var diffTests = []struct {
+	desc string
+	a, b proto.Message
+	want string
+}{
+	{"equal", &pb.MyMessage{Count: proto.Int32(1)}, &pb.MyMessage{Count: proto.Int32(1)}, ""},
+	{"nil", nil, &pb.MyMessage{}, "<message>: <unset> -> {}\n"},
+	{"types", &pb.MyMessage{}, &pb.InnerMessage{}, "<message>: {} -> {}\n"},
+	{
+		"scalars",
+		&pb.MyMessage{Count: proto.Int32(1), Name: proto.String("a"), Bikeshed: pb.MyMessage_RED.Enum()},
+		&pb.MyMessage{Count: proto.Int32(2), Quote: proto.String("b"), Bikeshed: pb.MyMessage_RED.Enum()},
+		"count: 1 -> 2\n" +
+			"name: \"a\" -> <unset>\n" +
+			"quote: <unset> -> \"b\"\n",
+	},
+	{
+		"nested",
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: proto.String("a"), Port: proto.Int32(1)}},
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: proto.String("b"), Port: proto.Int32(1)}, WeMustGoDeeper: &pb.RequiredInnerMessage{}},
+		"inner.host: \"a\" -> \"b\"\n" +
+			"we_must_go_deeper: <unset> -> {}\n",
+	},
+	{
+		"repeated",
+		&pb.MyMessage{Pet: []string{"cat", "dog"}, Others: []*pb.OtherMessage{{Key: proto.Int64(1)}}},
+		&pb.MyMessage{Pet: []string{"cat"}, Others: []*pb.OtherMessage{{Key: proto.Int64(2)}, {}}},
+		"pet[1]: \"dog\" -> <unset>\n" +
+			"others[0].key: 1 -> 2\n" +
+			"others[1]: <unset> -> {}\n",
+	},
+	{
+		"group and bytes",
+		&pb.MyMessage{Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(1)}, RepBytes: [][]byte{[]byte("x")}},
+		&pb.MyMessage{Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(2)}, RepBytes: [][]byte{[]byte("y")}},
+		"SomeGroup.group_field: 1 -> 2\n" +
+			"rep_bytes[0]: \"x\" -> \"y\"\n",
+	},
+	{
+		"maps",
+		&pb.MessageWithMap{
+			NameMapping: map[int32]string{1: "a", 2: "b"},
+			MsgMapping:  map[int64]*pb.FloatingPoint{7: {F: proto.Float64(1)}},
+			StrToStr:    map[string]string{"k": "v"},
+		},
+		&pb.MessageWithMap{
+			NameMapping: map[int32]string{2: "c", 3: "d"},
+			MsgMapping:  map[int64]*pb.FloatingPoint{7: {F: proto.Float64(2)}},
+		},
+		"name_mapping[1]: \"a\" -> <unset>\n" +
+			"name_mapping[2]: \"b\" -> \"c\"\n" +
+			"name_mapping[3]: <unset> -> \"d\"\n" +
+			"msg_mapping[7].f: 1 -> 2\n" +
+			"str_to_str[\"k\"]: \"v\" -> <unset>\n",
+	},
+	{
+		"oneof same member",
+		&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+		&pb.Communique{Union: &pb.Communique_Number{Number: 2}},
+		"number: 1 -> 2\n",
+	},
+	{
+		"oneof different members",
+		&pb.Communique{Union: &pb.Communique_Number{Number: 1}},
+		&pb.Communique{Union: &pb.Communique_Col{Col: pb.MyMessage_GREEN}},
+		"number: 1 -> <unset>\n" +
+			"col: <unset> -> GREEN\n",
+	},
+	{
+		"extensions",
+		extendedMessage(&pb.Ext{Data: proto.String("a")}, 5),
+		extendedMessage(&pb.Ext{Data: proto.String("b")}, 0),
+		"[testdata.Ext.more].data: \"a\" -> \"b\"\n" +
+			"[testdata.Ext.number]: 5 -> <unset>\n",
+	},
+	{
+		"unknown fields",
+		&pb.MyMessage{Count: proto.Int32(1), XXX_unrecognized: []byte("\x08\x01")},
+		&pb.MyMessage{Count: proto.Int32(1)},
+		"XXX_unrecognized: \"\\b\\x01\" -> \"\"\n",
+	},
+}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range diffTests {
+		if got := proto.FormatDiff(proto.Diff(tc.a, tc.b)); got != tc.want {
+			t.Errorf("%s: Diff:\n got %q\nwant %q", tc.desc, got, tc.want)
+		}
+	}
+}
+
+func TestDiffEncodedExtension(t *testing.T) {
+	a := extendedMessage(&pb.Ext{Data: proto.String("a")}, 0)
+	b, err := proto.Marshal(extendedMessage(&pb.Ext{Data: proto.String("b")}, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(pb.MyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	diffs := proto.Diff(a, m)
+	if len(diffs) != 1 || diffs[0].Path != "[testdata.Ext.more].data" || diffs[0].Old != "a" || diffs[0].New != "b" {
+		t.Errorf("Diff = %v", diffs)
+	}
+}
+
+// Diff must agree with Equal.
+func TestDiffMatchesEqual(t *testing.T) {
+	for _, tc := range EqualTests {
+		diffs := proto.Diff(tc.a, tc.b)
+		if eq := len(diffs) == 0; eq != tc.exp {
+			t.Errorf("%s: Diff reported %v; Equal = %v", tc.desc, diffs, tc.exp)
+		}
+	}
+}
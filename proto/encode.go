@@ -40,6 +40,9 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 )
 
 // RequiredNotSetError is the error returned if Marshal is called with
@@ -50,15 +53,32 @@ import (
 //
 // When printed, RequiredNotSetError reports the first unset required field in a
 // message. If the field cannot be precisely determined, it is reported as
-// "{Unknown}".
+// "{Unknown}". Errors returned by CheckInitialized report every unset
+// required field instead.
 type RequiredNotSetError struct {
-	field string
+	field  string
+	fields []string // all the unset fields, if known
 }
 
 func (e *RequiredNotSetError) Error() string {
+	if len(e.fields) > 1 {
+		quoted := make([]string, len(e.fields))
+		for i, f := range e.fields {
+			quoted[i] = strconv.Quote(f)
+		}
+		return fmt.Sprintf("proto: required fields %s not set", strings.Join(quoted, ", "))
+	}
 	return fmt.Sprintf("proto: required field %q not set", e.field)
 }
 
+// Fields returns the unset required fields that e reports.
+func (e *RequiredNotSetError) Fields() []string {
+	if e.fields != nil {
+		return e.fields
+	}
+	return []string{e.field}
+}
+
 var (
 	// errRepeatedHasNil is the error returned if Marshal is called with
 	// a struct with a repeated field containing a nil element.
@@ -82,6 +102,8 @@ var (
 
 const maxVarintBytes = 10 // maximum length of a varint
 
+const maxFieldNumber = 1<<29 - 1 // largest valid field number
+
 // maxMarshalSize is the largest allowed size of an encoded protobuf,
 // since C++ and Java use signed int32s for the size.
 const maxMarshalSize = 1<<31 - 1
@@ -232,7 +254,37 @@ func Marshal(pb Message) ([]byte, error) {
 	if m, ok := pb.(Marshaler); ok {
 		return m.Marshal()
 	}
+	b, err := MarshalAppend(nil, pb)
+	if b == nil && err == nil {
+		// Return a non-nil slice on success.
+		return []byte{}, nil
+	}
+	return b, err
+}
+
+// MarshalAppend is like Marshal but appends the encoding of pb to dst
+// and returns the extended slice. The encoder state is taken from an
+// internal pool, so repeated calls with a reused dst do not allocate
+// beyond growing dst.
+func MarshalAppend(dst []byte, pb Message) ([]byte, error) {
+	// Can the object marshal itself?
+	if m, ok := pb.(Marshaler); ok {
+		data, err := m.Marshal()
+		return append(dst, data...), err
+	}
+	p := getBuffer()
+	p.buf = dst
+	err := p.Marshal(pb)
+	dst = p.buf
+	putBuffer(p)
+	return dst, err
+}
+
+// MarshalDeterministic is like Marshal but encodes pb in deterministic mode;
+// see Buffer.SetDeterministic.
+func MarshalDeterministic(pb Message) ([]byte, error) {
 	p := NewBuffer(nil)
+	p.SetDeterministic(true)
 	err := p.Marshal(pb)
 	if p.buf == nil && err == nil {
 		// Return a non-nil slice on success.
@@ -249,8 +301,7 @@ func (p *Buffer) EncodeMessage(pb Message) error {
 		return ErrNil
 	}
 	if err == nil {
-		var state errorState
-		err = p.enc_len_struct(GetProperties(t.Elem()), base, &state)
+		err = p.marshalSized(GetProperties(t.Elem()).minfo, base, true)
 	}
 	return err
 }
@@ -271,11 +322,16 @@ func (p *Buffer) Marshal(pb Message) error {
 		return ErrNil
 	}
 	if err == nil {
-		err = p.enc_struct(GetProperties(t.Elem()), base)
+		sprop := GetProperties(t.Elem())
+		n, c := len(p.buf), cap(p.buf)
+		err = p.marshalSized(sprop.minfo, base, false)
+		if collectStats() {
+			sprop.stats.addEncode(len(p.buf)-n, cap(p.buf) != c)
+		}
 	}
 
-	if collectStats {
-		(stats).Encode++ // Parens are to work around a goimports bug.
+	if collectStats() {
+		atomic.AddUint64(&stats.Encode, 1)
 	}
 
 	if len(p.buf) > maxMarshalSize {
@@ -298,11 +354,15 @@ func Size(pb Message) (n int) {
 		return 0
 	}
 	if err == nil {
-		n = size_struct(GetProperties(t.Elem()), base)
+		sprop := GetProperties(t.Elem())
+		n = sprop.minfo.size(base, nil)
+		if collectStats() {
+			atomic.AddUint64(&sprop.stats.Size, 1)
+		}
 	}
 
-	if collectStats {
-		(stats).Size++ // Parens are to work around a goimports bug.
+	if collectStats() {
+		atomic.AddUint64(&stats.Size, 1)
 	}
 
 	return
@@ -546,6 +606,11 @@ func (o *Buffer) enc_struct_message(p *Properties, base structPointer) error {
 	if structPointer_IsNil(structp) {
 		return ErrNil
 	}
+	if raw, ok := lazyEncoding(p, base, structp); ok {
+		o.buf = append(o.buf, p.tagcode...)
+		o.EncodeRawBytes(raw)
+		return nil
+	}
 
 	// Can the object marshal itself?
 	if p.isMarshaler {
@@ -568,6 +633,9 @@ func size_struct_message(p *Properties, base structPointer) int {
 	if structPointer_IsNil(structp) {
 		return 0
 	}
+	if raw, ok := lazyEncoding(p, base, structp); ok {
+		return len(p.tagcode) + sizeRawBytes(raw)
+	}
 
 	// Can the object marshal itself?
 	if p.isMarshaler {
@@ -1066,7 +1134,7 @@ func size_slice_struct_group(p *Properties, base structPointer) (n int) {
 // Encode an extension map.
 func (o *Buffer) enc_map(p *Properties, base structPointer) error {
 	exts := structPointer_ExtMap(base, p.field)
-	if err := encodeExtensionsMap(*exts); err != nil {
+	if err := encodeExtensionsMap(*exts, o.deterministic); err != nil {
 		return err
 	}
 
@@ -1083,7 +1151,7 @@ func (o *Buffer) enc_exts(p *Properties, base structPointer) error {
 
 	mu.Lock()
 	defer mu.Unlock()
-	if err := encodeExtensionsMap(v); err != nil {
+	if err := encodeExtensionsMap(v, o.deterministic); err != nil {
 		return err
 	}
 
@@ -1154,8 +1222,13 @@ func (o *Buffer) enc_new_map(p *Properties, base structPointer) error {
 		return nil
 	}
 
-	// Don't sort map keys. It is not required by the spec, and C++ doesn't do it.
-	for _, key := range v.MapKeys() {
+	// Don't sort map keys unless asked to. It is not required by the spec,
+	// and C++ doesn't do it.
+	keys := v.MapKeys()
+	if o.deterministic {
+		sort.Sort(mapKeys(keys))
+	}
+	for _, key := range keys {
 		val := v.MapIndex(key)
 
 		keycopy.Set(key)
@@ -1236,7 +1309,7 @@ func (o *Buffer) enc_struct(prop *StructProperties, base structPointer) error {
 			if err != nil {
 				if err == ErrNil {
 					if p.Required && state.err == nil {
-						state.err = &RequiredNotSetError{p.Name}
+						state.err = &RequiredNotSetError{field: p.Name}
 					}
 				} else if err == errRepeatedHasNil {
 					// Give more context to nil values in repeated fields.
@@ -1268,13 +1341,64 @@ func (o *Buffer) enc_struct(prop *StructProperties, base structPointer) error {
 			return ErrTooLarge
 		}
 		if len(v) > 0 {
-			o.buf = append(o.buf, v...)
+			o.appendUnrecognized(v)
 		}
 	}
 
 	return state.err
 }
 
+// appendUnrecognized appends the unrecognized fields v to the buffer.
+// In deterministic mode they are first stably sorted by field number, so that
+// messages holding the same unknown fields marshal identically regardless of
+// the order in which those fields were decoded.
+func (o *Buffer) appendUnrecognized(v []byte) {
+	if o.deterministic {
+		v = sortUnrecognized(v)
+	}
+	o.buf = append(o.buf, v...)
+}
+
+// sortUnrecognized returns the wire-format fields in v stably sorted by field
+// number. Fields with the same number keep their relative order. If v is
+// already sorted or cannot be parsed, it is returned unchanged.
+func sortUnrecognized(v []byte) []byte {
+	var fields unrecognizedFields
+	p := NewBuffer(v)
+	for p.index < len(p.buf) {
+		start := p.index
+		u, err := p.DecodeVarint()
+		if err != nil {
+			return v
+		}
+		tag, wire := int(u>>3), int(u&0x7)
+		if err := p.skip(nil, tag, wire); err != nil {
+			return v
+		}
+		fields = append(fields, unrecognizedField{tag, v[start:p.index]})
+	}
+	if sort.IsSorted(fields) {
+		return v
+	}
+	sort.Stable(fields)
+	b := make([]byte, 0, len(v))
+	for _, f := range fields {
+		b = append(b, f.raw...)
+	}
+	return b
+}
+
+type unrecognizedField struct {
+	tag int
+	raw []byte // tag, wire type and value
+}
+
+type unrecognizedFields []unrecognizedField
+
+func (s unrecognizedFields) Len() int           { return len(s) }
+func (s unrecognizedFields) Less(i, j int) bool { return s[i].tag < s[j].tag }
+func (s unrecognizedFields) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 func size_struct(prop *StructProperties, base structPointer) (n int) {
 	for _, i := range prop.order {
 		p := prop.Prop[i]
@@ -1354,7 +1478,7 @@ func (s *errorState) shouldContinue(err error, prop *Properties) bool {
 	}
 	if s.err == nil {
 		if prop != nil {
-			err = &RequiredNotSetError{prop.Name + "." + reqNotSet.field}
+			err = &RequiredNotSetError{field: prop.Name + "." + reqNotSet.field}
 		}
 		s.err = err
 	}
@@ -35,7 +35,9 @@ package proto
 
 import (
 	"bytes"
+	"fmt"
 	"log"
+	"math"
 	"reflect"
 	"strings"
 )
@@ -67,9 +69,121 @@ Equality is defined in this way:
     fields are equal.
   - Every other combination of things are not equal.
 
+If a implements Equaler, its Equal method is used instead of the rules above.
+Messages generated with fastpath=true implement it, and Merger, with typed
+code that follows these rules.
+
 The return value is undefined if a and b are not protocol buffers.
 */
 func Equal(a, b Message) bool {
+	return exactEqual.equalMessage(a, b, "")
+}
+
+// Equaler is the interface representing messages that can compare
+// themselves, such as messages whose fields are not described by struct tags.
+// The argument is always of the same type as the receiver.
+type Equaler interface {
+	Equal(Message) bool
+}
+
+// An EqualOption relaxes one of the rules EqualWith uses to compare messages.
+type EqualOption func(*equalOptions)
+
+// equalOptions holds the rules set by a list of EqualOptions.
+// A nil *equalOptions compares by the rules of Equal.
+type equalOptions struct {
+	approx         bool
+	fraction       float64
+	margin         float64
+	ignoreUnknown  bool
+	ignore         map[string]bool
+	equateEmpty    bool
+	equateDefaults bool
+}
+
+// exactEqual compares by the rules of Equal.
+var exactEqual *equalOptions
+
+// FloatApprox makes EqualWith treat two floating-point values x and y as
+// equal if |x-y| <= max(margin, fraction*min(|x|, |y|)). NaN is still not
+// equal to anything.
+func FloatApprox(fraction, margin float64) EqualOption {
+	return func(o *equalOptions) {
+		o.approx, o.fraction, o.margin = true, fraction, margin
+	}
+}
+
+// IgnoreUnknown makes EqualWith skip XXX_unrecognized when comparing.
+func IgnoreUnknown() EqualOption {
+	return func(o *equalOptions) { o.ignoreUnknown = true }
+}
+
+// IgnoreFields makes EqualWith skip the named fields. A path is a dotted
+// list of original (.proto) field names from the root message, such as
+// "inner.host". Repeated elements and map entries do not add a segment,
+// so "others.key" names the key field of every element of others.
+// Extensions are named "[pkg.ext_name]" and oneof members by their own
+// field name. Ignoring a message field ignores everything beneath it.
+func IgnoreFields(paths ...string) EqualOption {
+	return func(o *equalOptions) {
+		if o.ignore == nil {
+			o.ignore = make(map[string]bool)
+		}
+		for _, p := range paths {
+			o.ignore[p] = true
+		}
+	}
+}
+
+// EquateEmpty makes EqualWith treat nil and empty values as equal where
+// Equal does not: a nil and a zero-length bytes field, and a nil and an
+// empty message inside a repeated field or map. (Equal already treats nil
+// and empty repeated and map fields as equal.)
+func EquateEmpty() EqualOption {
+	return func(o *equalOptions) { o.equateEmpty = true }
+}
+
+// EquateDefaults makes EqualWith treat an unset proto2 scalar or bytes
+// field as equal to a set one holding the field's default value.
+func EquateDefaults() EqualOption {
+	return func(o *equalOptions) { o.equateDefaults = true }
+}
+
+// EqualWith is like Equal, with the comparison rules relaxed by opts.
+//
+// The options apply only to messages that EqualWith walks by reflection.
+// A message that implements Equaler but is not a generated struct, such as
+// a dynamic message, can only be compared by its Equal method, so EqualWith
+// panics if it is given options and reaches such a message.
+func EqualWith(a, b Message, opts ...EqualOption) bool {
+	var o *equalOptions
+	if len(opts) > 0 {
+		o = new(equalOptions)
+		for _, opt := range opts {
+			opt(o)
+		}
+	}
+	return o.equalMessage(a, b, "")
+}
+
+// join returns the IgnoreFields path of field name under path.
+// Paths are only tracked when some field is ignored.
+func (o *equalOptions) join(path, name string) string {
+	if o == nil || o.ignore == nil {
+		return ""
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// ignored reports whether the field at path is to be skipped.
+func (o *equalOptions) ignored(path string) bool {
+	return o != nil && o.ignore[path]
+}
+
+func (o *equalOptions) equalMessage(a, b Message, path string) bool {
 	if a == nil || b == nil {
 		return a == b
 	}
@@ -86,20 +200,58 @@ func Equal(a, b Message) bool {
 		}
 		v1, v2 = v1.Elem(), v2.Elem()
 	}
+	// Typed Equal methods apply the rules of Equal, so EqualWith relaxed
+	// by options only defers to those of messages it cannot walk itself.
+	if e, ok := a.(Equaler); ok && (o == nil || !isGeneratedStruct(v1)) {
+		if o != nil {
+			panic(fmt.Sprintf("proto: EqualWith options cannot be applied to %T", a))
+		}
+		return e.Equal(b)
+	}
 	if v1.Kind() != reflect.Struct {
 		return false
 	}
-	return equalStruct(v1, v2)
+	return o.equalStruct(v1, v2, path)
+}
+
+// isGeneratedStruct reports whether v is a struct with the layout of
+// generated message types, which always have an XXX_unrecognized field.
+func isGeneratedStruct(v reflect.Value) bool {
+	return v.Kind() == reflect.Struct && v.FieldByName("XXX_unrecognized").IsValid()
+}
+
+// XXX_EqualExtensions reports whether the extensions of a and b, which must
+// be the same extendable message type, are equal by the rules of Equal.
+// It is for the internal use of Equal methods generated with fastpath=true.
+func XXX_EqualExtensions(a, b Message) bool {
+	x1, ok := extendable(a)
+	if !ok {
+		return true
+	}
+	x2, _ := extendable(b)
+	em1, _ := x1.extensionsRead()
+	em2, _ := x2.extensionsRead()
+	return exactEqual.equalExtMap(reflect.TypeOf(a).Elem(), em1, em2, "")
 }
 
 // v1 and v2 are known to have the same type.
-func equalStruct(v1, v2 reflect.Value) bool {
+func (o *equalOptions) equalStruct(v1, v2 reflect.Value, path string) bool {
+	resolveLazy(v1)
+	resolveLazy(v2)
 	sprop := GetProperties(v1.Type())
 	for i := 0; i < v1.NumField(); i++ {
 		f := v1.Type().Field(i)
 		if strings.HasPrefix(f.Name, "XXX_") {
 			continue
 		}
+		fpath := path
+		if f.Type.Kind() != reflect.Interface {
+			// Oneof members are named when the wrapper struct is compared.
+			fpath = o.join(path, sprop.Prop[i].OrigName)
+			if o.ignored(fpath) {
+				continue
+			}
+		}
 		f1, f2 := v1.Field(i), v2.Field(i)
 		if f.Type.Kind() == reflect.Ptr {
 			if n1, n2 := f1.IsNil(), f2.IsNil(); n1 && n2 {
@@ -107,7 +259,10 @@ func equalStruct(v1, v2 reflect.Value) bool {
 				continue
 			} else if n1 != n2 {
 				// set/unset mismatch
-				return false
+				if !o.equalUnset(f1, f2, sprop.Prop[i]) {
+					return false
+				}
+				continue
 			}
 			b1, ok := f1.Interface().(raw)
 			if ok {
@@ -120,27 +275,27 @@ func equalStruct(v1, v2 reflect.Value) bool {
 			}
 			f1, f2 = f1.Elem(), f2.Elem()
 		}
-		if !equalAny(f1, f2, sprop.Prop[i]) {
+		if !o.equalAny(f1, f2, sprop.Prop[i], fpath) {
 			return false
 		}
 	}
 
 	if em1 := v1.FieldByName("XXX_InternalExtensions"); em1.IsValid() {
 		em2 := v2.FieldByName("XXX_InternalExtensions")
-		if !equalExtensions(v1.Type(), em1.Interface().(XXX_InternalExtensions), em2.Interface().(XXX_InternalExtensions)) {
+		if !o.equalExtensions(v1.Type(), em1.Interface().(XXX_InternalExtensions), em2.Interface().(XXX_InternalExtensions), path) {
 			return false
 		}
 	}
 
 	if em1 := v1.FieldByName("XXX_extensions"); em1.IsValid() {
 		em2 := v2.FieldByName("XXX_extensions")
-		if !equalExtMap(v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension)) {
+		if !o.equalExtMap(v1.Type(), em1.Interface().(map[int32]Extension), em2.Interface().(map[int32]Extension), path) {
 			return false
 		}
 	}
 
 	uf := v1.FieldByName("XXX_unrecognized")
-	if !uf.IsValid() {
+	if !uf.IsValid() || (o != nil && o.ignoreUnknown) {
 		return true
 	}
 
@@ -153,32 +308,65 @@ func equalStruct(v1, v2 reflect.Value) bool {
 	return true
 }
 
+// equalUnset reports whether the pointer fields f1 and f2, exactly one of
+// which is nil, are equal because the set one holds the field's default.
+func (o *equalOptions) equalUnset(f1, f2 reflect.Value, prop *Properties) bool {
+	if o == nil || !o.equateDefaults {
+		return false
+	}
+	set := f1
+	if set.IsNil() {
+		set = f2
+	}
+	sf, _, err := fieldDefault(set.Type(), prop)
+	if err != nil || sf == nil {
+		return false
+	}
+	def := reflect.Zero(set.Type().Elem())
+	if sf.value != nil {
+		def = reflect.ValueOf(sf.value).Convert(def.Type())
+	}
+	return o.equalAny(set.Elem(), def, prop, "")
+}
+
 // v1 and v2 are known to have the same type.
 // prop may be nil.
-func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
+func (o *equalOptions) equalAny(v1, v2 reflect.Value, prop *Properties, path string) bool {
 	if v1.Type() == protoMessageType {
 		m1, _ := v1.Interface().(Message)
 		m2, _ := v2.Interface().(Message)
-		return Equal(m1, m2)
+		return o.equalMessage(m1, m2, path)
 	}
 	switch v1.Kind() {
 	case reflect.Bool:
 		return v1.Bool() == v2.Bool()
 	case reflect.Float32, reflect.Float64:
+		if o != nil && o.approx {
+			return o.approxEqual(v1.Float(), v2.Float())
+		}
 		return v1.Float() == v2.Float()
 	case reflect.Int32, reflect.Int64:
 		return v1.Int() == v2.Int()
 	case reflect.Interface:
 		// Probably a oneof field; compare the inner values.
 		n1, n2 := v1.IsNil(), v2.IsNil()
-		if n1 || n2 {
-			return n1 == n2
+		if !n1 && !n2 && v1.Elem().Type() == v2.Elem().Type() {
+			return o.equalAny(v1.Elem(), v2.Elem(), nil, path)
 		}
-		e1, e2 := v1.Elem(), v2.Elem()
-		if e1.Type() != e2.Type() {
-			return false
+		if o == nil || o.ignore == nil {
+			return n1 && n2
 		}
-		return equalAny(e1, e2, nil)
+		// Different oneof members are set; that only matters
+		// for members that are not ignored.
+		for _, v := range []reflect.Value{v1, v2} {
+			if v.IsNil() {
+				continue
+			}
+			if name, _ := oneofMember(v); !o.ignored(o.join(path, name)) {
+				return false
+			}
+		}
+		return true
 	case reflect.Map:
 		if v1.Len() != v2.Len() {
 			return false
@@ -189,7 +377,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 				// This key was not found in the second map.
 				return false
 			}
-			if !equalAny(v1.MapIndex(key), val2, nil) {
+			if !o.equalAny(v1.MapIndex(key), val2, nil, path) {
 				return false
 			}
 		}
@@ -200,9 +388,17 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 			return true
 		}
 		if v1.IsNil() != v2.IsNil() {
+			if o != nil && o.equateEmpty && v1.Type().Elem().Kind() == reflect.Struct {
+				// Compare the non-nil message against an empty one.
+				empty := reflect.New(v1.Type().Elem())
+				if v1.IsNil() {
+					return o.equalAny(empty.Elem(), v2.Elem(), prop, path)
+				}
+				return o.equalAny(v1.Elem(), empty.Elem(), prop, path)
+			}
 			return false
 		}
-		return equalAny(v1.Elem(), v2.Elem(), prop)
+		return o.equalAny(v1.Elem(), v2.Elem(), prop, path)
 	case reflect.Slice:
 		if v1.Type().Elem().Kind() == reflect.Uint8 {
 			// short circuit: []byte
@@ -213,7 +409,21 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 				return true
 			}
 			if v1.IsNil() != v2.IsNil() {
-				return false
+				if o == nil {
+					return false
+				}
+				if o.equateEmpty && v1.Len() == 0 && v2.Len() == 0 {
+					return true
+				}
+				if !o.equateDefaults || prop == nil || prop.Repeated {
+					return false
+				}
+				// An unset bytes field equals its default.
+				set := v1
+				if set.IsNil() {
+					set = v2
+				}
+				return bytes.Equal(set.Bytes(), []byte(prop.Default))
 			}
 			return bytes.Equal(v1.Interface().([]byte), v2.Interface().([]byte))
 		}
@@ -222,7 +432,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 			return false
 		}
 		for i := 0; i < v1.Len(); i++ {
-			if !equalAny(v1.Index(i), v2.Index(i), prop) {
+			if !o.equalAny(v1.Index(i), v2.Index(i), prop, path) {
 				return false
 			}
 		}
@@ -230,7 +440,7 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 	case reflect.String:
 		return v1.Interface().(string) == v2.Interface().(string)
 	case reflect.Struct:
-		return equalStruct(v1, v2)
+		return o.equalStruct(v1, v2, path)
 	case reflect.Uint32, reflect.Uint64:
 		return v1.Uint() == v2.Uint()
 	}
@@ -240,20 +450,53 @@ func equalAny(v1, v2 reflect.Value, prop *Properties) bool {
 	return false
 }
 
+// approxEqual compares x and y within the FloatApprox tolerance.
+func (o *equalOptions) approxEqual(x, y float64) bool {
+	if x == y {
+		return true
+	}
+	tol := math.Max(o.margin, o.fraction*math.Min(math.Abs(x), math.Abs(y)))
+	return math.Abs(x-y) <= tol
+}
+
 // base is the struct type that the extensions are based on.
 // x1 and x2 are InternalExtensions.
-func equalExtensions(base reflect.Type, x1, x2 XXX_InternalExtensions) bool {
+func (o *equalOptions) equalExtensions(base reflect.Type, x1, x2 XXX_InternalExtensions, path string) bool {
 	em1, _ := x1.extensionsRead()
 	em2, _ := x2.extensionsRead()
-	return equalExtMap(base, em1, em2)
+	return o.equalExtMap(base, em1, em2, path)
 }
 
-func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
-	if len(em1) != len(em2) {
-		return false
+// extPath returns the IgnoreFields path of extension extNum of base.
+func (o *equalOptions) extPath(base reflect.Type, extNum int32, path string) string {
+	if o == nil || o.ignore == nil {
+		return ""
+	}
+	if desc := GlobalRegistry.extensions(base)[extNum]; desc != nil {
+		return o.join(path, "["+desc.Name+"]")
+	}
+	return o.join(path, fmt.Sprintf("[%d]", extNum))
+}
+
+func (o *equalOptions) equalExtMap(base reflect.Type, em1, em2 map[int32]Extension, path string) bool {
+	if o == nil || o.ignore == nil {
+		if len(em1) != len(em2) {
+			return false
+		}
+	} else {
+		// Extensions present on one side only may be ignored.
+		for extNum := range em2 {
+			if _, ok := em1[extNum]; !ok && !o.ignored(o.extPath(base, extNum, path)) {
+				return false
+			}
+		}
 	}
 
 	for extNum, e1 := range em1 {
+		epath := o.extPath(base, extNum, path)
+		if o.ignored(epath) {
+			continue
+		}
 		e2, ok := em2[extNum]
 		if !ok {
 			return false
@@ -263,7 +506,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 
 		if m1 != nil && m2 != nil {
 			// Both are unencoded.
-			if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil) {
+			if !o.equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil, epath) {
 				return false
 			}
 			continue
@@ -272,7 +515,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 		// At least one is encoded. To do a semantically correct comparison
 		// we need to unmarshal them first.
 		var desc *ExtensionDesc
-		if m := extensionMaps[base]; m != nil {
+		if m := GlobalRegistry.extensions(base); m != nil {
 			desc = m[extNum]
 		}
 		if desc == nil {
@@ -291,7 +534,7 @@ func equalExtMap(base reflect.Type, em1, em2 map[int32]Extension) bool {
 			log.Printf("proto: badly encoded extension %d of %v: %v", extNum, base, err)
 			return false
 		}
-		if !equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil) {
+		if !o.equalAny(reflect.ValueOf(m1), reflect.ValueOf(m2), nil, epath) {
 			return false
 		}
 	}
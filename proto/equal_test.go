@@ -222,3 +222,171 @@ func TestEqual(t *testing.T) {
 		}
 	}
 }
+
+var EqualWithTests = []struct {
+	desc string
+	a, b Message
+	opts []EqualOption
+	exp  bool
+}{
+	{
+		"float exact",
+		&pb.FloatingPoint{F: Float64(1)},
+		&pb.FloatingPoint{F: Float64(1.0000001)},
+		nil,
+		false,
+	},
+	{
+		"float approx margin",
+		&pb.FloatingPoint{F: Float64(1)},
+		&pb.FloatingPoint{F: Float64(1.0000001)},
+		[]EqualOption{FloatApprox(0, 1e-6)},
+		true,
+	},
+	{
+		"float approx fraction",
+		&pb.FloatingPoint{F: Float64(1e9)},
+		&pb.FloatingPoint{F: Float64(1e9 + 10)},
+		[]EqualOption{FloatApprox(1e-6, 0)},
+		true,
+	},
+	{
+		"float approx too far",
+		&pb.FloatingPoint{F: Float64(1)},
+		&pb.FloatingPoint{F: Float64(1.1)},
+		[]EqualOption{FloatApprox(0.01, 0.01)},
+		false,
+	},
+	{
+		"float approx in map",
+		&pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{1: {F: Float64(2)}}},
+		&pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{1: {F: Float64(2.001)}}},
+		[]EqualOption{FloatApprox(0.01, 0)},
+		true,
+	},
+	{
+		"ignore unknown",
+		&pb.GoEnum{Foo: pb.FOO_FOO1.Enum(), XXX_unrecognized: []byte("\x08\x01")},
+		&pb.GoEnum{Foo: pb.FOO_FOO1.Enum()},
+		[]EqualOption{IgnoreUnknown()},
+		true,
+	},
+	{
+		"ignore field",
+		&pb.MyMessage{Count: Int32(1), Name: String("a")},
+		&pb.MyMessage{Count: Int32(2), Name: String("a")},
+		[]EqualOption{IgnoreFields("count")},
+		true,
+	},
+	{
+		"ignore nested field",
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(1)}},
+		[]EqualOption{IgnoreFields("inner.host")},
+		true,
+	},
+	{
+		"ignore other nested field",
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("a"), Port: Int32(1)}},
+		&pb.MyMessage{Inner: &pb.InnerMessage{Host: String("b"), Port: Int32(1)}},
+		[]EqualOption{IgnoreFields("inner.port")},
+		false,
+	},
+	{
+		"ignore field of repeated elements",
+		&pb.MyMessage{Others: []*pb.OtherMessage{{Key: Int64(1), Weight: Float32(1)}, {Key: Int64(2)}}},
+		&pb.MyMessage{Others: []*pb.OtherMessage{{Key: Int64(1), Weight: Float32(2)}, {Key: Int64(2), Weight: Float32(3)}}},
+		[]EqualOption{IgnoreFields("others.weight")},
+		true,
+	},
+	{
+		"ignore oneof member",
+		&pb.Communique{Union: &pb.Communique_Number{Number: 41}},
+		&pb.Communique{Union: &pb.Communique_Name{Name: "Bobby Tables"}},
+		[]EqualOption{IgnoreFields("number", "name")},
+		true,
+	},
+	{
+		"ignore one oneof member",
+		&pb.Communique{Union: &pb.Communique_Number{Number: 41}},
+		&pb.Communique{Union: &pb.Communique_Name{Name: "Bobby Tables"}},
+		[]EqualOption{IgnoreFields("number")},
+		false,
+	},
+	{
+		"ignore extension",
+		messageWithExtension1a,
+		messageWithExtension2,
+		[]EqualOption{IgnoreFields("[testdata.Ext.more]")},
+		true,
+	},
+	{
+		"ignore missing extension",
+		messageWithoutExtension,
+		messageWithExtension1a,
+		[]EqualOption{IgnoreFields("[testdata.Ext.more]")},
+		true,
+	},
+	{
+		"bytes nil vs empty",
+		&pb.OtherMessage{Value: nil},
+		&pb.OtherMessage{Value: []byte{}},
+		nil,
+		false,
+	},
+	{
+		"equate empty bytes",
+		&pb.OtherMessage{Value: nil},
+		&pb.OtherMessage{Value: []byte{}},
+		[]EqualOption{EquateEmpty()},
+		true,
+	},
+	{
+		"equate empty map value",
+		&pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{1: nil}},
+		&pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{1: {}}},
+		[]EqualOption{EquateEmpty()},
+		true,
+	},
+	{
+		"unset vs default",
+		&pb.Defaults{},
+		&pb.Defaults{F_Int32: Int32(32), F_Enum: pb.Defaults_GREEN.Enum(), F_Bytes: []byte("Bignose"), StrZero: String("")},
+		nil,
+		false,
+	},
+	{
+		"equate defaults",
+		&pb.Defaults{},
+		&pb.Defaults{F_Int32: Int32(32), F_Enum: pb.Defaults_GREEN.Enum(), F_Bytes: []byte("Bignose"), StrZero: String("")},
+		[]EqualOption{EquateDefaults()},
+		true,
+	},
+	{
+		"equate defaults non-default",
+		&pb.Defaults{},
+		&pb.Defaults{F_Int32: Int32(33)},
+		[]EqualOption{EquateDefaults()},
+		false,
+	},
+	{
+		"equate defaults zero",
+		&pb.InnerMessage{Host: String("h")},
+		&pb.InnerMessage{Host: String("h"), Port: Int32(4000), Connected: Bool(false)},
+		[]EqualOption{EquateDefaults()},
+		true,
+	},
+}
+
+func TestEqualWith(t *testing.T) {
+	for _, tc := range EqualTests {
+		if res := EqualWith(tc.a, tc.b); res != tc.exp {
+			t.Errorf("%v: EqualWith(%v, %v) = %v, want %v", tc.desc, tc.a, tc.b, res, tc.exp)
+		}
+	}
+	for _, tc := range EqualWithTests {
+		if res := EqualWith(tc.a, tc.b, tc.opts...); res != tc.exp {
+			t.Errorf("%v: EqualWith(%v, %v) = %v, want %v", tc.desc, tc.a, tc.b, res, tc.exp)
+		}
+	}
+}
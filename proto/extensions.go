@@ -39,7 +39,6 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"strconv"
 	"sync"
 )
 
@@ -251,18 +250,19 @@ func extensionProperties(ed *ExtensionDesc) *Properties {
 }
 
 // encode encodes any unmarshaled (unencoded) extensions in e.
-func encodeExtensions(e *XXX_InternalExtensions) error {
+// If deterministic is true, map fields within them are encoded in key order.
+func encodeExtensions(e *XXX_InternalExtensions, deterministic bool) error {
 	m, mu := e.extensionsRead()
 	if m == nil {
 		return nil // fast path
 	}
 	mu.Lock()
 	defer mu.Unlock()
-	return encodeExtensionsMap(m)
+	return encodeExtensionsMap(m, deterministic)
 }
 
 // encode encodes any unmarshaled (unencoded) extensions in e.
-func encodeExtensionsMap(m map[int32]Extension) error {
+func encodeExtensionsMap(m map[int32]Extension, deterministic bool) error {
 	for k, e := range m {
 		if e.value == nil || e.desc == nil {
 			// Extension is only in its encoded form.
@@ -277,6 +277,7 @@ func encodeExtensionsMap(m map[int32]Extension) error {
 		props := extensionProperties(e.desc)
 
 		p := NewBuffer(nil)
+		p.deterministic = deterministic
 		// If e.value has type T, the encoder expects a *struct{ X T }.
 		// Pass a *T with a zero field and hope it all works out.
 		x := reflect.New(et)
@@ -560,28 +561,16 @@ func ClearAllExtensions(pb Message) {
 	}
 }
 
-// A global registry of extensions.
-// The generated code will register the generated descriptors by calling RegisterExtension.
-
-var extensionMaps = make(map[reflect.Type]map[int32]*ExtensionDesc)
-
 // RegisterExtension is called from the generated code.
 func RegisterExtension(desc *ExtensionDesc) {
-	st := reflect.TypeOf(desc.ExtendedType).Elem()
-	m := extensionMaps[st]
-	if m == nil {
-		m = make(map[int32]*ExtensionDesc)
-		extensionMaps[st] = m
-	}
-	if _, ok := m[desc.Field]; ok {
-		panic("proto: duplicate extension registered: " + st.String() + " " + strconv.Itoa(int(desc.Field)))
+	if err := GlobalRegistry.RegisterExtension(desc); err != nil {
+		panic(err.Error())
 	}
-	m[desc.Field] = desc
 }
 
 // RegisteredExtensions returns a map of the registered extensions of a
 // protocol buffer struct, indexed by the extension number.
 // The argument pb should be a nil pointer to the struct type.
 func RegisteredExtensions(pb Message) map[int32]*ExtensionDesc {
-	return extensionMaps[reflect.TypeOf(pb).Elem()]
+	return GlobalRegistry.RegisteredExtensions(pb)
 }
@@ -0,0 +1,921 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fastpath_proto/fastpath.proto
+
+/*
+Package fastpath_proto is a generated protocol buffer package.
+
+It is generated from these files:
+
+	fastpath_proto/fastpath.proto
+	fastpath_proto/fastpath3.proto
+
+It has these top-level messages:
+
+	Inner
+	Outer
+	Proto3
+*/
+package fastpath_proto
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/any"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+var Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+func (x *Color) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Color_value, data, "Color")
+	if err != nil {
+		return err
+	}
+	*x = Color(value)
+	return nil
+}
+func (Color) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type Inner struct {
+	Id               *int32  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name             *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Values           []int64 `protobuf:"varint,3,rep,name=values" json:"values,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Inner) Reset()                    { *m = Inner{} }
+func (m *Inner) String() string            { return proto.CompactTextString(m) }
+func (*Inner) ProtoMessage()               {}
+func (*Inner) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Inner) GetId() int32 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *Inner) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Inner) GetValues() []int64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m.
+func (m *Inner) Clone() *Inner {
+	if m == nil {
+		return nil
+	}
+	c := new(Inner)
+	c.Merge(m)
+	return c
+}
+
+// Merge merges src, which must be a *Inner, into m by the rules of proto.Merge.
+func (m *Inner) Merge(src proto.Message) {
+	s := src.(*Inner)
+	if s == nil {
+		return
+	}
+	if s.Id != nil {
+		v := *s.Id
+		m.Id = &v
+	}
+	if s.Name != nil {
+		v := *s.Name
+		m.Name = &v
+	}
+	if s.Values != nil {
+		if m.Values == nil {
+			m.Values = make([]int64, 0, len(s.Values))
+		}
+		m.Values = append(m.Values, s.Values...)
+	}
+	if len(s.XXX_unrecognized) > 0 {
+		m.XXX_unrecognized = append([]byte(nil), s.XXX_unrecognized...)
+	}
+}
+
+// Equal reports whether m and other are equal by the rules of proto.Equal.
+func (m *Inner) Equal(other proto.Message) bool {
+	o, ok := other.(*Inner)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.Id == nil) != (o.Id == nil) || m.Id != nil && *m.Id != *o.Id {
+		return false
+	}
+	if (m.Name == nil) != (o.Name == nil) || m.Name != nil && *m.Name != *o.Name {
+		return false
+	}
+	if len(m.Values) != len(o.Values) {
+		return false
+	}
+	for i, x := range m.Values {
+		if x != o.Values[i] {
+			return false
+		}
+	}
+	return string(m.XXX_unrecognized) == string(o.XXX_unrecognized)
+}
+
+type Outer struct {
+	Id       *int32            `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name     *string           `protobuf:"bytes,2,opt,name=name,def=outer" json:"name,omitempty"`
+	Data     []byte            `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	Ratio    *float64          `protobuf:"fixed64,4,opt,name=ratio" json:"ratio,omitempty"`
+	Flag     *bool             `protobuf:"varint,5,opt,name=flag" json:"flag,omitempty"`
+	Color    *Color            `protobuf:"varint,6,opt,name=color,enum=fastpath_proto.Color" json:"color,omitempty"`
+	Inner    *Inner            `protobuf:"bytes,7,opt,name=inner" json:"inner,omitempty"`
+	Inners   []*Inner          `protobuf:"bytes,8,rep,name=inners" json:"inners,omitempty"`
+	Numbers  []int32           `protobuf:"varint,9,rep,packed,name=numbers" json:"numbers,omitempty"`
+	Tags     []string          `protobuf:"bytes,10,rep,name=tags" json:"tags,omitempty"`
+	Blobs    [][]byte          `protobuf:"bytes,11,rep,name=blobs" json:"blobs,omitempty"`
+	Counts   map[string]int32  `protobuf:"bytes,12,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	InnerMap map[int32]*Inner  `protobuf:"bytes,13,rep,name=inner_map,json=innerMap" json:"inner_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BlobMap  map[string][]byte `protobuf:"bytes,14,rep,name=blob_map,json=blobMap" json:"blob_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//	*Outer_Number
+	//	*Outer_Text
+	//	*Outer_Raw
+	//	*Outer_Nested
+	Choice                       isOuter_Choice       `protobuf_oneof:"choice"`
+	Any                          *google_protobuf.Any `protobuf:"bytes,19,opt,name=any" json:"any,omitempty"`
+	Group                        *Outer_Group         `protobuf:"group,20,opt,name=Group,json=group" json:"group,omitempty"`
+	Child                        *Outer               `protobuf:"bytes,22,opt,name=child" json:"child,omitempty"`
+	LazyInner                    *Inner               `protobuf:"bytes,23,opt,name=lazy_inner,json=lazyInner,lazy" json:"lazy_inner,omitempty"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_lazy                     proto.XXX_LazyFields `json:"-"`
+	XXX_unrecognized             []byte               `json:"-"`
+}
+
+func (m *Outer) Reset()                    { *m = Outer{} }
+func (m *Outer) String() string            { return proto.CompactTextString(m) }
+func (*Outer) ProtoMessage()               {}
+func (*Outer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+var extRange_Outer = []proto.ExtensionRange{
+	{100, 199},
+}
+
+func (*Outer) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_Outer
+}
+
+const Default_Outer_Name string = "outer"
+
+type isOuter_Choice interface{ isOuter_Choice() }
+
+type Outer_Number struct {
+	Number int32 `protobuf:"varint,15,opt,name=number,oneof"`
+}
+type Outer_Text struct {
+	Text string `protobuf:"bytes,16,opt,name=text,oneof"`
+}
+type Outer_Raw struct {
+	Raw []byte `protobuf:"bytes,17,opt,name=raw,oneof"`
+}
+type Outer_Nested struct {
+	Nested *Inner `protobuf:"bytes,18,opt,name=nested,oneof"`
+}
+
+func (*Outer_Number) isOuter_Choice() {}
+func (*Outer_Text) isOuter_Choice()   {}
+func (*Outer_Raw) isOuter_Choice()    {}
+func (*Outer_Nested) isOuter_Choice() {}
+
+func (m *Outer) GetChoice() isOuter_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Outer) GetId() int32 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *Outer) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return Default_Outer_Name
+}
+
+func (m *Outer) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Outer) GetRatio() float64 {
+	if m != nil && m.Ratio != nil {
+		return *m.Ratio
+	}
+	return 0
+}
+
+func (m *Outer) GetFlag() bool {
+	if m != nil && m.Flag != nil {
+		return *m.Flag
+	}
+	return false
+}
+
+func (m *Outer) GetColor() Color {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return Color_RED
+}
+
+func (m *Outer) GetInner() *Inner {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *Outer) GetInners() []*Inner {
+	if m != nil {
+		return m.Inners
+	}
+	return nil
+}
+
+func (m *Outer) GetNumbers() []int32 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+func (m *Outer) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Outer) GetBlobs() [][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Outer) GetCounts() map[string]int32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Outer) GetInnerMap() map[int32]*Inner {
+	if m != nil {
+		return m.InnerMap
+	}
+	return nil
+}
+
+func (m *Outer) GetBlobMap() map[string][]byte {
+	if m != nil {
+		return m.BlobMap
+	}
+	return nil
+}
+
+func (m *Outer) GetNumber() int32 {
+	if x, ok := m.GetChoice().(*Outer_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *Outer) GetText() string {
+	if x, ok := m.GetChoice().(*Outer_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Outer) GetRaw() []byte {
+	if x, ok := m.GetChoice().(*Outer_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+func (m *Outer) GetNested() *Inner {
+	if x, ok := m.GetChoice().(*Outer_Nested); ok {
+		return x.Nested
+	}
+	return nil
+}
+
+func (m *Outer) GetAny() *google_protobuf.Any {
+	if m != nil {
+		return m.Any
+	}
+	return nil
+}
+
+func (m *Outer) GetGroup() *Outer_Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *Outer) GetChild() *Outer {
+	if m != nil {
+		return m.Child
+	}
+	return nil
+}
+
+func (m *Outer) GetLazyInner() *Inner {
+	if m != nil {
+		m.XXX_lazy.Resolve(23)
+		return m.LazyInner
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m.
+func (m *Outer) Clone() *Outer {
+	if m == nil {
+		return nil
+	}
+	c := new(Outer)
+	c.Merge(m)
+	return c
+}
+
+// Merge merges src, which must be a *Outer, into m by the rules of proto.Merge.
+func (m *Outer) Merge(src proto.Message) {
+	s := src.(*Outer)
+	if s == nil {
+		return
+	}
+	proto.ResolveLazy(m)
+	proto.ResolveLazy(s)
+	if s.Id != nil {
+		v := *s.Id
+		m.Id = &v
+	}
+	if s.Name != nil {
+		v := *s.Name
+		m.Name = &v
+	}
+	if s.Data != nil {
+		m.Data = append([]byte{}, s.Data...)
+	}
+	if s.Ratio != nil {
+		v := *s.Ratio
+		m.Ratio = &v
+	}
+	if s.Flag != nil {
+		v := *s.Flag
+		m.Flag = &v
+	}
+	if s.Color != nil {
+		v := *s.Color
+		m.Color = &v
+	}
+	if s.Inner != nil {
+		if m.Inner == nil {
+			m.Inner = new(Inner)
+		}
+		m.Inner.Merge(s.Inner)
+	}
+	if s.Inners != nil {
+		if m.Inners == nil {
+			m.Inners = make([]*Inner, 0, len(s.Inners))
+		}
+		for _, x := range s.Inners {
+			var c *Inner
+			if x != nil {
+				c = new(Inner)
+				c.Merge(x)
+			}
+			m.Inners = append(m.Inners, c)
+		}
+	}
+	if s.Numbers != nil {
+		if m.Numbers == nil {
+			m.Numbers = make([]int32, 0, len(s.Numbers))
+		}
+		m.Numbers = append(m.Numbers, s.Numbers...)
+	}
+	if s.Tags != nil {
+		if m.Tags == nil {
+			m.Tags = make([]string, 0, len(s.Tags))
+		}
+		m.Tags = append(m.Tags, s.Tags...)
+	}
+	if s.Blobs != nil {
+		if m.Blobs == nil {
+			m.Blobs = make([][]byte, 0, len(s.Blobs))
+		}
+		for _, x := range s.Blobs {
+			var c []byte
+			if x != nil {
+				c = append([]byte{}, x...)
+			}
+			m.Blobs = append(m.Blobs, c)
+		}
+	}
+	if len(s.Counts) > 0 {
+		if m.Counts == nil {
+			m.Counts = make(map[string]int32, len(s.Counts))
+		}
+		for k, v := range s.Counts {
+			m.Counts[k] = v
+		}
+	}
+	if len(s.InnerMap) > 0 {
+		if m.InnerMap == nil {
+			m.InnerMap = make(map[int32]*Inner, len(s.InnerMap))
+		}
+		for k, v := range s.InnerMap {
+			c := new(Inner)
+			c.Merge(v)
+			m.InnerMap[k] = c
+		}
+	}
+	if len(s.BlobMap) > 0 {
+		if m.BlobMap == nil {
+			m.BlobMap = make(map[string][]byte, len(s.BlobMap))
+		}
+		for k, v := range s.BlobMap {
+			m.BlobMap[k] = append([]byte{}, v...)
+		}
+	}
+	if s.Any != nil {
+		if m.Any == nil {
+			m.Any = new(google_protobuf.Any)
+		}
+		proto.Merge(m.Any, s.Any)
+	}
+	if s.Group != nil {
+		if m.Group == nil {
+			m.Group = new(Outer_Group)
+		}
+		m.Group.Merge(s.Group)
+	}
+	if s.Child != nil {
+		if m.Child == nil {
+			m.Child = new(Outer)
+		}
+		m.Child.Merge(s.Child)
+	}
+	if s.LazyInner != nil {
+		if m.LazyInner == nil {
+			m.LazyInner = new(Inner)
+		}
+		m.LazyInner.Merge(s.LazyInner)
+	}
+	switch x := s.Choice.(type) {
+	case *Outer_Number:
+		m.Choice = &Outer_Number{Number: x.Number}
+	case *Outer_Text:
+		m.Choice = &Outer_Text{Text: x.Text}
+	case *Outer_Raw:
+		var c []byte
+		if x.Raw != nil {
+			c = append([]byte{}, x.Raw...)
+		}
+		m.Choice = &Outer_Raw{Raw: c}
+	case *Outer_Nested:
+		y, ok := m.Choice.(*Outer_Nested)
+		if !ok {
+			y = new(Outer_Nested)
+			m.Choice = y
+		}
+		if x.Nested != nil {
+			if y.Nested == nil {
+				y.Nested = new(Inner)
+			}
+			y.Nested.Merge(x.Nested)
+		}
+	}
+	proto.XXX_MergeExtensions(m, s)
+	if len(s.XXX_unrecognized) > 0 {
+		m.XXX_unrecognized = append([]byte(nil), s.XXX_unrecognized...)
+	}
+}
+
+// Equal reports whether m and other are equal by the rules of proto.Equal.
+func (m *Outer) Equal(other proto.Message) bool {
+	o, ok := other.(*Outer)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	proto.ResolveLazy(m)
+	proto.ResolveLazy(o)
+	if (m.Id == nil) != (o.Id == nil) || m.Id != nil && *m.Id != *o.Id {
+		return false
+	}
+	if (m.Name == nil) != (o.Name == nil) || m.Name != nil && *m.Name != *o.Name {
+		return false
+	}
+	if (m.Data == nil) != (o.Data == nil) || string(m.Data) != string(o.Data) {
+		return false
+	}
+	if (m.Ratio == nil) != (o.Ratio == nil) || m.Ratio != nil && *m.Ratio != *o.Ratio {
+		return false
+	}
+	if (m.Flag == nil) != (o.Flag == nil) || m.Flag != nil && *m.Flag != *o.Flag {
+		return false
+	}
+	if (m.Color == nil) != (o.Color == nil) || m.Color != nil && *m.Color != *o.Color {
+		return false
+	}
+	if !m.Inner.Equal(o.Inner) {
+		return false
+	}
+	if len(m.Inners) != len(o.Inners) {
+		return false
+	}
+	for i, x := range m.Inners {
+		if !x.Equal(o.Inners[i]) {
+			return false
+		}
+	}
+	if len(m.Numbers) != len(o.Numbers) {
+		return false
+	}
+	for i, x := range m.Numbers {
+		if x != o.Numbers[i] {
+			return false
+		}
+	}
+	if len(m.Tags) != len(o.Tags) {
+		return false
+	}
+	for i, x := range m.Tags {
+		if x != o.Tags[i] {
+			return false
+		}
+	}
+	if len(m.Blobs) != len(o.Blobs) {
+		return false
+	}
+	for i, x := range m.Blobs {
+		if (x == nil) != (o.Blobs[i] == nil) || string(x) != string(o.Blobs[i]) {
+			return false
+		}
+	}
+	if len(m.Counts) != len(o.Counts) {
+		return false
+	}
+	for k, v := range m.Counts {
+		w, ok := o.Counts[k]
+		if !ok || v != w {
+			return false
+		}
+	}
+	if len(m.InnerMap) != len(o.InnerMap) {
+		return false
+	}
+	for k, v := range m.InnerMap {
+		w, ok := o.InnerMap[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	if len(m.BlobMap) != len(o.BlobMap) {
+		return false
+	}
+	for k, v := range m.BlobMap {
+		w, ok := o.BlobMap[k]
+		if !ok || (v == nil) != (w == nil) || string(v) != string(w) {
+			return false
+		}
+	}
+	if !proto.Equal(m.Any, o.Any) {
+		return false
+	}
+	if !m.Group.Equal(o.Group) {
+		return false
+	}
+	if !m.Child.Equal(o.Child) {
+		return false
+	}
+	if !m.LazyInner.Equal(o.LazyInner) {
+		return false
+	}
+	switch x := m.Choice.(type) {
+	case *Outer_Number:
+		y, ok := o.Choice.(*Outer_Number)
+		if !ok || x.Number != y.Number {
+			return false
+		}
+	case *Outer_Text:
+		y, ok := o.Choice.(*Outer_Text)
+		if !ok || x.Text != y.Text {
+			return false
+		}
+	case *Outer_Raw:
+		y, ok := o.Choice.(*Outer_Raw)
+		if !ok || (x.Raw == nil) != (y.Raw == nil) || string(x.Raw) != string(y.Raw) {
+			return false
+		}
+	case *Outer_Nested:
+		y, ok := o.Choice.(*Outer_Nested)
+		if !ok || !x.Nested.Equal(y.Nested) {
+			return false
+		}
+	case nil:
+		if o.Choice != nil {
+			return false
+		}
+	}
+	if !proto.XXX_EqualExtensions(m, o) {
+		return false
+	}
+	return string(m.XXX_unrecognized) == string(o.XXX_unrecognized)
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Outer) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Outer_OneofMarshaler, _Outer_OneofUnmarshaler, _Outer_OneofSizer, []interface{}{
+		(*Outer_Number)(nil),
+		(*Outer_Text)(nil),
+		(*Outer_Raw)(nil),
+		(*Outer_Nested)(nil),
+	}
+}
+
+func _Outer_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Outer)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Outer_Number:
+		b.EncodeVarint(15<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case *Outer_Text:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Text)
+	case *Outer_Raw:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case *Outer_Nested:
+		b.EncodeVarint(18<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Nested); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Outer.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Outer_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Outer)
+	switch tag {
+	case 15: // choice.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Outer_Number{int32(x)}
+		return true, err
+	case 16: // choice.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Choice = &Outer_Text{x}
+		return true, err
+	case 17: // choice.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Choice = &Outer_Raw{x}
+		return true, err
+	case 18: // choice.nested
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Inner)
+		err := b.DecodeMessage(msg)
+		m.Choice = &Outer_Nested{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Outer_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Outer)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Outer_Number:
+		n += proto.SizeVarint(15<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case *Outer_Text:
+		n += proto.SizeVarint(16<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Text)))
+		n += len(x.Text)
+	case *Outer_Raw:
+		n += proto.SizeVarint(17<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case *Outer_Nested:
+		s := proto.Size(x.Nested)
+		n += proto.SizeVarint(18<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Outer_Group struct {
+	Value            *int32 `protobuf:"varint,21,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Outer_Group) Reset()                    { *m = Outer_Group{} }
+func (m *Outer_Group) String() string            { return proto.CompactTextString(m) }
+func (*Outer_Group) ProtoMessage()               {}
+func (*Outer_Group) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 3} }
+
+func (m *Outer_Group) GetValue() int32 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+// Clone returns a deep copy of m.
+func (m *Outer_Group) Clone() *Outer_Group {
+	if m == nil {
+		return nil
+	}
+	c := new(Outer_Group)
+	c.Merge(m)
+	return c
+}
+
+// Merge merges src, which must be a *Outer_Group, into m by the rules of proto.Merge.
+func (m *Outer_Group) Merge(src proto.Message) {
+	s := src.(*Outer_Group)
+	if s == nil {
+		return
+	}
+	if s.Value != nil {
+		v := *s.Value
+		m.Value = &v
+	}
+	if len(s.XXX_unrecognized) > 0 {
+		m.XXX_unrecognized = append([]byte(nil), s.XXX_unrecognized...)
+	}
+}
+
+// Equal reports whether m and other are equal by the rules of proto.Equal.
+func (m *Outer_Group) Equal(other proto.Message) bool {
+	o, ok := other.(*Outer_Group)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.Value == nil) != (o.Value == nil) || m.Value != nil && *m.Value != *o.Value {
+		return false
+	}
+	return string(m.XXX_unrecognized) == string(o.XXX_unrecognized)
+}
+
+var E_ExtInner = &proto.ExtensionDesc{
+	ExtendedType:  (*Outer)(nil),
+	ExtensionType: (*Inner)(nil),
+	Field:         100,
+	Name:          "fastpath_proto.ext_inner",
+	Tag:           "bytes,100,opt,name=ext_inner,json=extInner",
+	Filename:      "fastpath_proto/fastpath.proto",
+}
+
+var E_ExtNumbers = &proto.ExtensionDesc{
+	ExtendedType:  (*Outer)(nil),
+	ExtensionType: ([]int32)(nil),
+	Field:         101,
+	Name:          "fastpath_proto.ext_numbers",
+	Tag:           "varint,101,rep,name=ext_numbers,json=extNumbers",
+	Filename:      "fastpath_proto/fastpath.proto",
+}
+
+func init() {
+	proto.RegisterType((*Inner)(nil), "fastpath_proto.Inner")
+	proto.RegisterType((*Outer)(nil), "fastpath_proto.Outer")
+	proto.RegisterType((*Outer_Group)(nil), "fastpath_proto.Outer.Group")
+	proto.RegisterEnum("fastpath_proto.Color", Color_name, Color_value)
+	proto.RegisterExtension(E_ExtInner)
+	proto.RegisterExtension(E_ExtNumbers)
+}
+
+func init() { proto.RegisterFile("fastpath_proto/fastpath.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xd1, 0x6e, 0xd3, 0x4a,
+	0x10, 0x86, 0xb3, 0x76, 0x36, 0x71, 0x26, 0x69, 0x8e, 0xcf, 0x9e, 0xb4, 0x67, 0x5b, 0xa8, 0x64,
+	0x8a, 0x40, 0x56, 0x2b, 0x1c, 0xd1, 0x0b, 0xd4, 0x46, 0x42, 0x82, 0x94, 0xa8, 0xad, 0x04, 0x45,
+	0x5a, 0x89, 0xeb, 0x6a, 0x13, 0xbb, 0xa9, 0x85, 0x6b, 0x47, 0xf6, 0x06, 0x12, 0x5e, 0x8a, 0xd7,
+	0xe0, 0xb1, 0xd0, 0xce, 0x3a, 0xd4, 0x85, 0xb4, 0xdc, 0xcd, 0x9f, 0x7c, 0xf3, 0x7b, 0x66, 0x67,
+	0x06, 0x76, 0xaf, 0x64, 0xa1, 0x66, 0x52, 0x5d, 0x5f, 0xce, 0xf2, 0x4c, 0x65, 0xfd, 0x95, 0x0c,
+	0x50, 0xb2, 0xee, 0xdd, 0xbf, 0x77, 0xb6, 0xa7, 0x59, 0x36, 0x4d, 0xa2, 0x3e, 0xaa, 0xf1, 0xfc,
+	0xaa, 0x2f, 0xd3, 0xa5, 0x41, 0xf7, 0x4e, 0x80, 0x9e, 0xa7, 0x69, 0x94, 0xb3, 0x2e, 0x58, 0x71,
+	0xc8, 0x89, 0x47, 0x7c, 0x2a, 0xac, 0x38, 0x64, 0x0c, 0xea, 0xa9, 0xbc, 0x89, 0xb8, 0xe5, 0x11,
+	0xbf, 0x25, 0x30, 0x66, 0x5b, 0xd0, 0xf8, 0x22, 0x93, 0x79, 0x54, 0x70, 0xdb, 0xb3, 0x7d, 0x5b,
+	0x94, 0x6a, 0xef, 0xbb, 0x03, 0xf4, 0xe3, 0x5c, 0x55, 0x5c, 0xac, 0xd2, 0x65, 0xbb, 0xea, 0x32,
+	0xa0, 0x99, 0x86, 0x4a, 0x33, 0x06, 0xf5, 0x50, 0x2a, 0xc9, 0x6d, 0x8f, 0xf8, 0x1d, 0x81, 0x31,
+	0xeb, 0x01, 0xcd, 0xa5, 0x8a, 0x33, 0x5e, 0xf7, 0x88, 0x4f, 0x84, 0x11, 0x9a, 0xbc, 0x4a, 0xe4,
+	0x94, 0x53, 0x8f, 0xf8, 0x8e, 0xc0, 0x98, 0x1d, 0x00, 0x9d, 0x64, 0x49, 0x96, 0xf3, 0x86, 0x47,
+	0xfc, 0xee, 0xe1, 0x66, 0x70, 0xb7, 0xe5, 0xe0, 0x44, 0xff, 0x29, 0x0c, 0xa3, 0xe1, 0x58, 0x37,
+	0xc9, 0x9b, 0x1e, 0xf1, 0xdb, 0x7f, 0xc2, 0xf8, 0x02, 0xc2, 0x30, 0xec, 0x05, 0x34, 0x30, 0x28,
+	0xb8, 0xe3, 0xd9, 0xf7, 0xd3, 0x25, 0xc4, 0x1e, 0x43, 0x33, 0x9d, 0xdf, 0x8c, 0x35, 0xdf, 0xf2,
+	0x6c, 0x9f, 0x0e, 0x2d, 0x97, 0x88, 0xd5, 0x4f, 0xba, 0x74, 0x25, 0xa7, 0x05, 0x07, 0xcf, 0xd6,
+	0xaf, 0xa8, 0x63, 0xdd, 0xe4, 0x38, 0xc9, 0xc6, 0x05, 0x6f, 0x7b, 0xb6, 0xdf, 0x11, 0x46, 0xb0,
+	0x63, 0x68, 0x4c, 0xb2, 0x79, 0xaa, 0x0a, 0xde, 0xc1, 0xcf, 0x3e, 0xf9, 0xfd, 0xb3, 0xf8, 0xc0,
+	0xc1, 0x09, 0x32, 0xa3, 0x54, 0xe5, 0x4b, 0x51, 0x26, 0xb0, 0x37, 0xd0, 0xc2, 0x62, 0x2e, 0x6f,
+	0xe4, 0x8c, 0x6f, 0x60, 0xf6, 0xd3, 0xf5, 0xd9, 0x58, 0xfa, 0x07, 0x39, 0x33, 0xf9, 0x4e, 0x5c,
+	0x4a, 0xf6, 0x1a, 0x1c, 0x5d, 0x05, 0x1a, 0x74, 0xd1, 0x60, 0x6f, 0xbd, 0xc1, 0x30, 0xc9, 0xc6,
+	0xbf, 0xf2, 0x9b, 0x63, 0xa3, 0x18, 0x87, 0x86, 0x69, 0x98, 0xff, 0xa3, 0xf7, 0xe7, 0xac, 0x26,
+	0x4a, 0xcd, 0x7a, 0x50, 0x57, 0xd1, 0x42, 0x71, 0x57, 0xcf, 0xff, 0xac, 0x26, 0x50, 0x31, 0x06,
+	0x76, 0x2e, 0xbf, 0xf2, 0x7f, 0xf5, 0xe4, 0xcf, 0x6a, 0x42, 0x0b, 0xd6, 0x87, 0x46, 0x1a, 0x15,
+	0x2a, 0x0a, 0x39, 0x7b, 0x60, 0x48, 0x68, 0x8d, 0x18, 0x7b, 0x0e, 0xb6, 0x4c, 0x97, 0xfc, 0x3f,
+	0xa4, 0x7b, 0x81, 0x59, 0xf1, 0x60, 0xb5, 0xe2, 0xc1, 0xdb, 0x74, 0x29, 0x34, 0xc0, 0x5e, 0x02,
+	0x9d, 0xe6, 0xd9, 0x7c, 0xc6, 0x7b, 0x1e, 0xf1, 0xe1, 0xf0, 0xd1, 0xfa, 0xc6, 0x4e, 0x35, 0x22,
+	0x0c, 0x89, 0xcb, 0x75, 0x1d, 0x27, 0x21, 0xdf, 0x5a, 0x5f, 0x0a, 0xa6, 0x08, 0xc3, 0xb0, 0x23,
+	0x80, 0x44, 0x7e, 0x5b, 0x5e, 0x9a, 0x0d, 0xfb, 0xff, 0x81, 0xe2, 0x87, 0x96, 0x4f, 0x44, 0x4b,
+	0xc3, 0x28, 0x77, 0x8e, 0xa1, 0x5d, 0x19, 0x27, 0x73, 0xc1, 0xfe, 0x1c, 0x2d, 0xf1, 0x04, 0x5b,
+	0x42, 0x87, 0x7a, 0x53, 0xf0, 0xc2, 0xf0, 0x7c, 0xa8, 0x30, 0x62, 0x60, 0x1d, 0x91, 0x1d, 0x01,
+	0x1b, 0x77, 0x66, 0x59, 0x4d, 0xa6, 0x26, 0xf9, 0xa0, 0x9a, 0x7c, 0xff, 0xd2, 0xdf, 0x7a, 0x0e,
+	0xa0, 0x53, 0x1d, 0xef, 0xdf, 0xea, 0xe9, 0x54, 0x73, 0x77, 0x81, 0xe2, 0x0b, 0xde, 0x22, 0x9b,
+	0x95, 0x92, 0xf7, 0xa9, 0x13, 0xba, 0x3f, 0xc8, 0xd0, 0x81, 0xc6, 0xe4, 0x3a, 0x8b, 0x27, 0xd1,
+	0xfe, 0x33, 0xa0, 0x78, 0xa1, 0xac, 0x09, 0xb6, 0x18, 0xbd, 0x73, 0x6b, 0xac, 0x05, 0xf4, 0x54,
+	0x8c, 0x46, 0x17, 0x2e, 0x61, 0x0e, 0xd4, 0x87, 0xef, 0x3f, 0x8d, 0x5c, 0x6b, 0x70, 0x0e, 0xad,
+	0x68, 0xa1, 0xcc, 0xd3, 0xb2, 0xf5, 0x63, 0xe0, 0xe1, 0x43, 0xed, 0x39, 0xd1, 0x42, 0x61, 0x34,
+	0x78, 0x05, 0x6d, 0x6d, 0xb5, 0x3a, 0xcc, 0x7b, 0xcc, 0x22, 0x7d, 0xc2, 0x02, 0xa2, 0x85, 0xba,
+	0x30, 0xe0, 0xcf, 0x01, 0x00, 0x15, 0x11, 0x28, 0xe8, 0x6b, 0x05, 0x00, 0x00,
+}
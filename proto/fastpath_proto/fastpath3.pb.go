@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fastpath_proto/fastpath3.proto
+
+package fastpath_proto
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Proto3_Kind int32
+
+const (
+	Proto3_NONE Proto3_Kind = 0
+	Proto3_SOME Proto3_Kind = 1
+)
+
+var Proto3_Kind_name = map[int32]string{
+	0: "NONE",
+	1: "SOME",
+}
+var Proto3_Kind_value = map[string]int32{
+	"NONE": 0,
+	"SOME": 1,
+}
+
+func (x Proto3_Kind) String() string {
+	return proto.EnumName(Proto3_Kind_name, int32(x))
+}
+func (Proto3_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0, 0} }
+
+type Proto3 struct {
+	Id       int32              `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name     string             `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Data     []byte             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Ratio    float64            `protobuf:"fixed64,4,opt,name=ratio" json:"ratio,omitempty"`
+	Flag     bool               `protobuf:"varint,5,opt,name=flag" json:"flag,omitempty"`
+	Kind     Proto3_Kind        `protobuf:"varint,6,opt,name=kind,enum=fastpath_proto.Proto3_Kind" json:"kind,omitempty"`
+	Child    *Proto3            `protobuf:"bytes,7,opt,name=child" json:"child,omitempty"`
+	Blobs    [][]byte           `protobuf:"bytes,8,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Children map[string]*Proto3 `protobuf:"bytes,9,rep,name=children" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//	*Proto3_Number
+	//	*Proto3_Raw
+	Choice           isProto3_Choice `protobuf_oneof:"choice"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *Proto3) Reset()                    { *m = Proto3{} }
+func (m *Proto3) String() string            { return proto.CompactTextString(m) }
+func (*Proto3) ProtoMessage()               {}
+func (*Proto3) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+
+type isProto3_Choice interface{ isProto3_Choice() }
+
+type Proto3_Number struct {
+	Number int32 `protobuf:"varint,10,opt,name=number,oneof"`
+}
+type Proto3_Raw struct {
+	Raw []byte `protobuf:"bytes,11,opt,name=raw,proto3,oneof"`
+}
+
+func (*Proto3_Number) isProto3_Choice() {}
+func (*Proto3_Raw) isProto3_Choice()    {}
+
+func (m *Proto3) GetChoice() isProto3_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (m *Proto3) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proto3) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Proto3) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Proto3) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *Proto3) GetFlag() bool {
+	if m != nil {
+		return m.Flag
+	}
+	return false
+}
+
+func (m *Proto3) GetKind() Proto3_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return Proto3_NONE
+}
+
+func (m *Proto3) GetChild() *Proto3 {
+	if m != nil {
+		return m.Child
+	}
+	return nil
+}
+
+func (m *Proto3) GetBlobs() [][]byte {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *Proto3) GetChildren() map[string]*Proto3 {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func (m *Proto3) GetNumber() int32 {
+	if x, ok := m.GetChoice().(*Proto3_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *Proto3) GetRaw() []byte {
+	if x, ok := m.GetChoice().(*Proto3_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+// Clone returns a deep copy of m.
+func (m *Proto3) Clone() *Proto3 {
+	if m == nil {
+		return nil
+	}
+	c := new(Proto3)
+	c.Merge(m)
+	return c
+}
+
+// Merge merges src, which must be a *Proto3, into m by the rules of proto.Merge.
+func (m *Proto3) Merge(src proto.Message) {
+	s := src.(*Proto3)
+	if s == nil {
+		return
+	}
+	if s.Id != 0 {
+		m.Id = s.Id
+	}
+	if s.Name != "" {
+		m.Name = s.Name
+	}
+	if len(s.Data) > 0 {
+		m.Data = append([]byte{}, s.Data...)
+	}
+	if s.Ratio != 0 {
+		m.Ratio = s.Ratio
+	}
+	if s.Flag {
+		m.Flag = s.Flag
+	}
+	if s.Kind != 0 {
+		m.Kind = s.Kind
+	}
+	if s.Child != nil {
+		if m.Child == nil {
+			m.Child = new(Proto3)
+		}
+		m.Child.Merge(s.Child)
+	}
+	if s.Blobs != nil {
+		if m.Blobs == nil {
+			m.Blobs = make([][]byte, 0, len(s.Blobs))
+		}
+		for _, x := range s.Blobs {
+			var c []byte
+			if x != nil {
+				c = append([]byte{}, x...)
+			}
+			m.Blobs = append(m.Blobs, c)
+		}
+	}
+	if len(s.Children) > 0 {
+		if m.Children == nil {
+			m.Children = make(map[string]*Proto3, len(s.Children))
+		}
+		for k, v := range s.Children {
+			c := new(Proto3)
+			c.Merge(v)
+			m.Children[k] = c
+		}
+	}
+	switch x := s.Choice.(type) {
+	case *Proto3_Number:
+		m.Choice = &Proto3_Number{Number: x.Number}
+	case *Proto3_Raw:
+		var c []byte
+		if x.Raw != nil {
+			c = append([]byte{}, x.Raw...)
+		}
+		m.Choice = &Proto3_Raw{Raw: c}
+	}
+	if len(s.XXX_unrecognized) > 0 {
+		m.XXX_unrecognized = append([]byte(nil), s.XXX_unrecognized...)
+	}
+}
+
+// Equal reports whether m and other are equal by the rules of proto.Equal.
+func (m *Proto3) Equal(other proto.Message) bool {
+	o, ok := other.(*Proto3)
+	if !ok {
+		return false
+	}
+	if m == nil || o == nil {
+		return m == o
+	}
+	if m.Id != o.Id {
+		return false
+	}
+	if m.Name != o.Name {
+		return false
+	}
+	if string(m.Data) != string(o.Data) {
+		return false
+	}
+	if m.Ratio != o.Ratio {
+		return false
+	}
+	if m.Flag != o.Flag {
+		return false
+	}
+	if m.Kind != o.Kind {
+		return false
+	}
+	if !m.Child.Equal(o.Child) {
+		return false
+	}
+	if len(m.Blobs) != len(o.Blobs) {
+		return false
+	}
+	for i, x := range m.Blobs {
+		if string(x) != string(o.Blobs[i]) {
+			return false
+		}
+	}
+	if len(m.Children) != len(o.Children) {
+		return false
+	}
+	for k, v := range m.Children {
+		w, ok := o.Children[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	switch x := m.Choice.(type) {
+	case *Proto3_Number:
+		y, ok := o.Choice.(*Proto3_Number)
+		if !ok || x.Number != y.Number {
+			return false
+		}
+	case *Proto3_Raw:
+		y, ok := o.Choice.(*Proto3_Raw)
+		if !ok || (x.Raw == nil) != (y.Raw == nil) || string(x.Raw) != string(y.Raw) {
+			return false
+		}
+	case nil:
+		if o.Choice != nil {
+			return false
+		}
+	}
+	return string(m.XXX_unrecognized) == string(o.XXX_unrecognized)
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Proto3) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Proto3_OneofMarshaler, _Proto3_OneofUnmarshaler, _Proto3_OneofSizer, []interface{}{
+		(*Proto3_Number)(nil),
+		(*Proto3_Raw)(nil),
+	}
+}
+
+func _Proto3_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Proto3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Proto3_Number:
+		b.EncodeVarint(10<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case *Proto3_Raw:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.Raw)
+	case nil:
+	default:
+		return fmt.Errorf("Proto3.Choice has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Proto3_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Proto3)
+	switch tag {
+	case 10: // choice.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Choice = &Proto3_Number{int32(x)}
+		return true, err
+	case 11: // choice.raw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Choice = &Proto3_Raw{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Proto3_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Proto3)
+	// choice
+	switch x := m.Choice.(type) {
+	case *Proto3_Number:
+		n += proto.SizeVarint(10<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case *Proto3_Raw:
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Raw)))
+		n += len(x.Raw)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Proto3)(nil), "fastpath_proto.Proto3")
+	proto.RegisterEnum("fastpath_proto.Proto3_Kind", Proto3_Kind_name, Proto3_Kind_value)
+}
+
+func init() { proto.RegisterFile("fastpath_proto/fastpath3.proto", fileDescriptor1) }
+
+var fileDescriptor1 = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xdf, 0x6a, 0xf2, 0x40,
+	0x10, 0xc5, 0xdd, 0xfc, 0xfb, 0xe2, 0xe8, 0x27, 0x32, 0x94, 0xb2, 0x58, 0x28, 0x8b, 0xf4, 0x62,
+	0x2f, 0x4a, 0x04, 0xbd, 0x29, 0xbd, 0x2a, 0x2d, 0x82, 0x50, 0xaa, 0x65, 0x7c, 0x80, 0xb2, 0x31,
+	0xb1, 0x2e, 0xc6, 0x44, 0x62, 0x6c, 0xf1, 0x15, 0xfb, 0x54, 0x65, 0x37, 0xb6, 0x20, 0xb4, 0xbd,
+	0x3b, 0xbf, 0xcc, 0x99, 0xec, 0x99, 0x03, 0x97, 0x4b, 0xb5, 0xab, 0xb6, 0xaa, 0x5a, 0xbd, 0x6c,
+	0xcb, 0xa2, 0x2a, 0x06, 0x5f, 0x38, 0x8a, 0x2c, 0x63, 0xe7, 0x74, 0xde, 0xff, 0x70, 0x21, 0x78,
+	0x36, 0x6a, 0x84, 0x1d, 0x70, 0x74, 0xc2, 0x99, 0x60, 0xd2, 0x27, 0x47, 0x27, 0x88, 0xe0, 0xe5,
+	0x6a, 0x93, 0x72, 0x47, 0x30, 0xd9, 0x24, 0xab, 0xcd, 0xb7, 0x44, 0x55, 0x8a, 0xbb, 0x82, 0xc9,
+	0x36, 0x59, 0x8d, 0x67, 0xe0, 0x97, 0xaa, 0xd2, 0x05, 0xf7, 0x04, 0x93, 0x8c, 0x6a, 0x30, 0xce,
+	0x65, 0xa6, 0x5e, 0xb9, 0x2f, 0x98, 0x0c, 0xc9, 0x6a, 0x1c, 0x80, 0xb7, 0xd6, 0x79, 0xc2, 0x03,
+	0xc1, 0x64, 0x67, 0x78, 0x11, 0x9d, 0x66, 0x89, 0xea, 0x1c, 0xd1, 0xa3, 0xce, 0x13, 0xb2, 0x46,
+	0xbc, 0x06, 0x7f, 0xb1, 0xd2, 0x59, 0xc2, 0xff, 0x09, 0x26, 0x5b, 0xc3, 0xf3, 0x9f, 0x37, 0xa8,
+	0x36, 0x99, 0x20, 0x71, 0x56, 0xc4, 0x3b, 0x1e, 0x0a, 0x57, 0xb6, 0xa9, 0x06, 0xbc, 0x83, 0xd0,
+	0x8e, 0xcb, 0x34, 0xe7, 0x4d, 0xe1, 0xca, 0xd6, 0xf0, 0xea, 0x97, 0x87, 0x1f, 0x8e, 0xb6, 0x71,
+	0x5e, 0x95, 0x07, 0xfa, 0xde, 0x42, 0x0e, 0x41, 0xbe, 0xdf, 0xc4, 0x69, 0xc9, 0xc1, 0x94, 0x33,
+	0x69, 0xd0, 0x91, 0x11, 0xc1, 0x2d, 0xd5, 0x3b, 0x6f, 0x99, 0x36, 0x26, 0x0d, 0x32, 0xd0, 0x9b,
+	0xc3, 0xff, 0x93, 0x1f, 0x61, 0x17, 0xdc, 0x75, 0x7a, 0xb0, 0xc5, 0x36, 0xc9, 0x48, 0x73, 0xd6,
+	0x9b, 0xca, 0xf6, 0x75, 0xb5, 0x7f, 0x9c, 0x65, 0x4d, 0xb7, 0xce, 0x0d, 0xeb, 0xf7, 0xc0, 0x33,
+	0xb5, 0x60, 0x08, 0xde, 0x74, 0x36, 0x1d, 0x77, 0x1b, 0x46, 0xcd, 0x67, 0x4f, 0xe3, 0x2e, 0xbb,
+	0x0f, 0x21, 0x58, 0xac, 0x0a, 0xbd, 0x48, 0xe3, 0xc0, 0xae, 0x8f, 0x3e, 0x07, 0x00, 0xe4, 0x7b,
+	0x2f, 0x6d, 0x05, 0x02, 0x00, 0x00,
+}
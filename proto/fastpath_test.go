@@ -0,0 +1,297 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	fpb "github.com/golang/protobuf/proto/fastpath_proto"
+	anypb "github.com/golang/protobuf/ptypes/any"
+)
+
+var (
+	_ proto.Merger  = (*fpb.Outer)(nil)
+	_ proto.Equaler = (*fpb.Outer)(nil)
+	_ proto.Merger  = (*fpb.Proto3)(nil)
+	_ proto.Equaler = (*fpb.Proto3)(nil)
+)
+
+func fastPathOuter() *fpb.Outer {
+	m := &fpb.Outer{
+		Id:      proto.Int32(1),
+		Name:    proto.String("name"),
+		Data:    []byte("data"),
+		Ratio:   proto.Float64(0.5),
+		Flag:    proto.Bool(true),
+		Color:   fpb.Color_BLUE.Enum(),
+		Inner:   &fpb.Inner{Id: proto.Int32(2), Values: []int64{3, 4}},
+		Inners:  []*fpb.Inner{{Name: proto.String("a")}, {Name: proto.String("b")}},
+		Numbers: []int32{5, 6},
+		Tags:    []string{"x", "y"},
+		Blobs:   [][]byte{[]byte("b0"), nil, {}},
+		Counts:  map[string]int32{"one": 1, "two": 2},
+		InnerMap: map[int32]*fpb.Inner{
+			7: {Id: proto.Int32(7)},
+		},
+		BlobMap:   map[string][]byte{"k": []byte("v")},
+		Choice:    &fpb.Outer_Text{Text: "text"},
+		Any:       &anypb.Any{TypeUrl: "type.googleapis.com/fastpath_proto.Inner", Value: []byte{8, 9}},
+		Group:     &fpb.Outer_Group{Value: proto.Int32(10)},
+		Child:     &fpb.Outer{Id: proto.Int32(11), Choice: &fpb.Outer_Nested{Nested: &fpb.Inner{Id: proto.Int32(12)}}},
+		LazyInner: &fpb.Inner{Name: proto.String("lazy")},
+	}
+	if err := proto.SetExtension(m, fpb.E_ExtInner, &fpb.Inner{Id: proto.Int32(13)}); err != nil {
+		panic(err)
+	}
+	if err := proto.SetExtension(m, fpb.E_ExtNumbers, []int32{14, 15}); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestFastPathClone(t *testing.T) {
+	m := fastPathOuter()
+	c := proto.Clone(m).(*fpb.Outer)
+	if got, want := proto.MarshalTextString(c), proto.MarshalTextString(m); got != want {
+		t.Fatalf("Clone:\n got %s\nwant %s", got, want)
+	}
+	if !reflect.DeepEqual(c.Blobs, m.Blobs) || !reflect.DeepEqual(c.InnerMap, m.InnerMap) {
+		t.Errorf("Clone did not preserve nil/empty elements: got %q, want %q", c.Blobs, m.Blobs)
+	}
+
+	// The copy must not share memory with the original.
+	c.Data[0] = 'D'
+	c.Inner.Values[0] = 0
+	c.Inners[0].Name = proto.String("changed")
+	c.Counts["one"] = 0
+	c.InnerMap[7].Id = proto.Int32(0)
+	c.Child.GetNested().Id = proto.Int32(0)
+	ext, err := proto.GetExtension(c, fpb.E_ExtInner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ext.(*fpb.Inner).Id = proto.Int32(0)
+	if got, want := proto.MarshalTextString(m), proto.MarshalTextString(fastPathOuter()); got != want {
+		t.Errorf("modifying the clone changed the original:\n got %s\nwant %s", got, want)
+	}
+
+	if got := m.Clone(); !proto.Equal(got, m) {
+		t.Errorf("typed Clone = %v, want %v", got, m)
+	}
+	if got := (*fpb.Outer)(nil).Clone(); got != nil {
+		t.Errorf("Clone of nil = %v, want nil", got)
+	}
+}
+
+func TestFastPathCloneLazy(t *testing.T) {
+	b, err := proto.Marshal(fastPathOuter())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(fpb.Outer)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	c := proto.Clone(m).(*fpb.Outer)
+	if got := c.LazyInner.GetName(); got != "lazy" {
+		t.Errorf("Clone of undecoded lazy field: name = %q, want %q", got, "lazy")
+	}
+}
+
+// withoutExtensions clears the extensions of m. Merge replaces an extension
+// set in both messages, where decoding their concatenation would append.
+func withoutExtensions(m *fpb.Outer) *fpb.Outer {
+	proto.ClearAllExtensions(m)
+	return m
+}
+
+// Merging src into dst must give the same result as decoding their
+// concatenated encodings.
+func TestFastPathMerge(t *testing.T) {
+	tests := []struct {
+		dst, src proto.Message
+	}{
+		{&fpb.Outer{Id: proto.Int32(1)}, fastPathOuter()},
+		{fastPathOuter(), withoutExtensions(fastPathOuter())},
+		{fastPathOuter(), &fpb.Outer{
+			Id:       proto.Int32(2),
+			Inner:    &fpb.Inner{Name: proto.String("merged")},
+			Counts:   map[string]int32{"two": 22, "three": 3},
+			InnerMap: map[int32]*fpb.Inner{7: {Name: proto.String("replaced")}},
+			Choice:   &fpb.Outer_Raw{Raw: []byte("raw")},
+			Child:    &fpb.Outer{Id: proto.Int32(3), Tags: []string{"z"}},
+		}},
+		{&fpb.Proto3{Id: 1, Name: "a", Data: []byte("d"), Kind: fpb.Proto3_SOME}, &fpb.Proto3{Ratio: 2}},
+		{&fpb.Proto3{Id: 1, Flag: true}, &fpb.Proto3{
+			Name:     "b",
+			Child:    &fpb.Proto3{Id: 2},
+			Blobs:    [][]byte{[]byte("x")},
+			Children: map[string]*fpb.Proto3{"c": {Id: 3}},
+			Choice:   &fpb.Proto3_Number{Number: 4},
+		}},
+	}
+	for _, tt := range tests {
+		want := reflect.New(reflect.TypeOf(tt.dst).Elem()).Interface().(proto.Message)
+		b1, err := proto.Marshal(tt.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b2, err := proto.Marshal(tt.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := proto.Unmarshal(append(b1, b2...), want); err != nil {
+			t.Fatal(err)
+		}
+
+		got := proto.Clone(tt.dst)
+		proto.Merge(got, tt.src)
+		if g, w := proto.MarshalTextString(got), proto.MarshalTextString(want); g != w {
+			t.Errorf("Merge(%v, %v):\n got %s\nwant %s", tt.dst, tt.src, g, w)
+		}
+	}
+}
+
+func TestFastPathMergeUnknown(t *testing.T) {
+	dst := &fpb.Proto3{XXX_unrecognized: []byte{0x60, 1}}
+	src := &fpb.Proto3{XXX_unrecognized: []byte{0x68, 2}}
+	proto.Merge(dst, src)
+	if got, want := dst.XXX_unrecognized, src.XXX_unrecognized; !reflect.DeepEqual(got, want) {
+		t.Errorf("unknown fields after Merge = %v, want %v", got, want)
+	}
+	src.XXX_unrecognized[0] = 0
+	if dst.XXX_unrecognized[0] == 0 {
+		t.Error("Merge shares the unknown fields of src")
+	}
+}
+
+// Equal must agree with the reflection-based comparison, which EqualWith
+// uses for generated messages when it is given an option.
+func TestFastPathEqual(t *testing.T) {
+	nan := math.NaN()
+	withExt := func(n int32) *fpb.Outer {
+		m := &fpb.Outer{Id: proto.Int32(1)}
+		if err := proto.SetExtension(m, fpb.E_ExtInner, &fpb.Inner{Id: proto.Int32(n)}); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	tests := []struct {
+		a, b proto.Message
+		want bool
+	}{
+		{fastPathOuter(), fastPathOuter(), true},
+		{fastPathOuter(), withExt(13), false},
+		{withExt(1), withExt(1), true},
+		{withExt(1), withExt(2), false},
+		{&fpb.Outer{}, &fpb.Outer{}, true},
+		{&fpb.Outer{Id: proto.Int32(0)}, &fpb.Outer{}, false},
+		{&fpb.Outer{Ratio: proto.Float64(nan)}, &fpb.Outer{Ratio: proto.Float64(nan)}, false},
+		{&fpb.Outer{Data: []byte{}}, &fpb.Outer{}, false},
+		{&fpb.Outer{Numbers: []int32{}}, &fpb.Outer{}, true},
+		{&fpb.Outer{Blobs: [][]byte{{}}}, &fpb.Outer{Blobs: [][]byte{nil}}, false},
+		{&fpb.Outer{Inners: []*fpb.Inner{{}}}, &fpb.Outer{Inners: []*fpb.Inner{nil}}, false},
+		{&fpb.Outer{Counts: map[string]int32{"a": 1}}, &fpb.Outer{Counts: map[string]int32{"b": 1}}, false},
+		{&fpb.Outer{InnerMap: map[int32]*fpb.Inner{1: nil}}, &fpb.Outer{InnerMap: map[int32]*fpb.Inner{1: {}}}, false},
+		{&fpb.Outer{BlobMap: map[string][]byte{"a": nil}}, &fpb.Outer{BlobMap: map[string][]byte{"a": {}}}, false},
+		{&fpb.Outer{Choice: &fpb.Outer_Number{}}, &fpb.Outer{}, false},
+		{&fpb.Outer{Choice: &fpb.Outer_Number{}}, &fpb.Outer{Choice: &fpb.Outer_Text{}}, false},
+		{&fpb.Outer{Choice: &fpb.Outer_Raw{Raw: []byte{}}}, &fpb.Outer{Choice: &fpb.Outer_Raw{}}, false},
+		{&fpb.Outer{Choice: &fpb.Outer_Nested{Nested: &fpb.Inner{}}}, &fpb.Outer{Choice: &fpb.Outer_Nested{Nested: &fpb.Inner{}}}, true},
+		{&fpb.Outer{Any: &anypb.Any{TypeUrl: "a"}}, &fpb.Outer{Any: &anypb.Any{TypeUrl: "b"}}, false},
+		{&fpb.Outer{XXX_unrecognized: []byte{1}}, &fpb.Outer{}, false},
+		{&fpb.Proto3{Data: []byte{}}, &fpb.Proto3{}, true},
+		{&fpb.Proto3{Blobs: [][]byte{{}}}, &fpb.Proto3{Blobs: [][]byte{nil}}, true},
+		{&fpb.Proto3{Kind: fpb.Proto3_SOME}, &fpb.Proto3{}, false},
+		{&fpb.Proto3{Children: map[string]*fpb.Proto3{"a": {Id: 1}}}, &fpb.Proto3{Children: map[string]*fpb.Proto3{"a": {Id: 1}}}, true},
+		{&fpb.Proto3{Children: map[string]*fpb.Proto3{"a": {Id: 1}}}, &fpb.Proto3{Children: map[string]*fpb.Proto3{"a": {Id: 2}}}, false},
+		{&fpb.Outer{}, &fpb.Proto3{}, false},
+		{(*fpb.Outer)(nil), (*fpb.Outer)(nil), true},
+		{&fpb.Outer{}, (*fpb.Outer)(nil), false},
+	}
+	for _, tt := range tests {
+		if got := proto.Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := proto.Equal(tt.b, tt.a); got != tt.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+		if got := proto.EqualWith(tt.a, tt.b, proto.IgnoreFields()); got != tt.want {
+			t.Errorf("EqualWith(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFastPathEqualLazy(t *testing.T) {
+	m := fastPathOuter()
+	m.Blobs = nil // a nil element would decode as an empty one
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(fpb.Outer)
+	if err := proto.Unmarshal(b, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, decoded) || !proto.Equal(decoded, m) {
+		t.Errorf("message with an undecoded lazy field is not equal to the original")
+	}
+}
+
+func TestFastPathEqualWithOptions(t *testing.T) {
+	a := &fpb.Outer{Id: proto.Int32(1), Name: proto.String("a")}
+	b := &fpb.Outer{Id: proto.Int32(1), Name: proto.String("b")}
+	if !proto.EqualWith(a, b, proto.IgnoreFields("name")) {
+		t.Error("EqualWith ignoring name: got false, want true")
+	}
+	if proto.Equal(a, b) {
+		t.Error("Equal: got true, want false")
+	}
+}
+
+func BenchmarkFastPathClone(b *testing.B) {
+	m := fastPathOuter()
+	for i := 0; i < b.N; i++ {
+		proto.Clone(m)
+	}
+}
+
+func BenchmarkFastPathEqual(b *testing.B) {
+	m1, m2 := fastPathOuter(), fastPathOuter()
+	for i := 0; i < b.N; i++ {
+		proto.Equal(m1, m2)
+	}
+}
@@ -0,0 +1,241 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Lazily decoded sub-message fields.
+ */
+
+import (
+	"reflect"
+	"sync"
+)
+
+// XXX_LazyFields is an internal type used by generated code for messages
+// that have fields marked [lazy=true]. Unmarshal sets such a field to an
+// empty message and keeps the field's encoding here; the message is filled
+// in from the encoding when the field is first read through its getter.
+// Until then, Marshal writes the kept encoding back out unchanged, and code
+// that reads the field directly sees the empty message, so it should call
+// ResolveLazy first.
+//
+// Filling in a lazy field changes only the message the field points to,
+// under a lock, so getters are as safe to call alongside Marshal and other
+// readers as for any other field. The encoding is dropped once it has been
+// decoded. A field that is set directly, including to nil, takes the place
+// of its kept encoding.
+type XXX_LazyFields struct {
+	p *lazyFields
+}
+
+// lazyFields holds the lazy fields of a message that Unmarshal left undecoded.
+type lazyFields struct {
+	mu sync.Mutex
+	m  map[int32]*lazyField // by field number
+}
+
+// A lazyField is the state of a single lazy field.
+type lazyField struct {
+	prop *Properties
+	opts decodeOptions // settings of the Buffer the field was read from
+	msg  structPointer // the message Unmarshal set the field to
+
+	raw     []byte // encoding, until decoded
+	decoded bool   // whether msg has been filled in from raw
+	err     error  // error from decoding msg, if any
+}
+
+// decodeOptions are the settings of a Buffer that apply to the sub-messages
+// it decodes, along with the nesting depth reached.
+type decodeOptions struct {
+	aliasBuffer, discardUnknown    bool
+	maxDepth, maxSize, maxRepeated int
+	depth                          int
+}
+
+func (o *Buffer) decodeOptions() decodeOptions {
+	return decodeOptions{
+		aliasBuffer:    o.aliasBuffer,
+		discardUnknown: o.discardUnknown,
+		maxDepth:       o.maxDepth,
+		maxSize:        o.maxSize,
+		maxRepeated:    o.maxRepeated,
+		depth:          o.depth,
+	}
+}
+
+// unmarshal decodes raw into the message bas, the value of the field p,
+// as the Buffer described by d would have.
+func (d decodeOptions) unmarshal(p *Properties, bas structPointer, raw []byte) error {
+	if p.isUnmarshaler {
+		return structPointer_Interface(bas, p.stype).(Unmarshaler).Unmarshal(raw)
+	}
+	o := NewBuffer(raw)
+	o.aliasBuffer, o.discardUnknown = d.aliasBuffer, d.discardUnknown
+	o.maxDepth, o.maxSize, o.maxRepeated, o.depth = d.maxDepth, d.maxSize, d.maxRepeated, d.depth
+	if err := o.enter(); err != nil {
+		return addFieldContext(err, p.OrigName)
+	}
+	return addFieldContext(o.unmarshalType(p.stype, p.sprop, false, bas), p.OrigName)
+}
+
+// add records raw, read by o, as the encoding of the lazy field p of the
+// message at base, and reports whether it did. A nil field is set to an
+// empty message to be filled in later. A field that still holds such a
+// message keeps both encodings, concatenated, as merging on the wire
+// requires. Any other field is left for the caller to decode raw into.
+func (l *XXX_LazyFields) add(p *Properties, base structPointer, raw []byte, o *Buffer) bool {
+	if l.p == nil {
+		l.p = &lazyFields{m: make(map[int32]*lazyField)}
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	bas := structPointer_GetStructPointer(base, p.field)
+	f := l.p.m[int32(p.Tag)]
+	if f != nil && structPointer_Equal(f.msg, bas) {
+		if f.decoded {
+			return false
+		}
+		b := make([]byte, len(f.raw)+len(raw))
+		copy(b, f.raw)
+		copy(b[len(f.raw):], raw)
+		f.raw, f.opts = b, o.decodeOptions()
+		return true
+	}
+	delete(l.p.m, int32(p.Tag)) // the field was set directly since
+	if !structPointer_IsNil(bas) {
+		return false
+	}
+	if !o.aliasBuffer {
+		raw = append([]byte(nil), raw...)
+	}
+	bas = toStructPointer(reflect.New(p.stype))
+	structPointer_SetStructPointer(base, p.field, bas)
+	if collectStats() {
+		p.sprop.stats.addAlloc()
+	}
+	l.p.m[int32(p.Tag)] = &lazyField{prop: p, opts: o.decodeOptions(), msg: bas, raw: raw}
+	return true
+}
+
+// decode fills in f.msg, if that has not been done yet. l.p.mu must be held.
+func (f *lazyField) decode() {
+	if f.decoded {
+		return
+	}
+	f.err = f.opts.unmarshal(f.prop, f.msg, f.raw)
+	f.decoded, f.raw = true, nil
+}
+
+// Resolve fills in the message of the lazy field with the given number from
+// its kept encoding, if that has not been done yet. Generated getters call
+// it before returning the field. Decoding errors are reported by
+// ResolveLazy; the message holds whatever could be decoded.
+func (l *XXX_LazyFields) Resolve(field int32) {
+	if l.p == nil {
+		return
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	if f := l.p.m[field]; f != nil {
+		f.decode()
+	}
+}
+
+// ResolveLazy fills in every lazy field of pb that still holds the empty
+// message set by Unmarshal from its kept encoding, and returns the first
+// decoding error, including for fields filled in earlier by their getters.
+// Sub-messages of pb are not resolved. Like a getter, ResolveLazy may run
+// alongside other code that reads pb.
+//
+// Errors in lazy fields, including missing required fields, are not detected
+// by Unmarshal. Getters, Equal, Clone, Merge and the text and JSON
+// marshalers decode lazy fields as needed but ignore such errors, using
+// whatever could be decoded.
+func ResolveLazy(pb Message) error {
+	v := reflect.ValueOf(pb)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	return resolveLazy(v.Elem())
+}
+
+// resolveLazy does the work of ResolveLazy for the addressable struct v.
+func resolveLazy(v reflect.Value) error {
+	if v.Kind() != reflect.Struct || !v.CanAddr() {
+		return nil
+	}
+	prop := GetProperties(v.Type())
+	if !prop.lazyField.IsValid() {
+		return nil
+	}
+	base := toStructPointer(v.Addr())
+	l := structPointer_Lazy(base, prop.lazyField)
+	if l.p == nil {
+		return nil
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	var state errorState
+	for _, i := range prop.order {
+		p := prop.Prop[i]
+		f := l.p.m[int32(p.Tag)]
+		if !p.Lazy || f == nil || !structPointer_Equal(f.msg, structPointer_GetStructPointer(base, p.field)) {
+			continue // set directly, which overrides the encoding
+		}
+		f.decode()
+		if f.err != nil && !state.shouldContinue(f.err, p) {
+			return f.err
+		}
+	}
+	return state.err
+}
+
+// lazyEncoding returns the kept encoding of the lazy field p of the message
+// at base, if the field still holds structp, the empty message Unmarshal
+// set it to.
+func lazyEncoding(p *Properties, base, structp structPointer) ([]byte, bool) {
+	if !p.Lazy {
+		return nil, false
+	}
+	l := structPointer_Lazy(base, p.lazyField)
+	if l.p == nil {
+		return nil, false
+	}
+	l.p.mu.Lock()
+	defer l.p.mu.Unlock()
+	f := l.p.m[int32(p.Tag)]
+	if f == nil || f.decoded || !structPointer_Equal(f.msg, structp) {
+		return nil, false
+	}
+	return f.raw, true
+}
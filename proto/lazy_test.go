@@ -0,0 +1,313 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func newLazyMessage() *pb.LazyMessage {
+	return &pb.LazyMessage{
+		Id:      proto.Int32(1),
+		Payload: &pb.GoTestField{Label: proto.String("payload"), Type: proto.String("t")},
+		Child: &pb.LazyMessage{
+			Id:      proto.Int32(2),
+			Payload: &pb.GoTestField{Label: proto.String("nested"), Type: proto.String("t")},
+		},
+		Eager: &pb.GoTestField{Label: proto.String("eager"), Type: proto.String("t")},
+	}
+}
+
+func TestLazyDecoding(t *testing.T) {
+	want := newLazyMessage()
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Payload == nil || m.Payload.Label != nil || m.Child == nil || m.Child.Id != nil {
+		t.Errorf("lazy fields not left empty by Unmarshal: Payload %v, Child %v", m.Payload, m.Child)
+	}
+	if m.Eager == nil {
+		t.Errorf("eager field not decoded by Unmarshal")
+	}
+
+	// Untouched lazy fields are written back out unchanged.
+	if n := proto.Size(m); n != len(b) {
+		t.Errorf("Size = %d, want %d", n, len(b))
+	}
+	out, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, b) {
+		t.Errorf("Marshal of undecoded message:\n got %q\nwant %q", out, b)
+	}
+
+	// Getters fill in the field on first access.
+	payload := m.Payload
+	if got := m.GetPayload().GetLabel(); got != "payload" {
+		t.Errorf("GetPayload().GetLabel() = %q, want %q", got, "payload")
+	}
+	if m.GetPayload() != payload || payload.GetLabel() != "payload" {
+		t.Errorf("GetPayload did not fill in the field: Payload %v", m.Payload)
+	}
+	if got := m.GetChild().GetPayload().GetLabel(); got != "nested" {
+		t.Errorf("GetChild().GetPayload().GetLabel() = %q, want %q", got, "nested")
+	}
+
+	// A modified lazy field is re-encoded.
+	m.GetPayload().Label = proto.String("changed")
+	out, err = proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := new(pb.LazyMessage)
+	if err := proto.Unmarshal(out, m2); err != nil {
+		t.Fatal(err)
+	}
+	if got := m2.GetPayload().GetLabel(); got != "changed" {
+		t.Errorf("after modification and round trip, label = %q, want %q", got, "changed")
+	}
+}
+
+func TestLazyEqualClone(t *testing.T) {
+	b, err := proto.Marshal(newLazyMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, newLazyMessage()) {
+		t.Errorf("Equal(lazily decoded, original) = false")
+	}
+
+	m = new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if c := proto.Clone(m).(*pb.LazyMessage); !proto.Equal(c, newLazyMessage()) {
+		t.Errorf("Clone of lazily decoded message = %v, want %v", c, newLazyMessage())
+	}
+
+	m = new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := proto.CompactTextString(m), proto.CompactTextString(newLazyMessage()); got != want {
+		t.Errorf("text of lazily decoded message = %q, want %q", got, want)
+	}
+}
+
+func TestLazyFieldSet(t *testing.T) {
+	b, err := proto.Marshal(newLazyMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := &pb.GoTestField{Label: proto.String("other"), Type: proto.String("t")}
+	for _, tc := range []struct {
+		name    string
+		payload *pb.GoTestField
+		read    bool // whether to read the field through its getter first
+	}{
+		{"nil", nil, false},
+		{"nil after read", nil, true},
+		{"other", other, false},
+		{"other after read", other, true},
+	} {
+		m := new(pb.LazyMessage)
+		if err := proto.Unmarshal(b, m); err != nil {
+			t.Fatal(err)
+		}
+		if tc.read {
+			m.GetPayload()
+		}
+		m.Payload = tc.payload
+
+		want := newLazyMessage()
+		want.Payload = tc.payload
+		wantb, err := proto.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := proto.Size(m); n != len(wantb) {
+			t.Errorf("%s: Size = %d, want %d", tc.name, n, len(wantb))
+		}
+		out, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, wantb) {
+			t.Errorf("%s: Marshal:\n got %q\nwant %q", tc.name, out, wantb)
+		}
+		if got := m.GetPayload(); got != tc.payload {
+			t.Errorf("%s: GetPayload() = %v, want %v", tc.name, got, tc.payload)
+		}
+		if err := proto.ResolveLazy(m); err != nil {
+			t.Errorf("%s: ResolveLazy: %v", tc.name, err)
+		}
+	}
+}
+
+func TestLazyMerge(t *testing.T) {
+	// Two occurrences of a lazy field on the wire are merged, as for eager fields.
+	b1, _ := proto.Marshal(&pb.LazyMessage{Payload: &pb.GoTestField{Label: proto.String("a"), Type: proto.String("t")}})
+	b2, _ := proto.Marshal(&pb.LazyMessage{Payload: &pb.GoTestField{Label: proto.String("b"), Type: proto.String("t")}})
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(append(b1, b2...), m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetPayload().GetLabel(); got != "b" {
+		t.Errorf("merged label = %q, want %q", got, "b")
+	}
+}
+
+func TestResolveLazyError(t *testing.T) {
+	// Payload is missing its required Type field.
+	b, err := proto.Marshal(&pb.LazyMessage{Payload: &pb.GoTestField{Label: proto.String("a")}})
+	if _, ok := err.(*proto.RequiredNotSetError); !ok {
+		t.Fatalf("Marshal: %v", err)
+	}
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatalf("Unmarshal reported error in lazy field: %v", err)
+	}
+	// The getter ignores the error, which is kept for ResolveLazy.
+	if got := m.GetPayload().GetLabel(); got != "a" {
+		t.Errorf("GetPayload().GetLabel() = %q, want %q", got, "a")
+	}
+	if err := proto.ResolveLazy(m); err == nil {
+		t.Errorf("ResolveLazy = nil, want *RequiredNotSetError")
+	} else if _, ok := err.(*proto.RequiredNotSetError); !ok {
+		t.Errorf("ResolveLazy = %v, want *RequiredNotSetError", err)
+	}
+	if got := m.Payload.GetLabel(); got != "a" {
+		t.Errorf("Payload.Label after ResolveLazy = %q, want %q", got, "a")
+	}
+}
+
+func TestLazyDecodeLimits(t *testing.T) {
+	b, err := proto.Marshal(&pb.LazyMessage{
+		Child: &pb.LazyMessage{Child: &pb.LazyMessage{Id: proto.Int32(3)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(pb.LazyMessage)
+	buf := proto.NewBuffer(b)
+	buf.SetMaxDepth(1)
+	if err := buf.Unmarshal(m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	// The grandchild is too deep for the Buffer that read m.
+	child := m.GetChild()
+	if child == nil {
+		t.Fatal("GetChild() = nil")
+	}
+	if child.GetChild() == nil {
+		t.Error("GetChild().GetChild() = nil, want the partially decoded value")
+	}
+	err = proto.ResolveLazy(child)
+	if le, ok := err.(*proto.DecodeLimitError); !ok || le.Limit != "depth" {
+		t.Errorf("ResolveLazy = %v, want depth *DecodeLimitError", err)
+	}
+}
+
+func TestLazyConcurrentRead(t *testing.T) {
+	b, err := proto.Marshal(newLazyMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	// Readers may decode lazy fields while Marshal runs; run with -race.
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			m.GetChild().GetPayload()
+			proto.Equal(m, m)
+			proto.CompactTextString(m)
+		}
+		done <- true
+	}()
+	for i := 0; i < 100; i++ {
+		out, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, b) {
+			t.Fatalf("Marshal #%d:\n got %q\nwant %q", i, out, b)
+		}
+	}
+	<-done
+	if got := m.Child.Payload.GetLabel(); got != "nested" {
+		t.Errorf("Child.Payload.Label after readers = %q, want %q", got, "nested")
+	}
+}
+
+func TestLazyReflect(t *testing.T) {
+	b, err := proto.Marshal(newLazyMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	r := proto.Reflect(m)
+	fd := r.FieldByName("payload")
+	if !r.Has(fd) {
+		t.Errorf("Has(payload) = false")
+	}
+	if got := r.Get(fd).(*pb.GoTestField).GetLabel(); got != "payload" {
+		t.Errorf("Get(payload).GetLabel() = %q, want %q", got, "payload")
+	}
+	if m.Payload.GetLabel() != "payload" {
+		t.Errorf("Payload not filled in by Get: %v", m.Payload)
+	}
+	r.Clear(fd)
+	if r.Has(fd) || m.GetPayload() != nil {
+		t.Errorf("payload still set after Clear: %v", m.GetPayload())
+	}
+}
@@ -75,6 +75,9 @@ When the .proto file specifies `syntax="proto3"`, there are some differences:
   - Non-repeated fields of non-message type are values instead of pointers.
   - Getters are only generated for message and oneof fields.
   - Enum types do not get an Enum method.
+  - Fields declared optional are pointers (or []byte for bytes fields),
+	as in proto2, so a field set to its zero value is distinguished from an
+	unset one; it is encoded and printed whenever it is non-nil.
 
 The simplest way to describe this is to see an example.
 Given file test.proto, containing
@@ -272,6 +275,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 )
 
 // Message is implemented by generated protocol buffer messages.
@@ -283,6 +287,7 @@ type Message interface {
 
 // Stats records allocation details about the protocol buffer encoders
 // and decoders.  Useful for tuning the library itself.
+// Collection is off by default; see SetStatsEnabled.
 type Stats struct {
 	Emalloc uint64 // mallocs in encode
 	Dmalloc uint64 // mallocs in decode
@@ -291,15 +296,43 @@ type Stats struct {
 	Chit    uint64 // number of cache hits
 	Cmiss   uint64 // number of cache misses
 	Size    uint64 // number of sizes
+	PoolGet uint64 // number of Buffers taken from the internal pool
+	PoolNew uint64 // number of pooled Buffers that had to be allocated
 }
 
-// Set to true to enable stats collection.
-const collectStats = false
+// statsOn is non-zero while stats collection is enabled.
+var statsOn int32
+
+// collectStats reports whether stats collection is enabled.
+func collectStats() bool { return atomic.LoadInt32(&statsOn) != 0 }
+
+// SetStatsEnabled turns collection of the counters reported by GetStats
+// on or off. It is safe to call concurrently with encoding and decoding.
+// Counters are not reset when collection is turned off.
+func SetStatsEnabled(on bool) {
+	var v int32
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&statsOn, v)
+}
 
 var stats Stats
 
 // GetStats returns a copy of the global Stats structure.
-func GetStats() Stats { return stats }
+func GetStats() Stats {
+	return Stats{
+		Emalloc: atomic.LoadUint64(&stats.Emalloc),
+		Dmalloc: atomic.LoadUint64(&stats.Dmalloc),
+		Encode:  atomic.LoadUint64(&stats.Encode),
+		Decode:  atomic.LoadUint64(&stats.Decode),
+		Chit:    atomic.LoadUint64(&stats.Chit),
+		Cmiss:   atomic.LoadUint64(&stats.Cmiss),
+		Size:    atomic.LoadUint64(&stats.Size),
+		PoolGet: atomic.LoadUint64(&stats.PoolGet),
+		PoolNew: atomic.LoadUint64(&stats.PoolNew),
+	}
+}
 
 // A Buffer is a buffer manager for marshaling and unmarshaling
 // protocol buffers.  It may be reused between invocations to
@@ -310,6 +343,20 @@ type Buffer struct {
 	buf   []byte // encode/decode byte stream
 	index int    // read point
 
+	aliasBuffer   bool // decode bytes fields as sub-slices of buf; see SetAliasBuffer
+	deterministic bool // encode map entries and unknown fields in a stable order; see SetDeterministic
+
+	discardUnknown bool // drop unrecognized fields while decoding; see SetDiscardUnknown
+
+	// decoding limits; zero means unlimited. See SetMaxDepth,
+	// SetMaxMessageSize and SetMaxRepeatedCount.
+	maxDepth    int
+	maxSize     int
+	maxRepeated int
+	depth       int // current nesting depth while decoding
+
+	sizes sizeCache // sub-message sizes computed by the marshal in progress
+
 	// pools of basic types to amortize allocation.
 	bools   []bool
 	uint32s []uint32
@@ -328,6 +375,35 @@ func NewBuffer(e []byte) *Buffer {
 	return &Buffer{buf: e}
 }
 
+// bufferPool holds Buffers used by Marshal, MarshalAppend and Unmarshal.
+// Reusing them keeps the decoder's pools of basic types warm across calls.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		if collectStats() {
+			atomic.AddUint64(&stats.PoolNew, 1)
+		}
+		return new(Buffer)
+	},
+}
+
+// getBuffer returns an empty Buffer from bufferPool.
+func getBuffer() *Buffer {
+	if collectStats() {
+		atomic.AddUint64(&stats.PoolGet, 1)
+	}
+	return bufferPool.Get().(*Buffer)
+}
+
+// putBuffer returns p to bufferPool. The caller must not retain p.buf
+// through p after this call; the slice itself is dropped so results
+// handed to callers never share memory with a pooled Buffer.
+func putBuffer(p *Buffer) {
+	p.buf = nil
+	p.index = 0
+	p.depth = 0
+	bufferPool.Put(p)
+}
+
 // Reset resets the Buffer, ready for marshaling a new protocol buffer.
 func (p *Buffer) Reset() {
 	p.buf = p.buf[0:0] // for reading/writing
@@ -344,6 +420,68 @@ func (p *Buffer) SetBuf(s []byte) {
 // Bytes returns the contents of the Buffer.
 func (p *Buffer) Bytes() []byte { return p.buf }
 
+// SetAliasBuffer controls whether Unmarshal copies the contents of bytes
+// fields out of the buffer. When alias is true, decoded bytes fields,
+// repeated bytes fields and bytes map values are sub-slices of the slice
+// passed to NewBuffer or SetBuf instead of fresh allocations, which avoids
+// copying large payloads.
+//
+// The caller must then treat that slice as owned by the decoded message:
+// it must not be modified or reused for as long as the message is in use,
+// and modifying a bytes field in place modifies the input. The aliased
+// slices are capped at their own length, so appending to a field never
+// overwrites the rest of the input. String fields and bytes fields in a
+// oneof are always copied.
+func (p *Buffer) SetAliasBuffer(alias bool) {
+	p.aliasBuffer = alias
+}
+
+// SetDeterministic controls whether Marshal produces the same bytes every
+// time it is given equal messages. When deterministic is true, map entries
+// are written in order of their keys (numerically for integer keys, false
+// before true for bool keys, and bytewise for string keys), and unrecognized
+// fields are stably sorted by field number. Extensions are always written in
+// field number order.
+//
+// Deterministic output is stable for a given binary, but is not canonical:
+// it may change between versions of this package and may differ from other
+// implementations, so it should not be relied on across programs.
+// Messages that implement Marshaler encode themselves and are not affected.
+func (p *Buffer) SetDeterministic(deterministic bool) {
+	p.deterministic = deterministic
+}
+
+// SetDiscardUnknown controls whether Unmarshal keeps fields it does not
+// recognize. By default they are saved in the message's XXX_unrecognized
+// field, for proto2 and proto3 messages alike, and written back out by
+// Marshal. When discard is true they are skipped instead. Unrecognized
+// fields in extension ranges are kept as extensions in either case.
+func (p *Buffer) SetDiscardUnknown(discard bool) {
+	p.discardUnknown = discard
+}
+
+// SetMaxDepth limits how deeply embedded messages and groups may be nested
+// in the input to Unmarshal. The message being unmarshaled is at depth 0 and
+// its direct sub-messages are at depth 1. Input that nests deeper than n
+// levels is rejected with a *DecodeLimitError. Zero means no limit.
+func (p *Buffer) SetMaxDepth(n int) {
+	p.maxDepth = n
+}
+
+// SetMaxMessageSize limits the total size in bytes of the input to
+// Unmarshal and DecodeMessage. Larger input is rejected with a
+// *DecodeLimitError before any decoding is done. Zero means no limit.
+func (p *Buffer) SetMaxMessageSize(n int) {
+	p.maxSize = n
+}
+
+// SetMaxRepeatedCount limits the number of elements Unmarshal will decode
+// into any one repeated or map field. Input with more elements is rejected
+// with a *DecodeLimitError. Zero means no limit.
+func (p *Buffer) SetMaxRepeatedCount(n int) {
+	p.maxRepeated = n
+}
+
 /*
  * Helper routines for simplifying the creation of optional fields of basic type.
  */
@@ -856,6 +994,10 @@ func mapKeys(vs []reflect.Value) sort.Interface {
 		s.less = func(a, b reflect.Value) bool { return a.Int() < b.Int() }
 	case reflect.Uint32, reflect.Uint64:
 		s.less = func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Bool:
+		s.less = func(a, b reflect.Value) bool { return !a.Bool() && b.Bool() } // false < true
+	case reflect.String:
+		s.less = func(a, b reflect.Value) bool { return a.String() < b.String() }
 	}
 
 	return s
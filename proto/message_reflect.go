@@ -0,0 +1,424 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+// Field access by number or name for arbitrary generated messages.
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A FieldDesc describes one field of a message type for MessageReflection.
+// Values are obtained with MessageReflection.FieldByNumber, FieldByName
+// and Fields, and may be used with any message of the same type.
+type FieldDesc struct {
+	Number    int32          // field number
+	Name      string         // original name; the fully-qualified name for extensions
+	Repeated  bool           // repeated field; maps are not Repeated
+	Map       bool           // map field
+	Oneof     string         // name of the containing oneof, or ""
+	Extension *ExtensionDesc // descriptor of an extension field, or nil
+
+	typ       reflect.Type // type of the values returned by Get
+	index     int          // struct field index; unused for extensions
+	oneofType reflect.Type // oneof wrapper type, or nil
+	prop      *Properties
+}
+
+// isMessage reports whether the field holds a single message.
+func (fd *FieldDesc) isMessage() bool {
+	return fd.typ.Kind() == reflect.Ptr && fd.typ.Elem().Kind() == reflect.Struct
+}
+
+// messageFields holds the FieldDescs of a message type.
+type messageFields struct {
+	list   []*FieldDesc // in field number order
+	byNum  map[int32]*FieldDesc
+	byName map[string]*FieldDesc
+}
+
+var (
+	messageFieldsMu  sync.RWMutex
+	messageFieldsMap = make(map[reflect.Type]*messageFields)
+)
+
+// getMessageFields returns the fields of the struct type t.
+func getMessageFields(t reflect.Type) *messageFields {
+	messageFieldsMu.RLock()
+	mf, ok := messageFieldsMap[t]
+	messageFieldsMu.RUnlock()
+	if ok {
+		return mf
+	}
+
+	mf = &messageFields{byNum: make(map[int32]*FieldDesc), byName: make(map[string]*FieldDesc)}
+	sprop := GetProperties(t)
+	add := func(fd *FieldDesc) {
+		mf.list = append(mf.list, fd)
+		mf.byNum[fd.Number] = fd
+		mf.byName[fd.Name] = fd
+	}
+	for i, prop := range sprop.Prop {
+		f := t.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") || f.Tag.Get("protobuf_oneof") != "" {
+			continue
+		}
+		typ := f.Type
+		if typ.Kind() == reflect.Ptr && typ.Elem().Kind() != reflect.Struct {
+			typ = typ.Elem() // proto2 scalar
+		}
+		add(&FieldDesc{
+			Number:   int32(prop.Tag),
+			Name:     prop.OrigName,
+			Repeated: prop.Repeated && f.Type.Kind() != reflect.Map,
+			Map:      f.Type.Kind() == reflect.Map,
+			typ:      typ,
+			index:    i,
+			prop:     prop,
+		})
+	}
+	for name, op := range sprop.OneofTypes {
+		add(&FieldDesc{
+			Number:    int32(op.Prop.Tag),
+			Name:      name,
+			Oneof:     t.Field(op.Field).Tag.Get("protobuf_oneof"),
+			typ:       op.Type.Elem().Field(0).Type,
+			index:     op.Field,
+			oneofType: op.Type,
+			prop:      op.Prop,
+		})
+	}
+	sort.Sort(fieldDescsByNumber(mf.list))
+
+	messageFieldsMu.Lock()
+	messageFieldsMap[t] = mf
+	messageFieldsMu.Unlock()
+	return mf
+}
+
+type fieldDescsByNumber []*FieldDesc
+
+func (s fieldDescsByNumber) Len() int           { return len(s) }
+func (s fieldDescsByNumber) Less(i, j int) bool { return s[i].Number < s[j].Number }
+func (s fieldDescsByNumber) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// extensionFieldDesc returns a FieldDesc for the extension ed.
+func extensionFieldDesc(ed *ExtensionDesc) *FieldDesc {
+	typ := reflect.TypeOf(ed.ExtensionType)
+	if typ.Kind() == reflect.Ptr && typ.Elem().Kind() != reflect.Struct {
+		typ = typ.Elem()
+	}
+	return &FieldDesc{
+		Number:    ed.Field,
+		Name:      ed.Name,
+		Repeated:  ed.repeated(),
+		Extension: ed,
+		typ:       typ,
+		prop:      extensionProperties(ed),
+	}
+}
+
+// A MessageReflection gives access to the fields of a generated message
+// by field number or name, without regard to how each kind of field is
+// represented in Go. It is created by Reflect.
+//
+// Values passed to Set and returned by Get use these types:
+//   - scalar fields: the Go type of the value, such as int32, string,
+//     []byte or a generated enum type, for proto2 and proto3 alike;
+//   - message fields: a pointer to the generated message struct;
+//   - repeated fields: the slice, such as []int64 or []*pb.Inner;
+//   - map fields: the map, such as map[string]*pb.Inner.
+//
+// Oneof members and registered extensions behave like any other field.
+type MessageReflection struct {
+	m Message
+	v reflect.Value // the message struct; invalid if m is a nil pointer
+	t reflect.Type  // the message struct type
+}
+
+// Reflect returns a MessageReflection for m, which must be a pointer to a
+// generated message struct. Reads of a nil pointer report every field as
+// unset.
+func Reflect(m Message) *MessageReflection {
+	pv := reflect.ValueOf(m)
+	if pv.Kind() != reflect.Ptr || pv.Type().Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("proto: Reflect of non-message %T", m))
+	}
+	r := &MessageReflection{m: m, t: pv.Type().Elem()}
+	if !pv.IsNil() {
+		r.v = pv.Elem()
+	}
+	return r
+}
+
+// Message returns the message r reflects.
+func (r *MessageReflection) Message() Message { return r.m }
+
+// Fields returns the fields of the message type in field number order,
+// followed by its registered extensions, also in number order.
+func (r *MessageReflection) Fields() []*FieldDesc {
+	fds := append([]*FieldDesc(nil), getMessageFields(r.t).list...)
+	var exts []*FieldDesc
+	for _, ed := range GlobalRegistry.extensions(r.t) {
+		exts = append(exts, extensionFieldDesc(ed))
+	}
+	sort.Sort(fieldDescsByNumber(exts))
+	return append(fds, exts...)
+}
+
+// FieldByNumber returns the field or registered extension with number n,
+// or nil if there is none.
+func (r *MessageReflection) FieldByNumber(n int32) *FieldDesc {
+	if fd := getMessageFields(r.t).byNum[n]; fd != nil {
+		return fd
+	}
+	if ed := GlobalRegistry.extensions(r.t)[n]; ed != nil {
+		return extensionFieldDesc(ed)
+	}
+	return nil
+}
+
+// FieldByName returns the field with the given original name, or the
+// registered extension with the given fully-qualified name, or nil if
+// there is none.
+func (r *MessageReflection) FieldByName(name string) *FieldDesc {
+	if fd := getMessageFields(r.t).byName[name]; fd != nil {
+		return fd
+	}
+	for _, ed := range GlobalRegistry.extensions(r.t) {
+		if ed.Name == name {
+			return extensionFieldDesc(ed)
+		}
+	}
+	return nil
+}
+
+// Range calls f for each populated field, including extensions, in field
+// number order, until f returns false.
+func (r *MessageReflection) Range(f func(fd *FieldDesc, v interface{}) bool) {
+	if !r.v.IsValid() {
+		return
+	}
+	var fds []*FieldDesc
+	for _, fd := range getMessageFields(r.t).list {
+		if r.Has(fd) {
+			fds = append(fds, fd)
+		}
+	}
+	if _, ok := extendable(r.m); ok {
+		eds, _ := ExtensionDescs(r.m)
+		for _, ed := range eds {
+			if ed.ExtensionType != nil {
+				fds = append(fds, extensionFieldDesc(ed))
+			}
+		}
+	}
+	sort.Stable(fieldDescsByNumber(fds))
+	for _, fd := range fds {
+		if !f(fd, r.Get(fd)) {
+			return
+		}
+	}
+}
+
+// Has reports whether the field is populated. A proto2 field is populated
+// if it is set; a proto3 scalar if it is not the zero value; a repeated or
+// map field if it is not empty; a oneof member if it is the member set.
+func (r *MessageReflection) Has(fd *FieldDesc) bool {
+	if !r.v.IsValid() {
+		return false
+	}
+	if fd.Extension != nil {
+		return HasExtension(r.m, fd.Extension)
+	}
+	fv := r.v.Field(fd.index)
+	if fd.oneofType != nil {
+		return !fv.IsNil() && fv.Elem().Type() == fd.oneofType
+	}
+	switch fv.Kind() {
+	case reflect.Ptr:
+		return !fv.IsNil()
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() == reflect.Uint8 && !fd.prop.proto3 {
+			return !fv.IsNil() // proto2 bytes
+		}
+		return fv.Len() > 0
+	case reflect.Map:
+		return fv.Len() > 0
+	}
+	return fv.Interface() != reflect.Zero(fv.Type()).Interface()
+}
+
+// Get returns the value of the field. For an unpopulated field it returns
+// the field's default: the declared default or zero value for a scalar, a
+// nil pointer of the field's type for a message, and a nil slice or map
+// for a repeated or map field. An extension that cannot be decoded is
+// returned as its default.
+func (r *MessageReflection) Get(fd *FieldDesc) interface{} {
+	if !r.Has(fd) {
+		return fd.defaultValue()
+	}
+	if fd.Extension != nil {
+		v, err := GetExtension(r.m, fd.Extension)
+		if err != nil {
+			return fd.defaultValue()
+		}
+		if rv := reflect.ValueOf(v); rv.Type() != fd.typ {
+			return rv.Elem().Interface() // proto2 scalar
+		}
+		return v
+	}
+	fv := r.field(fd)
+	if fd.oneofType != nil {
+		return fv.Elem().Elem().Field(0).Interface()
+	}
+	if fv.Type() != fd.typ {
+		return fv.Elem().Interface() // proto2 scalar
+	}
+	return fv.Interface()
+}
+
+// field returns the struct field for fd, first filling in a lazy field from
+// its kept encoding.
+func (r *MessageReflection) field(fd *FieldDesc) reflect.Value {
+	if fd.prop.Lazy {
+		structPointer_Lazy(toStructPointer(r.v.Addr()), fd.prop.lazyField).Resolve(int32(fd.prop.Tag))
+	}
+	return r.v.Field(fd.index)
+}
+
+// defaultValue returns the value Get reports for an unpopulated field.
+func (fd *FieldDesc) defaultValue() interface{} {
+	if fd.oneofType == nil && fd.typ.Kind() != reflect.Ptr {
+		ft := fd.typ
+		if ft.Kind() != reflect.Slice && ft.Kind() != reflect.Map {
+			ft = reflect.PtrTo(ft)
+		}
+		if sf, _, err := fieldDefault(ft, fd.prop); err == nil && sf != nil && sf.value != nil {
+			return reflect.ValueOf(sf.value).Convert(fd.typ).Interface()
+		}
+	}
+	return reflect.Zero(fd.typ).Interface()
+}
+
+// Set sets the field to v, which must have the type described for
+// MessageReflection; scalar values may also be of a type convertible to
+// the field's type, such as an int32 for an enum. Setting a oneof member
+// replaces whichever member was set. Setting a message field to nil
+// clears it.
+func (r *MessageReflection) Set(fd *FieldDesc, v interface{}) error {
+	if !r.v.IsValid() {
+		return errors.New("proto: Set on nil message")
+	}
+	rv := reflect.ValueOf(v)
+	if fd.isMessage() && (v == nil || rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		r.Clear(fd)
+		return nil
+	}
+	val, err := fd.convert(rv)
+	if err != nil {
+		return err
+	}
+
+	if fd.Extension != nil {
+		et := reflect.TypeOf(fd.Extension.ExtensionType)
+		if et != fd.typ {
+			p := reflect.New(fd.typ)
+			p.Elem().Set(val)
+			val = p
+		}
+		return SetExtension(r.m, fd.Extension, val.Interface())
+	}
+	fv := r.v.Field(fd.index)
+	switch {
+	case fd.oneofType != nil:
+		w := reflect.New(fd.oneofType.Elem())
+		w.Elem().Field(0).Set(val)
+		fv.Set(w)
+	case fv.Type() != fd.typ:
+		p := reflect.New(fd.typ)
+		p.Elem().Set(val)
+		fv.Set(p)
+	default:
+		fv.Set(val)
+	}
+	return nil
+}
+
+// convert returns rv as a value of the field's type.
+func (fd *FieldDesc) convert(rv reflect.Value) (reflect.Value, error) {
+	switch {
+	case !rv.IsValid():
+	case rv.Type() == fd.typ:
+		return rv, nil
+	case rv.Kind() == fd.typ.Kind() && rv.Kind() != reflect.Ptr && rv.Kind() != reflect.Slice && rv.Kind() != reflect.Map:
+		return rv.Convert(fd.typ), nil
+	}
+	return reflect.Value{}, fmt.Errorf("proto: cannot set field %s of type %v to %v", fd.Name, fd.typ, rv.Type())
+}
+
+// Clear unsets the field. Clearing a oneof member that is not set does
+// nothing.
+func (r *MessageReflection) Clear(fd *FieldDesc) {
+	if !r.v.IsValid() {
+		return
+	}
+	if fd.Extension != nil {
+		ClearExtension(r.m, fd.Extension)
+		return
+	}
+	fv := r.v.Field(fd.index)
+	if fd.oneofType != nil && !r.Has(fd) {
+		return
+	}
+	fv.Set(reflect.Zero(fv.Type()))
+}
+
+// NewField returns a new, empty value suitable for passing to Set for the
+// field: an empty message, slice or map, or a scalar's zero value. The
+// value is not attached to the message.
+func (r *MessageReflection) NewField(fd *FieldDesc) interface{} {
+	switch fd.typ.Kind() {
+	case reflect.Ptr:
+		return reflect.New(fd.typ.Elem()).Interface()
+	case reflect.Slice:
+		return reflect.MakeSlice(fd.typ, 0, 0).Interface()
+	case reflect.Map:
+		return reflect.MakeMap(fd.typ).Interface()
+	}
+	return reflect.Zero(fd.typ).Interface()
+}
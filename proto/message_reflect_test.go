@@ -0,0 +1,241 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	ppb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func mustField(t *testing.T, r *proto.MessageReflection, key interface{}) *proto.FieldDesc {
+	var fd *proto.FieldDesc
+	switch k := key.(type) {
+	case int:
+		fd = r.FieldByNumber(int32(k))
+	case string:
+		fd = r.FieldByName(k)
+	}
+	if fd == nil {
+		t.Fatalf("no field %v in %T", key, r.Message())
+	}
+	return fd
+}
+
+func TestReflectProto2(t *testing.T) {
+	m := &pb.MyMessage{Count: proto.Int32(4), Pet: []string{"cat"}}
+	r := proto.Reflect(m)
+
+	count := mustField(t, r, 1)
+	if count.Name != "count" || !r.Has(count) || r.Get(count) != int32(4) {
+		t.Errorf("count: %+v has=%v get=%v", count, r.Has(count), r.Get(count))
+	}
+	name := mustField(t, r, "name")
+	if r.Has(name) || r.Get(name) != "" {
+		t.Errorf("unset name: has=%v get=%q", r.Has(name), r.Get(name))
+	}
+	if err := r.Set(name, "Dave"); err != nil || m.GetName() != "Dave" {
+		t.Errorf("Set(name) = %v; m.Name = %q", err, m.GetName())
+	}
+	bikeshed := mustField(t, r, "bikeshed")
+	if err := r.Set(bikeshed, int32(pb.MyMessage_BLUE)); err != nil || m.GetBikeshed() != pb.MyMessage_BLUE {
+		t.Errorf("Set(bikeshed, int32) = %v; m.Bikeshed = %v", err, m.Bikeshed)
+	}
+	if err := r.Set(bikeshed, "BLUE"); err == nil {
+		t.Error("Set(bikeshed, string) succeeded")
+	}
+
+	pet := mustField(t, r, "pet")
+	if !pet.Repeated || !reflect.DeepEqual(r.Get(pet), []string{"cat"}) {
+		t.Errorf("pet: repeated=%v get=%v", pet.Repeated, r.Get(pet))
+	}
+
+	inner := mustField(t, r, "inner")
+	if r.Has(inner) || r.Get(inner) != (*pb.InnerMessage)(nil) {
+		t.Errorf("unset inner: has=%v get=%#v", r.Has(inner), r.Get(inner))
+	}
+	im := r.NewField(inner).(*pb.InnerMessage)
+	im.Host = proto.String("h")
+	if err := r.Set(inner, im); err != nil || m.Inner != im {
+		t.Errorf("Set(inner) = %v; m.Inner = %v", err, m.Inner)
+	}
+	r.Clear(inner)
+	if m.Inner != nil {
+		t.Errorf("Clear(inner) left %v", m.Inner)
+	}
+
+	group := mustField(t, r, 8)
+	if group.Name != "SomeGroup" {
+		t.Errorf("field 8 name = %q", group.Name)
+	}
+
+	var got []string
+	r.Range(func(fd *proto.FieldDesc, v interface{}) bool {
+		got = append(got, fd.Name)
+		return true
+	})
+	if want := []string{"count", "name", "pet", "bikeshed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range visited %v; want %v", got, want)
+	}
+}
+
+func TestReflectDefaults(t *testing.T) {
+	r := proto.Reflect(&pb.Defaults{})
+	for _, tc := range []struct {
+		name string
+		want interface{}
+	}{
+		{"F_Int32", int32(32)},
+		{"F_String", "hello, \"world!\"\n"},
+		{"F_Bytes", []byte("Bignose")},
+		{"F_Enum", pb.Defaults_GREEN},
+		{"str_zero", ""},
+	} {
+		fd := mustField(t, r, tc.name)
+		if got := r.Get(fd); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Get(%s) = %#v; want %#v", tc.name, got, tc.want)
+		}
+	}
+	// Reads of a nil message report defaults too.
+	if got := proto.Reflect((*pb.Defaults)(nil)).Get(mustField(t, r, "F_Int32")); got != int32(32) {
+		t.Errorf("Get on nil message = %v", got)
+	}
+}
+
+func TestReflectProto3(t *testing.T) {
+	m := &ppb.Message{Name: "n", Terrain: map[string]*ppb.Nested{"a": {Bunny: "b"}}}
+	r := proto.Reflect(m)
+
+	name, height, data := mustField(t, r, "name"), mustField(t, r, "height_in_cm"), mustField(t, r, "data")
+	if !r.Has(name) || r.Has(height) || r.Has(data) {
+		t.Errorf("Has: name=%v height=%v data=%v", r.Has(name), r.Has(height), r.Has(data))
+	}
+	if err := r.Set(height, uint32(180)); err != nil || m.HeightInCm != 180 {
+		t.Errorf("Set(height_in_cm) = %v; m.HeightInCm = %v", err, m.HeightInCm)
+	}
+	r.Clear(name)
+	if m.Name != "" || r.Has(name) {
+		t.Errorf("Clear(name) left %q", m.Name)
+	}
+
+	terrain := mustField(t, r, 10)
+	if !terrain.Map || terrain.Repeated || !r.Has(terrain) {
+		t.Errorf("terrain: %+v", terrain)
+	}
+	tm := r.NewField(terrain).(map[string]*ppb.Nested)
+	tm["x"] = &ppb.Nested{}
+	if err := r.Set(terrain, tm); err != nil || len(m.Terrain) != 1 || m.Terrain["x"] == nil {
+		t.Errorf("Set(terrain) = %v; m.Terrain = %v", err, m.Terrain)
+	}
+}
+
+func TestReflectOneof(t *testing.T) {
+	m := &pb.Communique{Union: &pb.Communique_Number{Number: 7}}
+	r := proto.Reflect(m)
+
+	number, msg := mustField(t, r, "number"), mustField(t, r, 10)
+	if number.Oneof != "union" || !r.Has(number) || r.Get(number) != int32(7) {
+		t.Errorf("number: %+v has=%v get=%v", number, r.Has(number), r.Get(number))
+	}
+	if r.Has(msg) || r.Get(msg) != (*pb.Strings)(nil) {
+		t.Errorf("unset msg: has=%v get=%v", r.Has(msg), r.Get(msg))
+	}
+	if err := r.Set(msg, &pb.Strings{StringField: proto.String("s")}); err != nil || m.GetMsg().GetStringField() != "s" {
+		t.Errorf("Set(msg) = %v; m = %v", err, m)
+	}
+	if r.Has(number) {
+		t.Error("setting msg did not replace number")
+	}
+	r.Clear(number)
+	if m.GetMsg() == nil {
+		t.Error("clearing an unset member cleared the oneof")
+	}
+	r.Clear(msg)
+	if m.Union != nil {
+		t.Errorf("Clear(msg) left %v", m.Union)
+	}
+}
+
+func TestReflectExtensions(t *testing.T) {
+	m := &pb.MyMessage{Count: proto.Int32(1)}
+	r := proto.Reflect(m)
+
+	number := mustField(t, r, "testdata.Ext.number")
+	if number.Extension != pb.E_Ext_Number || r.FieldByNumber(105) == nil {
+		t.Fatalf("extension lookup: %+v", number)
+	}
+	if r.Has(number) || r.Get(number) != int32(0) {
+		t.Errorf("unset extension: has=%v get=%v", r.Has(number), r.Get(number))
+	}
+	if err := r.Set(number, int32(42)); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := proto.GetExtension(m, pb.E_Ext_Number); err != nil || *v.(*int32) != 42 {
+		t.Errorf("GetExtension = %v, %v", v, err)
+	}
+	more := mustField(t, r, 103)
+	if err := r.Set(more, &pb.Ext{Data: proto.String("d")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Extensions decoded from the wire are visible too.
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := new(pb.MyMessage)
+	if err := proto.Unmarshal(b, m2); err != nil {
+		t.Fatal(err)
+	}
+	r2 := proto.Reflect(m2)
+	got := map[string]interface{}{}
+	r2.Range(func(fd *proto.FieldDesc, v interface{}) bool {
+		got[fd.Name] = v
+		return true
+	})
+	if len(got) != 3 || got["testdata.Ext.number"] != int32(42) || got["testdata.Ext.more"].(*pb.Ext).GetData() != "d" {
+		t.Errorf("Range = %v", got)
+	}
+
+	r2.Clear(number)
+	if proto.HasExtension(m2, pb.E_Ext_Number) {
+		t.Error("Clear left the extension set")
+	}
+
+	fields := r.Fields()
+	if last := fields[len(fields)-1]; last.Extension == nil {
+		t.Errorf("Fields did not end with extensions: %v", last.Name)
+	}
+}
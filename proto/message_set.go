@@ -153,12 +153,12 @@ func MarshalMessageSet(exts interface{}) ([]byte, error) {
 	var m map[int32]Extension
 	switch exts := exts.(type) {
 	case *XXX_InternalExtensions:
-		if err := encodeExtensions(exts); err != nil {
+		if err := encodeExtensions(exts, false); err != nil {
 			return nil, err
 		}
 		m, _ = exts.extensionsRead()
 	case map[int32]Extension:
-		if err := encodeExtensionsMap(exts); err != nil {
+		if err := encodeExtensionsMap(exts, false); err != nil {
 			return nil, err
 		}
 		m = exts
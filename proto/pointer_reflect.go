@@ -58,6 +58,11 @@ func structPointer_IsNil(p structPointer) bool {
 	return p.v.IsNil()
 }
 
+// Equal reports whether p and q point to the same struct.
+func structPointer_Equal(p, q structPointer) bool {
+	return p.v.Pointer() == q.v.Pointer()
+}
+
 // Interface returns the struct pointer as an interface value.
 func structPointer_Interface(p structPointer, _ reflect.Type) interface{} {
 	return p.v.Interface()
@@ -144,6 +149,11 @@ func structPointer_Extensions(p structPointer, f field) *XXX_InternalExtensions
 	return structPointer_ifield(p, f).(*XXX_InternalExtensions)
 }
 
+// Lazy returns the address of an XXX_LazyFields field in the struct.
+func structPointer_Lazy(p structPointer, f field) *XXX_LazyFields {
+	return structPointer_ifield(p, f).(*XXX_LazyFields)
+}
+
 // ExtMap returns the address of an extension map field in the struct.
 func structPointer_ExtMap(p structPointer, f field) *map[int32]Extension {
 	return structPointer_ifield(p, f).(*map[int32]Extension)
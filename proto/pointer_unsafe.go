@@ -62,6 +62,11 @@ func structPointer_IsNil(p structPointer) bool {
 	return p == nil
 }
 
+// Equal reports whether p and q point to the same struct.
+func structPointer_Equal(p, q structPointer) bool {
+	return p == q
+}
+
 // Interface returns the struct pointer, assumed to have element type t,
 // as an interface value.
 func structPointer_Interface(p structPointer, t reflect.Type) interface{} {
@@ -130,6 +135,11 @@ func structPointer_Extensions(p structPointer, f field) *XXX_InternalExtensions
 	return (*XXX_InternalExtensions)(unsafe.Pointer(uintptr(p) + uintptr(f)))
 }
 
+// Lazy returns the address of an XXX_LazyFields field in the struct.
+func structPointer_Lazy(p structPointer, f field) *XXX_LazyFields {
+	return (*XXX_LazyFields)(unsafe.Pointer(uintptr(p) + uintptr(f)))
+}
+
 func structPointer_ExtMap(p structPointer, f field) *map[int32]Extension {
 	return (*map[int32]Extension)(unsafe.Pointer(uintptr(p) + uintptr(f)))
 }
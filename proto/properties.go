@@ -44,6 +44,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 const debug bool = false
@@ -141,7 +142,10 @@ type StructProperties struct {
 	decoderOrigNames map[string]int // map from original name to struct field number
 	order            []int          // list of struct field numbers in tag order
 	unrecField       field          // field id of the XXX_unrecognized []byte field
+	lazyField        field          // field id of the XXX_lazy XXX_LazyFields field
 	extendable       bool           // is this an extendable proto
+	minfo            *marshalInfo   // coding table used by Marshal and Size
+	stats            *TypeStats     // per-type counters; see GetTypeStats
 
 	oneofMarshaler   oneofMarshaler
 	oneofUnmarshaler oneofUnmarshaler
@@ -169,6 +173,17 @@ func (sp *StructProperties) Less(i, j int) bool {
 }
 func (sp *StructProperties) Swap(i, j int) { sp.order[i], sp.order[j] = sp.order[j], sp.order[i] }
 
+// oneofFieldName returns the .proto name of the oneof field with the given tag,
+// for use in error messages.
+func (sp *StructProperties) oneofFieldName(tag int) string {
+	for _, oop := range sp.OneofTypes {
+		if oop.Prop.Tag == tag {
+			return oop.Prop.OrigName
+		}
+	}
+	return "{Unknown}"
+}
+
 // Properties represents the protocol-specific behavior of a single struct field.
 type Properties struct {
 	Name     string // name of the field, for error messages
@@ -184,6 +199,12 @@ type Properties struct {
 	Enum     string // set for enum types only
 	proto3   bool   // whether this is known to be a proto3 field; set for []byte only
 	oneof    bool   // whether this is a oneof field
+	Lazy     bool   // whether to defer decoding; set for singular message fields only
+
+	// Proto3Optional is set for fields declared optional in a proto3 file.
+	// Like proto2 fields, they are pointers (or []byte) whose nil value
+	// means unset, so a zero value is still encoded.
+	Proto3Optional bool
 
 	Default    string // default value
 	HasDefault bool   // whether an explicit default was provided
@@ -198,6 +219,7 @@ type Properties struct {
 	sprop         *StructProperties // set for struct types only
 	isMarshaler   bool
 	isUnmarshaler bool
+	lazyField     field // the containing struct's XXX_lazy field; set if Lazy
 
 	mtype    reflect.Type // set for map types only
 	mkeyprop *Properties  // set for map types only
@@ -237,9 +259,15 @@ func (p *Properties) String() string {
 	if p.proto3 {
 		s += ",proto3"
 	}
+	if p.Proto3Optional {
+		s += ",proto3_optional"
+	}
 	if p.oneof {
 		s += ",oneof"
 	}
+	if p.Lazy {
+		s += ",lazy"
+	}
 	if len(p.Enum) > 0 {
 		s += ",enum=" + p.Enum
 	}
@@ -318,8 +346,12 @@ func (p *Properties) Parse(s string) {
 			p.Enum = f[5:]
 		case f == "proto3":
 			p.proto3 = true
+		case f == "proto3_optional":
+			p.Proto3Optional = true
 		case f == "oneof":
 			p.oneof = true
+		case f == "lazy":
+			p.Lazy = true
 		case strings.HasPrefix(f, "def="):
 			p.HasDefault = true
 			p.Default = f[4:] // rest of string
@@ -649,8 +681,9 @@ func GetProperties(t reflect.Type) *StructProperties {
 	sprop, ok := propertiesMap[t]
 	propertiesMu.RUnlock()
 	if ok {
-		if collectStats {
-			stats.Chit++
+		if collectStats() {
+			atomic.AddUint64(&stats.Chit, 1)
+			atomic.AddUint64(&sprop.stats.Chit, 1)
 		}
 		return sprop
 	}
@@ -664,16 +697,18 @@ func GetProperties(t reflect.Type) *StructProperties {
 // getPropertiesLocked requires that propertiesMu is held.
 func getPropertiesLocked(t reflect.Type) *StructProperties {
 	if prop, ok := propertiesMap[t]; ok {
-		if collectStats {
-			stats.Chit++
+		if collectStats() {
+			atomic.AddUint64(&stats.Chit, 1)
+			atomic.AddUint64(&prop.stats.Chit, 1)
 		}
 		return prop
 	}
-	if collectStats {
-		stats.Cmiss++
-	}
 
-	prop := new(StructProperties)
+	prop := &StructProperties{stats: new(TypeStats)}
+	if collectStats() {
+		atomic.AddUint64(&stats.Cmiss, 1)
+		atomic.AddUint64(&prop.stats.Cmiss, 1)
+	}
 	// in case of recursive protos, fill this in now.
 	propertiesMap[t] = prop
 
@@ -681,6 +716,7 @@ func getPropertiesLocked(t reflect.Type) *StructProperties {
 	prop.extendable = reflect.PtrTo(t).Implements(extendableProtoType) ||
 		reflect.PtrTo(t).Implements(extendableProtoV1Type)
 	prop.unrecField = invalidField
+	prop.lazyField = invalidField
 	prop.Prop = make([]*Properties, t.NumField())
 	prop.order = make([]int, t.NumField())
 
@@ -700,6 +736,8 @@ func getPropertiesLocked(t reflect.Type) *StructProperties {
 			p.size = size_map
 		} else if f.Name == "XXX_unrecognized" { // special case
 			prop.unrecField = toField(&f)
+		} else if f.Name == "XXX_lazy" { // special case
+			prop.lazyField = toField(&f)
 		}
 		oneof := f.Tag.Get("protobuf_oneof") // special case
 		if oneof != "" {
@@ -720,6 +758,16 @@ func getPropertiesLocked(t reflect.Type) *StructProperties {
 		}
 	}
 
+	// Lazy decoding needs somewhere to keep the encoded bytes.
+	for _, p := range prop.Prop {
+		if p.Lazy && (!prop.lazyField.IsValid() || p.stype == nil || p.Repeated || p.WireType != WireBytes || p.mtype != nil) {
+			p.Lazy = false
+		}
+		if p.Lazy {
+			p.lazyField = prop.lazyField
+		}
+	}
+
 	// Re-order prop.order.
 	sort.Sort(prop)
 
@@ -776,6 +824,8 @@ func getPropertiesLocked(t reflect.Type) *StructProperties {
 	}
 	prop.reqCount = reqCount
 
+	prop.minfo = newMarshalInfo(t, prop)
+
 	return prop
 }
 
@@ -803,70 +853,40 @@ func getbase(pb Message) (t reflect.Type, b structPointer, err error) {
 	return
 }
 
-// A global registry of enum types.
-// The generated code will register the generated maps by calling RegisterEnum.
-
-var enumValueMaps = make(map[string]map[string]int32)
-
 // RegisterEnum is called from the generated code to install the enum descriptor
-// maps into the global table to aid parsing text format protocol buffers.
+// maps into GlobalRegistry to aid parsing text format protocol buffers.
 func RegisterEnum(typeName string, unusedNameMap map[int32]string, valueMap map[string]int32) {
-	if _, ok := enumValueMaps[typeName]; ok {
-		panic("proto: duplicate enum registered: " + typeName)
+	if err := GlobalRegistry.RegisterEnum(typeName, valueMap); err != nil {
+		panic(err.Error())
 	}
-	enumValueMaps[typeName] = valueMap
 }
 
 // EnumValueMap returns the mapping from names to integers of the
 // enum type enumType, or a nil if not found.
 func EnumValueMap(enumType string) map[string]int32 {
-	return enumValueMaps[enumType]
+	return GlobalRegistry.EnumValueMap(enumType)
 }
 
-// A registry of all linked message types.
-// The string is a fully-qualified proto name ("pkg.Message").
-var (
-	protoTypes    = make(map[string]reflect.Type)
-	revProtoTypes = make(map[reflect.Type]string)
-)
-
 // RegisterType is called from generated code and maps from the fully qualified
 // proto name to the type (pointer to struct) of the protocol buffer.
 func RegisterType(x Message, name string) {
-	if _, ok := protoTypes[name]; ok {
+	if err := GlobalRegistry.RegisterType(x, name); err != nil {
 		// TODO: Some day, make this a panic.
-		log.Printf("proto: duplicate proto type registered: %s", name)
-		return
+		log.Print(err)
 	}
-	t := reflect.TypeOf(x)
-	protoTypes[name] = t
-	revProtoTypes[t] = name
 }
 
 // MessageName returns the fully-qualified proto name for the given message type.
-func MessageName(x Message) string {
-	type xname interface {
-		XXX_MessageName() string
-	}
-	if m, ok := x.(xname); ok {
-		return m.XXX_MessageName()
-	}
-	return revProtoTypes[reflect.TypeOf(x)]
-}
+func MessageName(x Message) string { return GlobalRegistry.MessageName(x) }
 
 // MessageType returns the message type (pointer to struct) for a named message.
-func MessageType(name string) reflect.Type { return protoTypes[name] }
-
-// A registry of all linked proto files.
-var (
-	protoFiles = make(map[string][]byte) // file name => fileDescriptor
-)
+func MessageType(name string) reflect.Type { return GlobalRegistry.MessageType(name) }
 
 // RegisterFile is called from generated code and maps from the
 // full file name of a .proto file to its compressed FileDescriptorProto.
 func RegisterFile(filename string, fileDescriptor []byte) {
-	protoFiles[filename] = fileDescriptor
+	GlobalRegistry.RegisterFile(filename, fileDescriptor)
 }
 
 // FileDescriptor returns the compressed FileDescriptorProto for a .proto file.
-func FileDescriptor(filename string) []byte { return protoFiles[filename] }
+func FileDescriptor(filename string) []byte { return GlobalRegistry.FileDescriptor(filename) }
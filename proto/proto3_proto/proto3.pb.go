@@ -14,6 +14,7 @@ It has these top-level messages:
 	MessageWithMap
 	IntMap
 	IntMaps
+	OptionalScalars
 */
 package proto3_proto
 
@@ -62,24 +63,25 @@ func (x Message_Humour) String() string {
 func (Message_Humour) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 0} }
 
 type Message struct {
-	Name         string                           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Hilarity     Message_Humour                   `protobuf:"varint,2,opt,name=hilarity,enum=proto3_proto.Message_Humour" json:"hilarity,omitempty"`
-	HeightInCm   uint32                           `protobuf:"varint,3,opt,name=height_in_cm,json=heightInCm" json:"height_in_cm,omitempty"`
-	Data         []byte                           `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	ResultCount  int64                            `protobuf:"varint,7,opt,name=result_count,json=resultCount" json:"result_count,omitempty"`
-	TrueScotsman bool                             `protobuf:"varint,8,opt,name=true_scotsman,json=trueScotsman" json:"true_scotsman,omitempty"`
-	Score        float32                          `protobuf:"fixed32,9,opt,name=score" json:"score,omitempty"`
-	Key          []uint64                         `protobuf:"varint,5,rep,packed,name=key" json:"key,omitempty"`
-	ShortKey     []int32                          `protobuf:"varint,19,rep,packed,name=short_key,json=shortKey" json:"short_key,omitempty"`
-	Nested       *Nested                          `protobuf:"bytes,6,opt,name=nested" json:"nested,omitempty"`
-	RFunny       []Message_Humour                 `protobuf:"varint,16,rep,packed,name=r_funny,json=rFunny,enum=proto3_proto.Message_Humour" json:"r_funny,omitempty"`
-	Terrain      map[string]*Nested               `protobuf:"bytes,10,rep,name=terrain" json:"terrain,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Proto2Field  *testdata.SubDefaults            `protobuf:"bytes,11,opt,name=proto2_field,json=proto2Field" json:"proto2_field,omitempty"`
-	Proto2Value  map[string]*testdata.SubDefaults `protobuf:"bytes,13,rep,name=proto2_value,json=proto2Value" json:"proto2_value,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Anything     *google_protobuf.Any             `protobuf:"bytes,14,opt,name=anything" json:"anything,omitempty"`
-	ManyThings   []*google_protobuf.Any           `protobuf:"bytes,15,rep,name=many_things,json=manyThings" json:"many_things,omitempty"`
-	Submessage   *Message                         `protobuf:"bytes,17,opt,name=submessage" json:"submessage,omitempty"`
-	Children     []*Message                       `protobuf:"bytes,18,rep,name=children" json:"children,omitempty"`
+	Name             string                           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Hilarity         Message_Humour                   `protobuf:"varint,2,opt,name=hilarity,enum=proto3_proto.Message_Humour" json:"hilarity,omitempty"`
+	HeightInCm       uint32                           `protobuf:"varint,3,opt,name=height_in_cm,json=heightInCm" json:"height_in_cm,omitempty"`
+	Data             []byte                           `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	ResultCount      int64                            `protobuf:"varint,7,opt,name=result_count,json=resultCount" json:"result_count,omitempty"`
+	TrueScotsman     bool                             `protobuf:"varint,8,opt,name=true_scotsman,json=trueScotsman" json:"true_scotsman,omitempty"`
+	Score            float32                          `protobuf:"fixed32,9,opt,name=score" json:"score,omitempty"`
+	Key              []uint64                         `protobuf:"varint,5,rep,packed,name=key" json:"key,omitempty"`
+	ShortKey         []int32                          `protobuf:"varint,19,rep,packed,name=short_key,json=shortKey" json:"short_key,omitempty"`
+	Nested           *Nested                          `protobuf:"bytes,6,opt,name=nested" json:"nested,omitempty"`
+	RFunny           []Message_Humour                 `protobuf:"varint,16,rep,packed,name=r_funny,json=rFunny,enum=proto3_proto.Message_Humour" json:"r_funny,omitempty"`
+	Terrain          map[string]*Nested               `protobuf:"bytes,10,rep,name=terrain" json:"terrain,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Proto2Field      *testdata.SubDefaults            `protobuf:"bytes,11,opt,name=proto2_field,json=proto2Field" json:"proto2_field,omitempty"`
+	Proto2Value      map[string]*testdata.SubDefaults `protobuf:"bytes,13,rep,name=proto2_value,json=proto2Value" json:"proto2_value,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Anything         *google_protobuf.Any             `protobuf:"bytes,14,opt,name=anything" json:"anything,omitempty"`
+	ManyThings       []*google_protobuf.Any           `protobuf:"bytes,15,rep,name=many_things,json=manyThings" json:"many_things,omitempty"`
+	Submessage       *Message                         `protobuf:"bytes,17,opt,name=submessage" json:"submessage,omitempty"`
+	Children         []*Message                       `protobuf:"bytes,18,rep,name=children" json:"children,omitempty"`
+	XXX_unrecognized []byte                           `json:"-"`
 }
 
 func (m *Message) Reset()                    { *m = Message{} }
@@ -214,8 +216,9 @@ func (m *Message) GetChildren() []*Message {
 }
 
 type Nested struct {
-	Bunny string `protobuf:"bytes,1,opt,name=bunny" json:"bunny,omitempty"`
-	Cute  bool   `protobuf:"varint,2,opt,name=cute" json:"cute,omitempty"`
+	Bunny            string `protobuf:"bytes,1,opt,name=bunny" json:"bunny,omitempty"`
+	Cute             bool   `protobuf:"varint,2,opt,name=cute" json:"cute,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Nested) Reset()                    { *m = Nested{} }
@@ -238,7 +241,8 @@ func (m *Nested) GetCute() bool {
 }
 
 type MessageWithMap struct {
-	ByteMapping map[bool][]byte `protobuf:"bytes,1,rep,name=byte_mapping,json=byteMapping" json:"byte_mapping,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ByteMapping      map[bool][]byte `protobuf:"bytes,1,rep,name=byte_mapping,json=byteMapping" json:"byte_mapping,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *MessageWithMap) Reset()                    { *m = MessageWithMap{} }
@@ -254,7 +258,8 @@ func (m *MessageWithMap) GetByteMapping() map[bool][]byte {
 }
 
 type IntMap struct {
-	Rtt map[int32]int32 `protobuf:"bytes,1,rep,name=rtt" json:"rtt,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Rtt              map[int32]int32 `protobuf:"bytes,1,rep,name=rtt" json:"rtt,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *IntMap) Reset()                    { *m = IntMap{} }
@@ -270,7 +275,8 @@ func (m *IntMap) GetRtt() map[int32]int32 {
 }
 
 type IntMaps struct {
-	Maps []*IntMap `protobuf:"bytes,1,rep,name=maps" json:"maps,omitempty"`
+	Maps             []*IntMap `protobuf:"bytes,1,rep,name=maps" json:"maps,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *IntMaps) Reset()                    { *m = IntMaps{} }
@@ -285,63 +291,243 @@ func (m *IntMaps) GetMaps() []*IntMap {
 	return nil
 }
 
+type OptionalScalars struct {
+	Count   *int32          `protobuf:"varint,1,opt,name=count,proto3_optional" json:"count,omitempty"`
+	Label   *string         `protobuf:"bytes,2,opt,name=label,proto3_optional" json:"label,omitempty"`
+	Enabled *bool           `protobuf:"varint,3,opt,name=enabled,proto3_optional" json:"enabled,omitempty"`
+	Blob    []byte          `protobuf:"bytes,4,opt,name=blob,proto3_optional" json:"blob,omitempty"`
+	Humour  *Message_Humour `protobuf:"varint,5,opt,name=humour,proto3_optional,enum=proto3_proto.Message_Humour" json:"humour,omitempty"`
+	Ratio   *float64        `protobuf:"fixed64,6,opt,name=ratio,proto3_optional" json:"ratio,omitempty"`
+	Nested  *Nested         `protobuf:"bytes,7,opt,name=nested,proto3_optional" json:"nested,omitempty"`
+	// Types that are valid to be assigned to Kind:
+	//	*OptionalScalars_Word
+	//	*OptionalScalars_Number
+	Kind             isOptionalScalars_Kind `protobuf_oneof:"kind"`
+	XXX_unrecognized []byte                 `json:"-"`
+}
+
+func (m *OptionalScalars) Reset()                    { *m = OptionalScalars{} }
+func (m *OptionalScalars) String() string            { return proto.CompactTextString(m) }
+func (*OptionalScalars) ProtoMessage()               {}
+func (*OptionalScalars) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type isOptionalScalars_Kind interface{ isOptionalScalars_Kind() }
+
+type OptionalScalars_Word struct {
+	Word string `protobuf:"bytes,8,opt,name=word,oneof"`
+}
+type OptionalScalars_Number struct {
+	Number int64 `protobuf:"varint,9,opt,name=number,oneof"`
+}
+
+func (*OptionalScalars_Word) isOptionalScalars_Kind()   {}
+func (*OptionalScalars_Number) isOptionalScalars_Kind() {}
+
+func (m *OptionalScalars) GetKind() isOptionalScalars_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (m *OptionalScalars) GetCount() int32 {
+	if m != nil && m.Count != nil {
+		return *m.Count
+	}
+	return 0
+}
+
+func (m *OptionalScalars) GetLabel() string {
+	if m != nil && m.Label != nil {
+		return *m.Label
+	}
+	return ""
+}
+
+func (m *OptionalScalars) GetEnabled() bool {
+	if m != nil && m.Enabled != nil {
+		return *m.Enabled
+	}
+	return false
+}
+
+func (m *OptionalScalars) GetBlob() []byte {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *OptionalScalars) GetHumour() Message_Humour {
+	if m != nil && m.Humour != nil {
+		return *m.Humour
+	}
+	return Message_UNKNOWN
+}
+
+func (m *OptionalScalars) GetRatio() float64 {
+	if m != nil && m.Ratio != nil {
+		return *m.Ratio
+	}
+	return 0
+}
+
+func (m *OptionalScalars) GetNested() *Nested {
+	if m != nil {
+		return m.Nested
+	}
+	return nil
+}
+
+func (m *OptionalScalars) GetWord() string {
+	if x, ok := m.GetKind().(*OptionalScalars_Word); ok {
+		return x.Word
+	}
+	return ""
+}
+
+func (m *OptionalScalars) GetNumber() int64 {
+	if x, ok := m.GetKind().(*OptionalScalars_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*OptionalScalars) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _OptionalScalars_OneofMarshaler, _OptionalScalars_OneofUnmarshaler, _OptionalScalars_OneofSizer, []interface{}{
+		(*OptionalScalars_Word)(nil),
+		(*OptionalScalars_Number)(nil),
+	}
+}
+
+func _OptionalScalars_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*OptionalScalars)
+	// kind
+	switch x := m.Kind.(type) {
+	case *OptionalScalars_Word:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Word)
+	case *OptionalScalars_Number:
+		b.EncodeVarint(9<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case nil:
+	default:
+		return fmt.Errorf("OptionalScalars.Kind has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _OptionalScalars_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*OptionalScalars)
+	switch tag {
+	case 8: // kind.word
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Kind = &OptionalScalars_Word{x}
+		return true, err
+	case 9: // kind.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Kind = &OptionalScalars_Number{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _OptionalScalars_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*OptionalScalars)
+	// kind
+	switch x := m.Kind.(type) {
+	case *OptionalScalars_Word:
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Word)))
+		n += len(x.Word)
+	case *OptionalScalars_Number:
+		n += proto.SizeVarint(9<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 func init() {
 	proto.RegisterType((*Message)(nil), "proto3_proto.Message")
 	proto.RegisterType((*Nested)(nil), "proto3_proto.Nested")
 	proto.RegisterType((*MessageWithMap)(nil), "proto3_proto.MessageWithMap")
 	proto.RegisterType((*IntMap)(nil), "proto3_proto.IntMap")
 	proto.RegisterType((*IntMaps)(nil), "proto3_proto.IntMaps")
+	proto.RegisterType((*OptionalScalars)(nil), "proto3_proto.OptionalScalars")
 	proto.RegisterEnum("proto3_proto.Message_Humour", Message_Humour_name, Message_Humour_value)
 }
 
 func init() { proto.RegisterFile("proto3_proto/proto3.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x53, 0x6d, 0x6f, 0xf3, 0x34,
-	0x14, 0x25, 0x4d, 0x5f, 0xd2, 0x9b, 0x74, 0x0b, 0x5e, 0x91, 0xbc, 0x02, 0x52, 0x28, 0x12, 0x8a,
-	0x78, 0x49, 0xa1, 0xd3, 0xd0, 0x84, 0x10, 0x68, 0x1b, 0x9b, 0xa8, 0xd6, 0x95, 0xca, 0xdd, 0x98,
-	0xf8, 0x14, 0xa5, 0xad, 0xdb, 0x46, 0x34, 0x4e, 0x49, 0x1c, 0xa4, 0xfc, 0x1d, 0xfe, 0x28, 0x8f,
-	0x6c, 0xa7, 0x5d, 0x36, 0x65, 0xcf, 0xf3, 0x29, 0xf6, 0xf1, 0xb9, 0xf7, 0x9c, 0x1c, 0x5f, 0xc3,
-	0xe9, 0x2e, 0x89, 0x79, 0x7c, 0xe6, 0xcb, 0xcf, 0x40, 0x6d, 0x3c, 0xf9, 0x41, 0x56, 0xf9, 0xa8,
-	0x77, 0xba, 0x8e, 0xe3, 0xf5, 0x96, 0x2a, 0xca, 0x3c, 0x5b, 0x0d, 0x02, 0x96, 0x2b, 0x62, 0xef,
-	0x84, 0xd3, 0x94, 0x2f, 0x03, 0x1e, 0x0c, 0xc4, 0x42, 0x81, 0xfd, 0xff, 0x5b, 0xd0, 0xba, 0xa7,
-	0x69, 0x1a, 0xac, 0x29, 0x42, 0x50, 0x67, 0x41, 0x44, 0xb1, 0xe6, 0x68, 0x6e, 0x9b, 0xc8, 0x35,
-	0xba, 0x00, 0x63, 0x13, 0x6e, 0x83, 0x24, 0xe4, 0x39, 0xae, 0x39, 0x9a, 0x7b, 0x34, 0xfc, 0xcc,
-	0x2b, 0x0b, 0x7a, 0x45, 0xb1, 0xf7, 0x7b, 0x16, 0xc5, 0x59, 0x42, 0x0e, 0x6c, 0xe4, 0x80, 0xb5,
-	0xa1, 0xe1, 0x7a, 0xc3, 0xfd, 0x90, 0xf9, 0x8b, 0x08, 0xeb, 0x8e, 0xe6, 0x76, 0x08, 0x28, 0x6c,
-	0xc4, 0xae, 0x23, 0xa1, 0x27, 0xec, 0xe0, 0xba, 0xa3, 0xb9, 0x16, 0x91, 0x6b, 0xf4, 0x05, 0x58,
-	0x09, 0x4d, 0xb3, 0x2d, 0xf7, 0x17, 0x71, 0xc6, 0x38, 0x6e, 0x39, 0x9a, 0xab, 0x13, 0x53, 0x61,
-	0xd7, 0x02, 0x42, 0x5f, 0x42, 0x87, 0x27, 0x19, 0xf5, 0xd3, 0x45, 0xcc, 0xd3, 0x28, 0x60, 0xd8,
-	0x70, 0x34, 0xd7, 0x20, 0x96, 0x00, 0x67, 0x05, 0x86, 0xba, 0xd0, 0x48, 0x17, 0x71, 0x42, 0x71,
-	0xdb, 0xd1, 0xdc, 0x1a, 0x51, 0x1b, 0x64, 0x83, 0xfe, 0x37, 0xcd, 0x71, 0xc3, 0xd1, 0xdd, 0x3a,
-	0x11, 0x4b, 0xf4, 0x29, 0xb4, 0xd3, 0x4d, 0x9c, 0x70, 0x5f, 0xe0, 0x27, 0x8e, 0xee, 0x36, 0x88,
-	0x21, 0x81, 0x3b, 0x9a, 0xa3, 0x6f, 0xa1, 0xc9, 0x68, 0xca, 0xe9, 0x12, 0x37, 0x1d, 0xcd, 0x35,
-	0x87, 0xdd, 0x97, 0xbf, 0x3e, 0x91, 0x67, 0xa4, 0xe0, 0xa0, 0x73, 0x68, 0x25, 0xfe, 0x2a, 0x63,
-	0x2c, 0xc7, 0xb6, 0xa3, 0x7f, 0x30, 0xa9, 0x66, 0x72, 0x2b, 0xb8, 0xe8, 0x67, 0x68, 0x71, 0x9a,
-	0x24, 0x41, 0xc8, 0x30, 0x38, 0xba, 0x6b, 0x0e, 0xfb, 0xd5, 0x65, 0x0f, 0x8a, 0x74, 0xc3, 0x78,
-	0x92, 0x93, 0x7d, 0x09, 0xba, 0x00, 0x75, 0xff, 0x43, 0x7f, 0x15, 0xd2, 0xed, 0x12, 0x9b, 0xd2,
-	0xe8, 0x27, 0xde, 0xfe, 0xae, 0xbd, 0x59, 0x36, 0xff, 0x8d, 0xae, 0x82, 0x6c, 0xcb, 0x53, 0x62,
-	0x2a, 0xea, 0xad, 0x60, 0xa2, 0xd1, 0xa1, 0xf2, 0xdf, 0x60, 0x9b, 0x51, 0xdc, 0x91, 0xe2, 0x5f,
-	0x55, 0x8b, 0x4f, 0x25, 0xf3, 0x4f, 0x41, 0x54, 0x06, 0x8a, 0x56, 0x12, 0x41, 0xdf, 0x83, 0x11,
-	0xb0, 0x9c, 0x6f, 0x42, 0xb6, 0xc6, 0x47, 0x45, 0x52, 0x6a, 0x0e, 0xbd, 0xfd, 0x1c, 0x7a, 0x97,
-	0x2c, 0x27, 0x07, 0x16, 0x3a, 0x07, 0x33, 0x0a, 0x58, 0xee, 0xcb, 0x5d, 0x8a, 0x8f, 0xa5, 0x76,
-	0x75, 0x11, 0x08, 0xe2, 0x83, 0xe4, 0xa1, 0x73, 0x80, 0x34, 0x9b, 0x47, 0xca, 0x14, 0xfe, 0xb8,
-	0xf8, 0xd7, 0x2a, 0xc7, 0xa4, 0x44, 0x44, 0x3f, 0x80, 0xb1, 0xd8, 0x84, 0xdb, 0x65, 0x42, 0x19,
-	0x46, 0x52, 0xea, 0x8d, 0xa2, 0x03, 0xad, 0x37, 0x05, 0xab, 0x1c, 0xf8, 0x7e, 0x72, 0xd4, 0xd3,
-	0x90, 0x93, 0xf3, 0x35, 0x34, 0x54, 0x70, 0xb5, 0xf7, 0xcc, 0x86, 0xa2, 0xfc, 0x54, 0xbb, 0xd0,
-	0x7a, 0x8f, 0x60, 0xbf, 0x4e, 0xb1, 0xa2, 0xeb, 0x37, 0x2f, 0xbb, 0xbe, 0x71, 0x91, 0xcf, 0x6d,
-	0xfb, 0xbf, 0x42, 0x53, 0x0d, 0x14, 0x32, 0xa1, 0xf5, 0x38, 0xb9, 0x9b, 0xfc, 0xf1, 0x34, 0xb1,
-	0x3f, 0x42, 0x06, 0xd4, 0xa7, 0x8f, 0x93, 0x99, 0xad, 0xa1, 0x0e, 0xb4, 0x67, 0xe3, 0xcb, 0xe9,
-	0xec, 0x61, 0x74, 0x7d, 0x67, 0xd7, 0xd0, 0x31, 0x98, 0x57, 0xa3, 0xf1, 0xd8, 0xbf, 0xba, 0x1c,
-	0x8d, 0x6f, 0xfe, 0xb2, 0xf5, 0xfe, 0x10, 0x9a, 0xca, 0xac, 0x78, 0x33, 0x73, 0x39, 0xbe, 0xca,
-	0x8f, 0xda, 0x88, 0x57, 0xba, 0xc8, 0xb8, 0x32, 0x64, 0x10, 0xb9, 0xee, 0xff, 0xa7, 0xc1, 0x51,
-	0x91, 0xd9, 0x53, 0xc8, 0x37, 0xf7, 0xc1, 0x0e, 0x4d, 0xc1, 0x9a, 0xe7, 0x9c, 0xfa, 0x51, 0xb0,
-	0xdb, 0x89, 0x39, 0xd0, 0x64, 0xce, 0xdf, 0x55, 0xe6, 0x5c, 0xd4, 0x78, 0x57, 0x39, 0xa7, 0xf7,
-	0x8a, 0x5f, 0x4c, 0xd5, 0xfc, 0x19, 0xe9, 0xfd, 0x02, 0xf6, 0x6b, 0x42, 0x39, 0x30, 0x43, 0x05,
-	0xd6, 0x2d, 0x07, 0x66, 0x95, 0x93, 0xf9, 0x07, 0x9a, 0x23, 0xc6, 0x85, 0xb7, 0x01, 0xe8, 0x09,
-	0xe7, 0x85, 0xa5, 0xcf, 0x5f, 0x5a, 0x52, 0x14, 0x8f, 0x70, 0xae, 0x2c, 0x08, 0x66, 0xef, 0x47,
-	0x30, 0xf6, 0x40, 0x59, 0xb2, 0x51, 0x21, 0xd9, 0x28, 0x4b, 0x9e, 0x41, 0x4b, 0xf5, 0x4b, 0x91,
-	0x0b, 0xf5, 0x28, 0xd8, 0xa5, 0x85, 0x68, 0xb7, 0x4a, 0x94, 0x48, 0xc6, 0xbc, 0xa9, 0x8e, 0xde,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x75, 0x38, 0xad, 0x84, 0xe4, 0x05, 0x00, 0x00,
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xed, 0x6e, 0xe3, 0x44,
+	0x17, 0xae, 0xe3, 0xf8, 0x23, 0x27, 0x69, 0x9b, 0x77, 0x36, 0xaf, 0x70, 0x03, 0x2b, 0x99, 0x20,
+	0x21, 0x8b, 0x0f, 0x97, 0xcd, 0xaa, 0xa8, 0x20, 0x04, 0x6a, 0xca, 0xae, 0x1c, 0xb5, 0xcd, 0x46,
+	0x93, 0x96, 0x15, 0xbf, 0xac, 0x71, 0x32, 0x4d, 0xac, 0xb5, 0xc7, 0xc1, 0x1e, 0x83, 0x7c, 0x07,
+	0x70, 0x1b, 0xdc, 0x28, 0x68, 0x66, 0x9c, 0x34, 0x5d, 0xa5, 0xec, 0xaf, 0x9c, 0x8f, 0xe7, 0xcc,
+	0x79, 0xe6, 0xf1, 0x99, 0x13, 0x38, 0x59, 0xe7, 0x19, 0xcf, 0x5e, 0x86, 0xf2, 0xe7, 0x54, 0x39,
+	0xbe, 0xfc, 0x41, 0x9d, 0xdd, 0x54, 0xff, 0x64, 0x99, 0x65, 0xcb, 0x84, 0x2a, 0x48, 0x54, 0xde,
+	0x9f, 0x12, 0x56, 0x29, 0x60, 0xff, 0x19, 0xa7, 0x05, 0x5f, 0x10, 0x4e, 0x4e, 0x85, 0xa1, 0x82,
+	0x83, 0x7f, 0x2c, 0xb0, 0x6e, 0x68, 0x51, 0x90, 0x25, 0x45, 0x08, 0x9a, 0x8c, 0xa4, 0xd4, 0xd1,
+	0x5c, 0xcd, 0x6b, 0x61, 0x69, 0xa3, 0x73, 0xb0, 0x57, 0x71, 0x42, 0xf2, 0x98, 0x57, 0x4e, 0xc3,
+	0xd5, 0xbc, 0xa3, 0xe1, 0x27, 0xfe, 0x6e, 0x43, 0xbf, 0x2e, 0xf6, 0x83, 0x32, 0xcd, 0xca, 0x1c,
+	0x6f, 0xd1, 0xc8, 0x85, 0xce, 0x8a, 0xc6, 0xcb, 0x15, 0x0f, 0x63, 0x16, 0xce, 0x53, 0x47, 0x77,
+	0x35, 0xef, 0x10, 0x83, 0x8a, 0x8d, 0xd9, 0x65, 0x2a, 0xfa, 0x09, 0x3a, 0x4e, 0xd3, 0xd5, 0xbc,
+	0x0e, 0x96, 0x36, 0xfa, 0x14, 0x3a, 0x39, 0x2d, 0xca, 0x84, 0x87, 0xf3, 0xac, 0x64, 0xdc, 0xb1,
+	0x5c, 0xcd, 0xd3, 0x71, 0x5b, 0xc5, 0x2e, 0x45, 0x08, 0x7d, 0x06, 0x87, 0x3c, 0x2f, 0x69, 0x58,
+	0xcc, 0x33, 0x5e, 0xa4, 0x84, 0x39, 0xb6, 0xab, 0x79, 0x36, 0xee, 0x88, 0xe0, 0xac, 0x8e, 0xa1,
+	0x1e, 0x18, 0xc5, 0x3c, 0xcb, 0xa9, 0xd3, 0x72, 0x35, 0xaf, 0x81, 0x95, 0x83, 0xba, 0xa0, 0xbf,
+	0xa3, 0x95, 0x63, 0xb8, 0xba, 0xd7, 0xc4, 0xc2, 0x44, 0x1f, 0x43, 0xab, 0x58, 0x65, 0x39, 0x0f,
+	0x45, 0xfc, 0x99, 0xab, 0x7b, 0x06, 0xb6, 0x65, 0xe0, 0x8a, 0x56, 0xe8, 0x2b, 0x30, 0x19, 0x2d,
+	0x38, 0x5d, 0x38, 0xa6, 0xab, 0x79, 0xed, 0x61, 0xef, 0xf1, 0xd5, 0x27, 0x32, 0x87, 0x6b, 0x0c,
+	0x3a, 0x03, 0x2b, 0x0f, 0xef, 0x4b, 0xc6, 0x2a, 0xa7, 0xeb, 0xea, 0x1f, 0x54, 0xca, 0xcc, 0x5f,
+	0x0b, 0x2c, 0xfa, 0x01, 0x2c, 0x4e, 0xf3, 0x9c, 0xc4, 0xcc, 0x01, 0x57, 0xf7, 0xda, 0xc3, 0xc1,
+	0xfe, 0xb2, 0x5b, 0x05, 0x7a, 0xc5, 0x78, 0x5e, 0xe1, 0x4d, 0x09, 0x3a, 0x07, 0xf5, 0xfd, 0x87,
+	0xe1, 0x7d, 0x4c, 0x93, 0x85, 0xd3, 0x96, 0x44, 0xff, 0xef, 0x6f, 0xbe, 0xb5, 0x3f, 0x2b, 0xa3,
+	0x9f, 0xe9, 0x3d, 0x29, 0x13, 0x5e, 0xe0, 0xb6, 0x82, 0xbe, 0x16, 0x48, 0x34, 0xde, 0x56, 0xfe,
+	0x4e, 0x92, 0x92, 0x3a, 0x87, 0xb2, 0xf9, 0xe7, 0xfb, 0x9b, 0x4f, 0x25, 0xf2, 0x17, 0x01, 0x54,
+	0x04, 0xda, 0xeb, 0x87, 0x08, 0xfa, 0x06, 0x6c, 0xc2, 0x2a, 0xbe, 0x8a, 0xd9, 0xd2, 0x39, 0xaa,
+	0x95, 0x52, 0x73, 0xe8, 0x6f, 0xe6, 0xd0, 0xbf, 0x60, 0x15, 0xde, 0xa2, 0xd0, 0x19, 0xb4, 0x53,
+	0xc2, 0xaa, 0x50, 0x7a, 0x85, 0x73, 0xec, 0xea, 0x4f, 0x16, 0x81, 0x00, 0xde, 0x4a, 0x1c, 0x3a,
+	0x03, 0x28, 0xca, 0x28, 0x55, 0xa4, 0x9c, 0xff, 0xd5, 0x77, 0xdd, 0xc7, 0x18, 0xef, 0x00, 0xd1,
+	0x0b, 0xb0, 0xe7, 0xab, 0x38, 0x59, 0xe4, 0x94, 0x39, 0xc8, 0xd5, 0x9f, 0x2e, 0xda, 0xc2, 0xfa,
+	0x53, 0xe8, 0xec, 0x0a, 0xbe, 0x99, 0x1c, 0xf5, 0x34, 0x84, 0x89, 0xbe, 0x00, 0x43, 0x09, 0xd7,
+	0xf8, 0x8f, 0xd9, 0x50, 0x90, 0xef, 0x1b, 0xe7, 0x5a, 0xff, 0x0e, 0xba, 0xef, 0xab, 0xb8, 0xe7,
+	0xd4, 0x2f, 0x1f, 0x9f, 0xfa, 0xc4, 0x87, 0x7c, 0x38, 0x76, 0xf0, 0x13, 0x98, 0x6a, 0xa0, 0x50,
+	0x1b, 0xac, 0xbb, 0xc9, 0xd5, 0xe4, 0xcd, 0xdb, 0x49, 0xf7, 0x00, 0xd9, 0xd0, 0x9c, 0xde, 0x4d,
+	0x66, 0x5d, 0x0d, 0x1d, 0x42, 0x6b, 0x76, 0x7d, 0x31, 0x9d, 0xdd, 0x8e, 0x2f, 0xaf, 0xba, 0x0d,
+	0x74, 0x0c, 0xed, 0xd1, 0xf8, 0xfa, 0x3a, 0x1c, 0x5d, 0x8c, 0xaf, 0x5f, 0xfd, 0xda, 0xd5, 0x07,
+	0x43, 0x30, 0x15, 0x59, 0xf1, 0x66, 0x22, 0x39, 0xbe, 0x8a, 0x8f, 0x72, 0xc4, 0x2b, 0x9d, 0x97,
+	0x5c, 0x11, 0xb2, 0xb1, 0xb4, 0x07, 0x7f, 0x6b, 0x70, 0x54, 0x6b, 0xf6, 0x36, 0xe6, 0xab, 0x1b,
+	0xb2, 0x46, 0x53, 0xe8, 0x44, 0x15, 0xa7, 0x61, 0x4a, 0xd6, 0x6b, 0x31, 0x07, 0x9a, 0xd4, 0xf9,
+	0xeb, 0xbd, 0x3a, 0xd7, 0x35, 0xfe, 0xa8, 0xe2, 0xf4, 0x46, 0xe1, 0xeb, 0xa9, 0x8a, 0x1e, 0x22,
+	0xfd, 0x1f, 0xa1, 0xfb, 0x3e, 0x60, 0x57, 0x30, 0x5b, 0x09, 0xd6, 0xdb, 0x15, 0xac, 0xb3, 0xab,
+	0xcc, 0x6f, 0x60, 0x8e, 0x19, 0x17, 0xdc, 0x4e, 0x41, 0xcf, 0x39, 0xaf, 0x29, 0x3d, 0x7f, 0x4c,
+	0x49, 0x41, 0x7c, 0xcc, 0xb9, 0xa2, 0x20, 0x90, 0xfd, 0x6f, 0xc1, 0xde, 0x04, 0x76, 0x5b, 0x1a,
+	0x7b, 0x5a, 0x1a, 0xbb, 0x2d, 0x5f, 0x82, 0xa5, 0xce, 0x2b, 0x90, 0x07, 0xcd, 0x94, 0xac, 0x8b,
+	0xba, 0x69, 0x6f, 0x5f, 0x53, 0x2c, 0x11, 0x83, 0xbf, 0x74, 0x38, 0x7e, 0xb3, 0xe6, 0x71, 0xc6,
+	0x48, 0x32, 0x9b, 0x93, 0x84, 0xe4, 0x05, 0x3a, 0x01, 0x43, 0xed, 0x3f, 0xd9, 0x36, 0xd0, 0xb0,
+	0x72, 0xff, 0xd4, 0x34, 0x91, 0x4a, 0x48, 0x44, 0x13, 0xd9, 0xbd, 0x15, 0x34, 0xb0, 0x72, 0x45,
+	0xea, 0x39, 0x58, 0x94, 0x91, 0x28, 0xa1, 0x0b, 0xb9, 0x6d, 0xed, 0x40, 0xc7, 0x9b, 0x80, 0x48,
+	0x7f, 0x04, 0xcd, 0x28, 0xc9, 0x22, 0xb5, 0x6f, 0x83, 0x26, 0x96, 0x9e, 0x48, 0x7c, 0x07, 0xe6,
+	0x4a, 0xce, 0x90, 0x63, 0x7c, 0x78, 0xc5, 0x07, 0x06, 0xae, 0xd1, 0x35, 0x9b, 0x9c, 0xf0, 0x38,
+	0x93, 0x1b, 0x52, 0x0b, 0x4c, 0xac, 0x5c, 0x91, 0x7a, 0xb1, 0xdd, 0x9e, 0xd6, 0xd3, 0x2f, 0x24,
+	0xb0, 0x36, 0xfb, 0x53, 0x94, 0xf4, 0xa0, 0xf9, 0x47, 0x96, 0x2f, 0xe4, 0x46, 0x6f, 0x05, 0x07,
+	0x58, 0x7a, 0xc8, 0x01, 0x93, 0x95, 0x69, 0x44, 0x73, 0xb9, 0xcc, 0xf5, 0xe0, 0x00, 0xd7, 0xfe,
+	0xc8, 0x84, 0xe6, 0xbb, 0x98, 0x2d, 0x46, 0x36, 0x98, 0xea, 0xff, 0x42, 0x5a, 0x52, 0x8f, 0x11,
+	0x80, 0x1d, 0xd6, 0x97, 0x1f, 0x59, 0x60, 0x84, 0xe2, 0xb2, 0xa3, 0x16, 0x58, 0xa1, 0x22, 0x2f,
+	0x91, 0x92, 0xab, 0x0c, 0x2a, 0x0e, 0x91, 0xa9, 0x28, 0xfe, 0x3b, 0x00, 0x35, 0x18, 0xff, 0xd1,
+	0x70, 0x07, 0x00, 0x00,
 }
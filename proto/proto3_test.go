@@ -93,6 +93,41 @@ func TestRoundTripProto3(t *testing.T) {
 	}
 }
 
+func TestProto3UnknownFields(t *testing.T) {
+	b, err := proto.Marshal(&pb.Nested{Bunny: "Monty"})
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	// Append fields a newer client might send: 3: 150 and 4: "new".
+	unknown := []byte("\x18\x96\x01\x22\x03new")
+	b = append(b, unknown...)
+
+	m := new(pb.Nested)
+	if err := proto.Unmarshal(b, m); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if string(m.XXX_unrecognized) != string(unknown) {
+		t.Errorf("XXX_unrecognized = %q, want %q", m.XXX_unrecognized, unknown)
+	}
+	out, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	if string(out) != string(b) {
+		t.Errorf("re-marshaled = %q, want %q", out, b)
+	}
+
+	m = new(pb.Nested)
+	buf := proto.NewBuffer(b)
+	buf.SetDiscardUnknown(true)
+	if err := buf.Unmarshal(m); err != nil {
+		t.Fatalf("Buffer.Unmarshal: %v", err)
+	}
+	if m.XXX_unrecognized != nil || m.Bunny != "Monty" {
+		t.Errorf("with SetDiscardUnknown got %v (XXX_unrecognized %q), want only Bunny", m, m.XXX_unrecognized)
+	}
+}
+
 func TestGettersForBasicTypesExist(t *testing.T) {
 	var m pb.Message
 	if got := m.GetNested().GetBunny(); got != "" {
@@ -133,3 +168,56 @@ func TestProto3SetDefaults(t *testing.T) {
 		t.Errorf("with in = %v\nproto.SetDefaults(in) =>\ngot %v\nwant %v", in, got, want)
 	}
 }
+
+func TestProto3Optional(t *testing.T) {
+	if b, err := proto.Marshal(&pb.OptionalScalars{}); err != nil || len(b) > 0 {
+		t.Errorf("proto.Marshal of unset fields = %q, %v; want empty", b, err)
+	}
+
+	// Zero values that are set must survive a round trip.
+	humour := pb.Message_UNKNOWN
+	m := &pb.OptionalScalars{
+		Count:   proto.Int32(0),
+		Label:   proto.String(""),
+		Enabled: proto.Bool(false),
+		Blob:    []byte{},
+		Humour:  &humour,
+		Ratio:   proto.Float64(0),
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	want := "\x08\x00\x12\x00\x18\x00\x22\x00\x28\x00\x31\x00\x00\x00\x00\x00\x00\x00\x00"
+	if string(b) != want {
+		t.Errorf("proto.Marshal = %q, want %q", b, want)
+	}
+	m2 := new(pb.OptionalScalars)
+	if err := proto.Unmarshal(b, m2); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if m2.Count == nil || m2.Label == nil || m2.Enabled == nil || m2.Blob == nil || m2.Humour == nil || m2.Ratio == nil {
+		t.Errorf("presence lost in round trip: %v", m2)
+	}
+	if !proto.Equal(m, m2) {
+		t.Errorf("proto.Equal returned false:\n m: %v\nm2: %v", m, m2)
+	}
+	if proto.Equal(m, &pb.OptionalScalars{}) {
+		t.Errorf("proto.Equal treats set zero values as unset")
+	}
+	if got := proto.Clone(m).(*pb.OptionalScalars); got.Count == nil || got.Blob == nil {
+		t.Errorf("proto.Clone lost presence: %v", got)
+	}
+
+	text := `count:0 label:"" enabled:false blob:"" humour:UNKNOWN ratio:0 `
+	if got := proto.CompactTextString(m); got != text {
+		t.Errorf("text = %q, want %q", got, text)
+	}
+	m3 := new(pb.OptionalScalars)
+	if err := proto.UnmarshalText("count: 0 enabled: false", m3); err != nil {
+		t.Fatalf("proto.UnmarshalText: %v", err)
+	}
+	if m3.Count == nil || m3.Enabled == nil || m3.Label != nil {
+		t.Errorf("proto.UnmarshalText presence = %v", m3)
+	}
+}
@@ -0,0 +1,218 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// A Registry maps proto names to the Go types, enums, files and extensions
+// that implement them. The package-level registration functions
+// (RegisterType, RegisterEnum, RegisterFile, RegisterExtension) and lookup
+// functions (MessageType, MessageName, EnumValueMap, FileDescriptor,
+// RegisteredExtensions) operate on GlobalRegistry, which is where generated
+// code installs its types.
+//
+// A separate Registry lets a program resolve names against a restricted or
+// extended set of types, for instance when decoding Any messages or
+// extensions in jsonpb, the text format or ptypes.
+//
+// The zero value is an empty registry ready to use, and a Registry is safe
+// for concurrent use. A nil *Registry stands for GlobalRegistry in every
+// method, so option fields of type *Registry default to the global one.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+	names map[reflect.Type]string
+	enums map[string]map[string]int32
+	files map[string][]byte
+
+	// exts is keyed by the extended struct type. Each inner map is
+	// replaced rather than modified once stored, so it can be handed
+	// out to readers without holding mu.
+	exts map[reflect.Type]map[int32]*ExtensionDesc
+}
+
+// GlobalRegistry is the registry populated by generated code and consulted
+// by the package-level lookup functions.
+var GlobalRegistry = new(Registry)
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return new(Registry)
+}
+
+func (r *Registry) orGlobal() *Registry {
+	if r == nil {
+		return GlobalRegistry
+	}
+	return r
+}
+
+// RegisterType maps the fully qualified proto name to the type
+// (pointer to struct) of x.
+// It returns an error if name is already registered.
+func (r *Registry) RegisterType(x Message, name string) error {
+	r = r.orGlobal()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.types[name]; ok {
+		return fmt.Errorf("proto: duplicate proto type registered: %s", name)
+	}
+	if r.types == nil {
+		r.types = make(map[string]reflect.Type)
+		r.names = make(map[reflect.Type]string)
+	}
+	t := reflect.TypeOf(x)
+	r.types[name] = t
+	r.names[t] = name
+	return nil
+}
+
+// MessageType returns the message type (pointer to struct) registered
+// under name, or nil if there is none.
+func (r *Registry) MessageType(name string) reflect.Type {
+	r = r.orGlobal()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.types[name]
+}
+
+// MessageName returns the fully-qualified proto name for the given message type.
+// Messages that report their own name through an XXX_MessageName method
+// need not be registered.
+func (r *Registry) MessageName(x Message) string {
+	type xname interface {
+		XXX_MessageName() string
+	}
+	if m, ok := x.(xname); ok {
+		return m.XXX_MessageName()
+	}
+	return r.typeName(reflect.TypeOf(x))
+}
+
+// typeName returns the name registered for t, which is a pointer to struct.
+func (r *Registry) typeName(t reflect.Type) string {
+	r = r.orGlobal()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.names[t]
+}
+
+// RegisterEnum installs the mapping from value names to numbers for the
+// enum typeName.
+// It returns an error if typeName is already registered.
+func (r *Registry) RegisterEnum(typeName string, valueMap map[string]int32) error {
+	r = r.orGlobal()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.enums[typeName]; ok {
+		return fmt.Errorf("proto: duplicate enum registered: %s", typeName)
+	}
+	if r.enums == nil {
+		r.enums = make(map[string]map[string]int32)
+	}
+	r.enums[typeName] = valueMap
+	return nil
+}
+
+// EnumValueMap returns the mapping from names to integers of the
+// enum type enumType, or a nil if not found.
+func (r *Registry) EnumValueMap(enumType string) map[string]int32 {
+	r = r.orGlobal()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.enums[enumType]
+}
+
+// RegisterFile maps the full file name of a .proto file to its
+// compressed FileDescriptorProto. A later registration of the same
+// file name replaces the earlier one.
+func (r *Registry) RegisterFile(filename string, fileDescriptor []byte) {
+	r = r.orGlobal()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.files == nil {
+		r.files = make(map[string][]byte)
+	}
+	r.files[filename] = fileDescriptor
+}
+
+// FileDescriptor returns the compressed FileDescriptorProto for a .proto file.
+func (r *Registry) FileDescriptor(filename string) []byte {
+	r = r.orGlobal()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.files[filename]
+}
+
+// RegisterExtension adds desc to the extensions of its extended type.
+// It returns an error if the field number is already registered for
+// that type.
+func (r *Registry) RegisterExtension(desc *ExtensionDesc) error {
+	r = r.orGlobal()
+	st := reflect.TypeOf(desc.ExtendedType).Elem()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.exts[st]
+	if _, ok := old[desc.Field]; ok {
+		return fmt.Errorf("proto: duplicate extension registered: %s %d", st, desc.Field)
+	}
+	m := make(map[int32]*ExtensionDesc, len(old)+1)
+	for k, v := range old {
+		m[k] = v
+	}
+	m[desc.Field] = desc
+	if r.exts == nil {
+		r.exts = make(map[reflect.Type]map[int32]*ExtensionDesc)
+	}
+	r.exts[st] = m
+	return nil
+}
+
+// RegisteredExtensions returns a map of the registered extensions of a
+// protocol buffer struct, indexed by the extension number.
+// The argument pb should be a nil pointer to the struct type.
+// The returned map must not be modified.
+func (r *Registry) RegisteredExtensions(pb Message) map[int32]*ExtensionDesc {
+	return r.extensions(reflect.TypeOf(pb).Elem())
+}
+
+// extensions returns the registered extensions of the struct type st.
+func (r *Registry) extensions(st reflect.Type) map[int32]*ExtensionDesc {
+	r = r.orGlobal()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.exts[st]
+}
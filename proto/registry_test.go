@@ -0,0 +1,154 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/proto/proto3_proto"
+	. "github.com/golang/protobuf/proto/testdata"
+)
+
+func TestRegistry(t *testing.T) {
+	reg := proto.NewRegistry()
+	if err := reg.RegisterType((*pb.Nested)(nil), "test.Nested"); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterType((*pb.Nested)(nil), "test.Nested"); err == nil {
+		t.Error("duplicate RegisterType: got nil error")
+	}
+	if got, want := reg.MessageType("test.Nested"), reflect.TypeOf((*pb.Nested)(nil)); got != want {
+		t.Errorf("MessageType = %v, want %v", got, want)
+	}
+	if got := reg.MessageType("proto3_proto.Nested"); got != nil {
+		t.Errorf("MessageType of a globally registered name = %v, want nil", got)
+	}
+	if got := reg.MessageName(&pb.Nested{}); got != "test.Nested" {
+		t.Errorf("MessageName = %q, want %q", got, "test.Nested")
+	}
+
+	values := map[string]int32{"A": 1}
+	if err := reg.RegisterEnum("test.E", values); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterEnum("test.E", values); err == nil {
+		t.Error("duplicate RegisterEnum: got nil error")
+	}
+	if got := reg.EnumValueMap("test.E"); !reflect.DeepEqual(got, values) {
+		t.Errorf("EnumValueMap = %v, want %v", got, values)
+	}
+
+	reg.RegisterFile("test.proto", []byte("x"))
+	if got := string(reg.FileDescriptor("test.proto")); got != "x" {
+		t.Errorf("FileDescriptor = %q, want %q", got, "x")
+	}
+
+	if err := reg.RegisterExtension(E_Ext_More); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterExtension(E_Ext_More); err == nil {
+		t.Error("duplicate RegisterExtension: got nil error")
+	}
+	exts := reg.RegisteredExtensions((*MyMessage)(nil))
+	if len(exts) != 1 || exts[E_Ext_More.Field] != E_Ext_More {
+		t.Errorf("RegisteredExtensions = %v, want only %v", exts, E_Ext_More.Name)
+	}
+
+	var global *proto.Registry
+	if got, want := global.MessageType("proto3_proto.Nested"), proto.MessageType("proto3_proto.Nested"); got != want {
+		t.Errorf("nil Registry MessageType = %v, want %v", got, want)
+	}
+}
+
+func TestRegistryZeroValue(t *testing.T) {
+	var reg proto.Registry
+	if got := reg.MessageType("proto3_proto.Nested"); got != nil {
+		t.Errorf("empty Registry MessageType = %v, want nil", got)
+	}
+	if err := reg.RegisterType((*pb.Nested)(nil), "test.Nested"); err != nil {
+		t.Fatal(err)
+	}
+	if reg.MessageType("test.Nested") == nil {
+		t.Error("type registered in zero Registry not found")
+	}
+}
+
+func TestTextRegistry(t *testing.T) {
+	reg := proto.NewRegistry()
+	if err := reg.RegisterType((*pb.Nested)(nil), "test.Nested"); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterExtension(E_Ext_More); err != nil {
+		t.Fatal(err)
+	}
+	tu := proto.TextUnmarshaler{Registry: reg}
+
+	const anyText = `anything: < [type.googleapis.com/test.Nested]: < bunny: "Monty" > >`
+	if err := proto.UnmarshalText(anyText, new(pb.Message)); err == nil {
+		t.Error("UnmarshalText resolved an Any type only present in a private registry")
+	}
+	m := new(pb.Message)
+	if err := tu.Unmarshal(anyText, m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Anything.GetTypeUrl(); got != "type.googleapis.com/test.Nested" {
+		t.Errorf("type_url = %q", got)
+	}
+
+	tm := proto.TextMarshaler{Compact: true, ExpandAny: true, Registry: reg}
+	if got, want := tm.Text(m), `anything:<[type.googleapis.com/test.Nested]:<bunny:"Monty" > > `; got != want {
+		t.Errorf("Text with registry:\n got %q\nwant %q", got, want)
+	}
+	tm.Registry = proto.NewRegistry()
+	if got, want := tm.Text(m), `anything:<type_url:"type.googleapis.com/test.Nested" value:"\n\005Monty" > `; got != want {
+		t.Errorf("Text with empty registry:\n got %q\nwant %q", got, want)
+	}
+
+	const extText = `count: 1 [testdata.Ext.more]: < data: "x" >`
+	if err := (&proto.TextUnmarshaler{Registry: proto.NewRegistry()}).Unmarshal(extText, new(MyMessage)); err == nil {
+		t.Error("Unmarshal resolved an extension missing from the registry")
+	}
+	mm := new(MyMessage)
+	if err := tu.Unmarshal(extText, mm); err != nil {
+		t.Fatal(err)
+	}
+	ext, err := proto.GetExtension(mm, E_Ext_More)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ext.(*Ext).GetData(); got != "x" {
+		t.Errorf("extension data = %q, want %q", got, "x")
+	}
+}
@@ -0,0 +1,175 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CheckInitialized reports every required field that is not set in m or in
+// any message reachable from it through message, group, repeated, map and
+// extension fields. It returns nil if all of them are set, and otherwise a
+// *RequiredNotSetError whose Fields method lists the missing fields.
+//
+// The paths are built as Diff builds them: original (.proto) field names
+// joined by dots, with repeated elements indexed as "b[3]", map values as
+// "m[7]" or "m[\"k\"]" and extensions as "[pkg.ext_name]", so a required
+// field c of the fourth element of a repeated field b of a message field a
+// is reported as "a.b[3].c". Extensions that were decoded but are not
+// registered are not checked.
+//
+// If m implements RequiredChecker, its MissingRequired method lists the
+// fields instead, and likewise for the messages m holds. CheckInitialized
+// returns an error for any other message that is not a generated struct.
+func CheckInitialized(m Message) error {
+	v := reflect.ValueOf(m)
+	if m == nil || v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	var missing []string
+	if c, ok := m.(RequiredChecker); ok {
+		missing = c.MissingRequired()
+	} else if isGeneratedStruct(v.Elem()) {
+		checkRequired(v.Elem(), "", &missing)
+	} else {
+		return fmt.Errorf("proto: cannot check the required fields of %T", m)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return &RequiredNotSetError{field: missing[0], fields: missing}
+}
+
+// RequiredChecker is the interface representing messages that can find
+// their own unset required fields, such as messages whose fields are not
+// described by struct tags. MissingRequired returns the paths of the unset
+// required fields in the message and in the messages it holds, relative to
+// the message, in the form CheckInitialized reports them.
+type RequiredChecker interface {
+	MissingRequired() []string
+}
+
+// checkRequired appends to missing the paths of the unset required fields
+// in the message struct v and in the messages it holds.
+func checkRequired(v reflect.Value, path string, missing *[]string) {
+	resolveLazy(v)
+	t := v.Type()
+	sprop := GetProperties(t)
+	for i := 0; i < v.NumField(); i++ {
+		f := t.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		fv := v.Field(i)
+		if f.Tag.Get("protobuf_oneof") != "" {
+			if !fv.IsNil() {
+				name, inner := oneofMember(fv)
+				checkRequiredValue(inner, joinPath(path, name), missing)
+			}
+			continue
+		}
+		prop := sprop.Prop[i]
+		p := joinPath(path, prop.OrigName)
+		if prop.Required && (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Slice) && fv.IsNil() {
+			*missing = append(*missing, p)
+			continue
+		}
+		checkRequiredValue(fv, p, missing)
+	}
+
+	if ep, ok := extendable(v.Addr().Interface()); ok {
+		emap, mu := ep.extensionsRead()
+		if emap == nil {
+			return
+		}
+		mu.Lock()
+		nums := make([]int, 0, len(emap))
+		exts := make(map[int32]Extension, len(emap))
+		for n, e := range emap {
+			nums = append(nums, int(n))
+			exts[n] = e
+		}
+		mu.Unlock()
+		sort.Ints(nums)
+		for _, n := range nums {
+			desc := exts[int32(n)].desc
+			if desc == nil {
+				desc = GlobalRegistry.extensions(t)[int32(n)]
+			}
+			if desc == nil {
+				continue
+			}
+			ev, err := diffExtensionValue(exts[int32(n)], true, desc)
+			if err != nil || ev == nil {
+				continue
+			}
+			checkRequiredValue(reflect.ValueOf(ev), joinPath(path, "["+desc.Name+"]"), missing)
+		}
+	}
+}
+
+// checkRequiredValue checks the messages held by the field value v.
+func checkRequiredValue(v reflect.Value, path string, missing *[]string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if c, ok := v.Interface().(RequiredChecker); ok {
+			for _, p := range c.MissingRequired() {
+				*missing = append(*missing, joinPath(path, p))
+			}
+		} else if isGeneratedStruct(v.Elem()) {
+			checkRequired(v.Elem(), path, missing)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Ptr {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			checkRequiredValue(v.Index(i), path+"["+strconv.Itoa(i)+"]", missing)
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Ptr {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Sort(mapKeys(keys))
+		for _, k := range keys {
+			checkRequiredValue(v.MapIndex(k), path+"["+diffValueString(k.Interface())+"]", missing)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+// innerExt is an extension with required fields; it is not registered.
+var innerExt = &proto.ExtensionDesc{
+	ExtendedType:  (*pb.MyMessage)(nil),
+	ExtensionType: (*pb.InnerMessage)(nil),
+	Field:         123,
+	Name:          "testdata.inner_ext",
+	Tag:           "bytes,123,opt,name=inner_ext",
+}
+
+func TestCheckInitialized(t *testing.T) {
+	m := &pb.MyMessage{
+		Count: proto.Int32(1),
+		Inner: &pb.InnerMessage{},
+		Others: []*pb.OtherMessage{
+			{},
+			{Inner: &pb.InnerMessage{Host: proto.String("h")}},
+			{Inner: &pb.InnerMessage{}},
+		},
+		WeMustGoDeeper: &pb.RequiredInnerMessage{},
+	}
+	if err := proto.SetExtension(m, innerExt, &pb.InnerMessage{}); err != nil {
+		t.Fatal(err)
+	}
+	err := proto.CheckInitialized(m)
+	if err == nil {
+		t.Fatal("CheckInitialized = nil, want an error")
+	}
+	want := []string{
+		"inner.host",
+		"others[2].inner.host",
+		"we_must_go_deeper.leo_finally_won_an_oscar",
+		"[testdata.inner_ext].host",
+	}
+	rerr, ok := err.(*proto.RequiredNotSetError)
+	if !ok {
+		t.Fatalf("CheckInitialized returned %T, want *proto.RequiredNotSetError", err)
+	}
+	if got := rerr.Fields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields() = %q, want %q", got, want)
+	}
+	const wantErr = `proto: required fields "inner.host", "others[2].inner.host", ` +
+		`"we_must_go_deeper.leo_finally_won_an_oscar", "[testdata.inner_ext].host" not set`
+	if err.Error() != wantErr {
+		t.Errorf("Error() = %s, want %s", err, wantErr)
+	}
+
+	tests := []struct {
+		m    proto.Message
+		want []string
+	}{
+		{&pb.MyMessage{Count: proto.Int32(1)}, nil},
+		{&pb.MyMessage{}, []string{"count"}},
+		{(*pb.MyMessage)(nil), nil},
+		{&pb.GoTestRequiredGroupField{Group: &pb.GoTestRequiredGroupField_Group{}}, []string{"Group.Field"}},
+		{&pb.MessageWithMap{MsgMapping: map[int64]*pb.FloatingPoint{
+			2:  {},
+			-1: {F: proto.Float64(1)},
+			1:  {Exact: proto.Bool(true)},
+		}}, []string{"msg_mapping[1].f", "msg_mapping[2].f"}},
+		{&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{}}}, nil},
+		{&pb.Oneof{Union: &pb.Oneof_F_Message{F_Message: &pb.GoTestField{Label: proto.String("l")}}}, []string{"F_Message.Type"}},
+	}
+	for _, tt := range tests {
+		err := proto.CheckInitialized(tt.m)
+		var got []string
+		if err != nil {
+			got = err.(*proto.RequiredNotSetError).Fields()
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CheckInitialized(%v) reports %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestCheckInitializedDecodedExtension(t *testing.T) {
+	m := &pb.OtherMessage{}
+	if err := proto.SetExtension(m, pb.E_RComplex, []*pb.ComplexExtension{{}}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m2 := &pb.OtherMessage{}
+	if err := proto.Unmarshal(b, m2); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.CheckInitialized(m2); err != nil {
+		t.Errorf("CheckInitialized(%v) = %v, want nil", m2, err)
+	}
+}
@@ -0,0 +1,151 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Iteration over raw wire-format fields, without a generated type.
+ */
+
+import (
+	"fmt"
+	"io"
+)
+
+// A RawField is a single field of wire-format data, as reported by Scan.
+type RawField struct {
+	Number   int // field number
+	WireType int // WireVarint, WireFixed64, WireBytes, WireStartGroup or WireFixed32
+	Offset   int // offset of the field's tag within the scanned data
+
+	// Raw is the complete encoding of the field: tag, value and, for
+	// groups, the closing end-group tag. It can be copied to another
+	// message to forward the field unchanged.
+	Raw []byte
+
+	// Value is the encoded value of the field. For WireVarint it holds the
+	// varint bytes, which DecodeVarint decodes. For WireFixed64 and
+	// WireFixed32 it holds the 8 or 4 little-endian bytes. For WireBytes it
+	// holds the payload without its length prefix; an embedded message can
+	// be scanned in turn with Scan. For WireStartGroup it holds the fields
+	// between the start- and end-group tags.
+	Value []byte
+}
+
+// maxScanDepth is the deepest nesting of groups that Scan accepts. Each level
+// of nesting costs a recursive call, so without a limit hostile input could
+// exhaust the stack.
+const maxScanDepth = 10000
+
+// Scan iterates over the wire-format fields in b, calling fn for each one
+// in order. It stops early, returning nil, if fn returns false. It returns
+// an error if b is not well-formed wire-format data, after calling fn for
+// the fields that precede the error. Groups nested more than 10000 deep are
+// rejected with a *DecodeLimitError.
+//
+// The Raw and Value slices of each RawField alias b.
+func Scan(b []byte, fn func(f RawField) bool) error {
+	p := NewBuffer(b)
+	p.maxDepth = maxScanDepth
+	for p.index < len(p.buf) {
+		f, err := p.scanField()
+		if err != nil {
+			return err
+		}
+		if f.WireType == WireEndGroup {
+			return fmt.Errorf("proto: unexpected end group for field %d at offset %d", f.Number, f.Offset)
+		}
+		if !fn(f) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// scanField reads the next field from the buffer. An end-group tag is
+// returned as a field with an empty value, for the caller to match up.
+func (p *Buffer) scanField() (f RawField, err error) {
+	f.Offset = p.index
+	u, err := p.DecodeVarint()
+	if err != nil {
+		return f, err
+	}
+	f.Number, f.WireType = int(u>>3), int(u&0x7)
+	if f.Number <= 0 {
+		return f, fmt.Errorf("proto: illegal tag %d (wire type %d) at offset %d", f.Number, f.WireType, f.Offset)
+	}
+
+	start := p.index
+	switch f.WireType {
+	case WireVarint:
+		_, err = p.DecodeVarint()
+		f.Value = p.buf[start:p.index:p.index]
+	case WireFixed64:
+		_, err = p.DecodeFixed64()
+		f.Value = p.buf[start:p.index:p.index]
+	case WireFixed32:
+		_, err = p.DecodeFixed32()
+		f.Value = p.buf[start:p.index:p.index]
+	case WireBytes:
+		f.Value, err = p.DecodeRawBytes(false)
+	case WireStartGroup:
+		if err = p.enter(); err != nil {
+			return f, err
+		}
+		defer p.leave()
+		for {
+			end := p.index
+			if end >= len(p.buf) {
+				return f, io.ErrUnexpectedEOF
+			}
+			var g RawField
+			if g, err = p.scanField(); err != nil {
+				return f, err
+			}
+			if g.WireType == WireEndGroup {
+				if g.Number != f.Number {
+					return f, fmt.Errorf("proto: end group for field %d at offset %d does not match start group for field %d", g.Number, g.Offset, f.Number)
+				}
+				f.Value = p.buf[start:end:end]
+				break
+			}
+		}
+	case WireEndGroup:
+		// Value is empty.
+	default:
+		return f, fmt.Errorf("proto: unknown wire type %d for field %d at offset %d", f.WireType, f.Number, f.Offset)
+	}
+	if err != nil {
+		return f, err
+	}
+	f.Raw = p.buf[f.Offset:p.index:p.index]
+	return f, nil
+}
@@ -0,0 +1,141 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func TestScan(t *testing.T) {
+	b := []byte("\x08\x07" + // 1: varint 7
+		"\x12\x01t" + // 2: bytes "t"
+		"\xd5\x02\x07\x00\x00\x00" + // 42: fixed32 7
+		"\xd9\x02\x08\x00\x00\x00\x00\x00\x00\x00" + // 43: fixed64 8
+		"\xb3\x04\xba\x04\x01g\xb4\x04") // 70: group holding 71: "g"
+
+	type field struct {
+		Number, WireType int
+		Value            string
+	}
+	var got []field
+	var raw []byte
+	err := proto.Scan(b, func(f proto.RawField) bool {
+		if !bytes.Equal(b[f.Offset:f.Offset+len(f.Raw)], f.Raw) {
+			t.Errorf("field %d: Raw %q is not at offset %d", f.Number, f.Raw, f.Offset)
+		}
+		got = append(got, field{f.Number, f.WireType, string(f.Value)})
+		raw = append(raw, f.Raw...)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	want := []field{
+		{1, proto.WireVarint, "\x07"},
+		{2, proto.WireBytes, "t"},
+		{42, proto.WireFixed32, "\x07\x00\x00\x00"},
+		{43, proto.WireFixed64, "\x08\x00\x00\x00\x00\x00\x00\x00"},
+		{70, proto.WireStartGroup, "\xba\x04\x01g"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan fields:\n got %v\nwant %v", got, want)
+	}
+	if !bytes.Equal(raw, b) {
+		t.Errorf("concatenated Raw = %q, want %q", raw, b)
+	}
+}
+
+func TestScanStop(t *testing.T) {
+	b, err := proto.Marshal(&pb.GoTestField{Label: proto.String("route"), Type: proto.String("x")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var label string
+	calls := 0
+	err = proto.Scan(b, func(f proto.RawField) bool {
+		calls++
+		if f.Number == 1 && f.WireType == proto.WireBytes {
+			label = string(f.Value)
+			return false
+		}
+		return true
+	})
+	if err != nil || label != "route" || calls != 1 {
+		t.Errorf("Scan = %v, label %q after %d calls; want nil, \"route\" after 1 call", err, label, calls)
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   string
+		err  error // nil means any non-nil error
+	}{
+		{"truncated varint", "\x08\x80", io.ErrUnexpectedEOF},
+		{"truncated bytes", "\x12\x05abc", io.ErrUnexpectedEOF},
+		{"truncated fixed32", "\x15\x01\x02", io.ErrUnexpectedEOF},
+		{"unterminated group", "\x0b\x10\x01", io.ErrUnexpectedEOF},
+		{"mismatched end group", "\x0b\x14", nil},
+		{"stray end group", "\x0c", nil},
+		{"field number zero", "\x00\x01", nil},
+		{"bad wire type", "\x0e", nil},
+	}
+	for _, tc := range tests {
+		err := proto.Scan([]byte(tc.in), func(proto.RawField) bool { return true })
+		if err == nil || (tc.err != nil && err != tc.err) {
+			t.Errorf("%s: Scan = %v, want error %v", tc.desc, err, tc.err)
+		}
+	}
+}
+
+func TestScanDeepGroups(t *testing.T) {
+	// Groups nested a million deep must fail cleanly rather than
+	// overflow the stack.
+	const depth = 1000000
+	b := append(bytes.Repeat([]byte{0x0b}, depth), bytes.Repeat([]byte{0x0c}, depth)...)
+	err := proto.Scan(b, func(proto.RawField) bool { return true })
+	if le, ok := err.(*proto.DecodeLimitError); !ok || le.Limit != "depth" {
+		t.Errorf("Scan of deeply nested groups = %v, want depth *DecodeLimitError", err)
+	}
+
+	// Shallower nesting is accepted.
+	b = append(bytes.Repeat([]byte{0x0b}, 100), bytes.Repeat([]byte{0x0c}, 100)...)
+	if err := proto.Scan(b, func(proto.RawField) bool { return true }); err != nil {
+		t.Errorf("Scan of groups nested 100 deep: %v", err)
+	}
+}
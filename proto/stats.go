@@ -0,0 +1,156 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync/atomic"
+)
+
+// TypeStats records encode and decode activity for a single message type.
+// Like Stats, it is only updated while collection is enabled; see
+// SetStatsEnabled.
+type TypeStats struct {
+	Encode      uint64 // number of top-level encodes
+	Decode      uint64 // number of top-level decodes
+	EncodeBytes uint64 // bytes produced by encodes
+	DecodeBytes uint64 // bytes consumed by decodes
+	Emalloc     uint64 // encodes that had to grow the output buffer
+	Dmalloc     uint64 // messages of this type allocated while decoding
+	Chit        uint64 // GetProperties cache hits
+	Cmiss       uint64 // GetProperties cache misses
+	Size        uint64 // number of sizes
+}
+
+func (s *TypeStats) addEncode(n int, grew bool) {
+	atomic.AddUint64(&s.Encode, 1)
+	atomic.AddUint64(&s.EncodeBytes, uint64(n))
+	if grew {
+		atomic.AddUint64(&s.Emalloc, 1)
+		atomic.AddUint64(&stats.Emalloc, 1)
+	}
+}
+
+func (s *TypeStats) addDecode(n int) {
+	atomic.AddUint64(&s.Decode, 1)
+	atomic.AddUint64(&s.DecodeBytes, uint64(n))
+}
+
+func (s *TypeStats) addAlloc() {
+	atomic.AddUint64(&s.Dmalloc, 1)
+	atomic.AddUint64(&stats.Dmalloc, 1)
+}
+
+func (s *TypeStats) load() TypeStats {
+	return TypeStats{
+		Encode:      atomic.LoadUint64(&s.Encode),
+		Decode:      atomic.LoadUint64(&s.Decode),
+		EncodeBytes: atomic.LoadUint64(&s.EncodeBytes),
+		DecodeBytes: atomic.LoadUint64(&s.DecodeBytes),
+		Emalloc:     atomic.LoadUint64(&s.Emalloc),
+		Dmalloc:     atomic.LoadUint64(&s.Dmalloc),
+		Chit:        atomic.LoadUint64(&s.Chit),
+		Cmiss:       atomic.LoadUint64(&s.Cmiss),
+		Size:        atomic.LoadUint64(&s.Size),
+	}
+}
+
+func (s *TypeStats) reset() {
+	for _, p := range []*uint64{
+		&s.Encode, &s.Decode, &s.EncodeBytes, &s.DecodeBytes,
+		&s.Emalloc, &s.Dmalloc, &s.Chit, &s.Cmiss, &s.Size,
+	} {
+		atomic.StoreUint64(p, 0)
+	}
+}
+
+// GetTypeStats returns a snapshot of the per-type counters, keyed by the
+// fully-qualified proto name of each message type (or its Go type name if
+// it was never registered). Types with no recorded activity are omitted.
+func GetTypeStats() map[string]TypeStats {
+	propertiesMu.RLock()
+	defer propertiesMu.RUnlock()
+
+	m := make(map[string]TypeStats)
+	for t, sprop := range propertiesMap {
+		s := sprop.stats.load()
+		if s == (TypeStats{}) {
+			continue
+		}
+		m[typeStatsName(t)] = s
+	}
+	return m
+}
+
+// typeStatsName returns the name under which GetTypeStats reports t.
+func typeStatsName(t reflect.Type) string {
+	if name := GlobalRegistry.typeName(reflect.PtrTo(t)); name != "" {
+		return name
+	}
+	return t.String()
+}
+
+// ResetStats zeroes the counters reported by GetStats and GetTypeStats.
+func ResetStats() {
+	for _, p := range []*uint64{
+		&stats.Emalloc, &stats.Dmalloc, &stats.Encode, &stats.Decode,
+		&stats.Chit, &stats.Cmiss, &stats.Size, &stats.PoolGet, &stats.PoolNew,
+	} {
+		atomic.StoreUint64(p, 0)
+	}
+
+	propertiesMu.RLock()
+	defer propertiesMu.RUnlock()
+	for _, sprop := range propertiesMap {
+		sprop.stats.reset()
+	}
+}
+
+// StatsVar renders the counters reported by GetStats and GetTypeStats as
+// a JSON object with "Global" and "Types" members. It satisfies the
+// expvar.Var interface, so the statistics can be exported with
+//
+//	proto.SetStatsEnabled(true)
+//	expvar.Publish("proto", proto.StatsVar{})
+type StatsVar struct{}
+
+func (StatsVar) String() string {
+	b, err := json.Marshal(struct {
+		Global Stats
+		Types  map[string]TypeStats
+	}{GetStats(), GetTypeStats()})
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
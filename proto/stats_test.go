@@ -0,0 +1,114 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+)
+
+func TestTypeStats(t *testing.T) {
+	proto.SetStatsEnabled(true)
+	defer proto.SetStatsEnabled(false)
+	proto.ResetStats()
+
+	m := &pb.OtherMessage{
+		Key:   proto.Int64(1),
+		Inner: &pb.InnerMessage{Host: proto.String("localhost")},
+	}
+	const n = 3
+	var size int
+	for i := 0; i < n; i++ {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		size = len(b)
+		if err := proto.Unmarshal(b, new(pb.OtherMessage)); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+	}
+	proto.Size(m)
+
+	ts := proto.GetTypeStats()
+	got := ts["testdata.OtherMessage"]
+	if got.Encode != n || got.Decode != n || got.Size != 1 {
+		t.Errorf("OtherMessage counts: encode %d, decode %d, size %d; want %d, %d, 1",
+			got.Encode, got.Decode, got.Size, n, n)
+	}
+	if want := uint64(n * size); got.EncodeBytes != want || got.DecodeBytes != want {
+		t.Errorf("OtherMessage bytes: encoded %d, decoded %d; want %d", got.EncodeBytes, got.DecodeBytes, want)
+	}
+	if got.Emalloc != n {
+		t.Errorf("OtherMessage Emalloc = %d; want %d", got.Emalloc, n)
+	}
+	if got.Chit == 0 {
+		t.Error("OtherMessage recorded no GetProperties cache hits")
+	}
+	if inner := ts["testdata.InnerMessage"]; inner.Dmalloc != n || inner.Decode != 0 {
+		t.Errorf("InnerMessage: Dmalloc %d, Decode %d; want %d, 0", inner.Dmalloc, inner.Decode, n)
+	}
+	if _, ok := ts["testdata.GoTest"]; ok {
+		t.Error("GetTypeStats reported an unused type")
+	}
+	if g := proto.GetStats(); g.Dmalloc != n || g.Emalloc != n {
+		t.Errorf("global Dmalloc %d, Emalloc %d; want %d, %d", g.Dmalloc, g.Emalloc, n, n)
+	}
+
+	// Appending into a buffer with room to spare does not count as a malloc.
+	proto.MarshalAppend(make([]byte, 0, 2*size), m)
+	if got := proto.GetTypeStats()["testdata.OtherMessage"].Emalloc; got != n {
+		t.Errorf("Emalloc after MarshalAppend = %d; want %d", got, n)
+	}
+
+	var v struct {
+		Global proto.Stats
+		Types  map[string]proto.TypeStats
+	}
+	if err := json.Unmarshal([]byte(proto.StatsVar{}.String()), &v); err != nil {
+		t.Fatalf("StatsVar is not valid JSON: %v", err)
+	}
+	if v.Global.Encode != n+1 || v.Types["testdata.OtherMessage"].Encode != n+1 {
+		t.Errorf("StatsVar = %+v", v)
+	}
+
+	proto.ResetStats()
+	if g := proto.GetStats(); g != (proto.Stats{}) {
+		t.Errorf("GetStats after ResetStats = %+v", g)
+	}
+	if ts := proto.GetTypeStats(); len(ts) != 0 {
+		t.Errorf("GetTypeStats after ResetStats = %v", ts)
+	}
+}
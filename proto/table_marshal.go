@@ -0,0 +1,1045 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package proto
+
+/*
+ * Table-driven marshaling.
+ *
+ * For every message type GetProperties builds a marshalInfo: a flat table,
+ * in tag order, of the fields that have encoders, holding for each field
+ * its offset, precomputed tag bytes and a specialized marshaler/sizer pair.
+ * Marshal sizes the message with the table first, allocates the output
+ * once and then encodes it without the intermediate buffers and length
+ * back-patching used by the per-field encoders in encode.go.
+ */
+
+import (
+	"errors"
+	"reflect"
+)
+
+// A fieldMarshaler writes the encoding of a single field, tag included.
+// Like an encoder, it returns ErrNil if there was nothing to write.
+type fieldMarshaler func(o *Buffer, f *marshalFieldInfo, base structPointer) error
+
+// A fieldSizer returns the encoded size of a single field, tag included.
+// Sizers of message fields record the sizes of sub-messages in c, if it is
+// not nil.
+type fieldSizer func(f *marshalFieldInfo, base structPointer, c *sizeCache) int
+
+// marshalInfo is the coding table for a message type.
+type marshalInfo struct {
+	fields     []marshalFieldInfo // fields with encoders, in tag order
+	unrecField field              // field id of the XXX_unrecognized []byte field
+
+	oneofMarshaler oneofMarshaler
+	oneofSizer     oneofSizer
+	stype          reflect.Type
+}
+
+// marshalFieldInfo is the coding table entry for a single field.
+type marshalFieldInfo struct {
+	field       field
+	tagcode     []byte
+	required    bool
+	isMarshaler bool
+	prop        *Properties
+
+	marshal fieldMarshaler
+	size    fieldSizer
+}
+
+// newMarshalInfo builds the coding table for struct type t from its properties.
+// Nested message tables are reached through prop.sprop, so they need not be
+// complete yet; this makes it safe to call while building recursive types.
+func newMarshalInfo(t reflect.Type, sprop *StructProperties) *marshalInfo {
+	mi := &marshalInfo{
+		unrecField:     sprop.unrecField,
+		oneofMarshaler: sprop.oneofMarshaler,
+		oneofSizer:     sprop.oneofSizer,
+		stype:          t,
+	}
+	for _, i := range sprop.order {
+		p := sprop.Prop[i]
+		if p.enc == nil {
+			continue
+		}
+		f := marshalFieldInfo{
+			field:       p.field,
+			tagcode:     p.tagcode,
+			required:    p.Required,
+			isMarshaler: p.isMarshaler,
+			prop:        p,
+		}
+		f.marshal, f.size = fieldCoders(t.Field(i).Type, p)
+		mi.fields = append(mi.fields, f)
+	}
+	return mi
+}
+
+// fieldCoders returns the table marshaler and sizer for a field of type ft.
+// Fields without a specialized implementation fall back to the per-field
+// encoder and sizer in Properties.
+func fieldCoders(ft reflect.Type, p *Properties) (fieldMarshaler, fieldSizer) {
+	if p.mtype != nil || p.Name == "XXX_InternalExtensions" || p.Name == "XXX_extensions" {
+		return marshalProp, sizeProp
+	}
+	varint := p.Wire == "varint"
+	switch ft.Kind() {
+	case reflect.Bool:
+		return marshalBoolValue, sizeBoolValue
+	case reflect.Int32:
+		if varint {
+			return marshalInt32Value, sizeInt32Value
+		}
+	case reflect.Uint32:
+		if varint {
+			return marshalUint32Value, sizeUint32Value
+		}
+	case reflect.Int64, reflect.Uint64:
+		if varint {
+			return marshalInt64Value, sizeInt64Value
+		}
+	case reflect.String:
+		return marshalStringValue, sizeStringValue
+	case reflect.Ptr:
+		switch ft.Elem().Kind() {
+		case reflect.Bool:
+			return marshalBoolPtr, sizeBoolPtr
+		case reflect.Int32:
+			if varint {
+				return marshalInt32Ptr, sizeInt32Ptr
+			}
+		case reflect.Uint32:
+			if varint {
+				return marshalUint32Ptr, sizeUint32Ptr
+			}
+		case reflect.Int64, reflect.Uint64:
+			if varint {
+				return marshalInt64Ptr, sizeInt64Ptr
+			}
+		case reflect.String:
+			return marshalStringPtr, sizeStringPtr
+		case reflect.Struct:
+			if p.Wire == "group" {
+				return marshalGroup, sizeGroup
+			}
+			return marshalMessage, sizeMessage
+		}
+	case reflect.Slice:
+		switch ft.Elem().Kind() {
+		case reflect.Uint8:
+			if p.proto3 {
+				return marshalBytes3, sizeBytes3
+			}
+			return marshalBytes, sizeBytes
+		case reflect.Bool:
+			if p.Packed {
+				return marshalPackedBoolSlice, sizePackedBoolSlice
+			}
+		case reflect.Int32:
+			if p.Packed {
+				return marshalPackedInt32Slice, sizePackedInt32Slice
+			}
+			if varint {
+				return marshalInt32Slice, sizeInt32Slice
+			}
+		case reflect.Uint32, reflect.Float32:
+			if p.Packed {
+				return marshalPackedUint32Slice, sizePackedUint32Slice
+			}
+			if p.Wire == "fixed32" {
+				return marshalFixed32Slice, sizeFixed32Slice
+			}
+		case reflect.Int64, reflect.Uint64, reflect.Float64:
+			if p.Packed {
+				return marshalPackedInt64Slice, sizePackedInt64Slice
+			}
+			switch p.Wire {
+			case "varint":
+				return marshalInt64Slice, sizeInt64Slice
+			case "fixed64":
+				return marshalFixed64Slice, sizeFixed64Slice
+			}
+		case reflect.String:
+			return marshalStringSlice, sizeStringSlice
+		case reflect.Ptr:
+			if p.Wire == "group" {
+				return marshalGroupSlice, sizeGroupSlice
+			}
+			return marshalMessageSlice, sizeMessageSlice
+		}
+	}
+	return marshalProp, sizeProp
+}
+
+// marshalProp and sizeProp adapt the per-field encoder and sizer.
+func marshalProp(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	return f.prop.enc(o, f.prop, base)
+}
+
+func sizeProp(f *marshalFieldInfo, base structPointer, _ *sizeCache) int {
+	return f.prop.size(f.prop, base)
+}
+
+// size returns the encoded size of the message at base.
+// If c is not nil, the sizes of the length-delimited sub-messages beneath
+// base are recorded in it for marshal to use.
+func (mi *marshalInfo) size(base structPointer, c *sizeCache) (n int) {
+	for i := range mi.fields {
+		f := &mi.fields[i]
+		n += f.size(f, base, c)
+	}
+	if mi.unrecField.IsValid() {
+		n += len(*structPointer_Bytes(base, mi.unrecField))
+	}
+	if mi.oneofSizer != nil {
+		m := structPointer_Interface(base, mi.stype).(Message)
+		n += mi.oneofSizer(m)
+	}
+	return n
+}
+
+// marshal appends the encoding of the message at base to o.
+// It follows the same error conventions as enc_struct.
+func (o *Buffer) marshal(mi *marshalInfo, base structPointer) error {
+	var state errorState
+	for i := range mi.fields {
+		f := &mi.fields[i]
+		err := f.marshal(o, f, base)
+		if err == nil {
+			continue
+		}
+		if err == ErrNil {
+			if f.required && state.err == nil {
+				state.err = &RequiredNotSetError{field: f.prop.Name}
+			}
+		} else if err == errRepeatedHasNil {
+			// Give more context to nil values in repeated fields.
+			return errors.New("repeated field " + f.prop.OrigName + " has nil element")
+		} else if !state.shouldContinue(err, f.prop) {
+			return err
+		}
+	}
+
+	if mi.oneofMarshaler != nil {
+		m := structPointer_Interface(base, mi.stype).(Message)
+		if err := mi.oneofMarshaler(m, o); err == ErrNil {
+			return errOneofHasNil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if mi.unrecField.IsValid() {
+		if v := *structPointer_Bytes(base, mi.unrecField); len(v) > 0 {
+			o.appendUnrecognized(v)
+		}
+	}
+	return state.err
+}
+
+// A sizeCache holds the sizes of the sub-messages of a message being
+// marshaled, in the order marshal writes their length prefixes. Sizing the
+// whole tree up front and reusing the sizes makes Marshal size each
+// sub-message once, instead of once for every message enclosing it.
+type sizeCache struct {
+	sizes []int
+	next  int // index of the next size for marshal to use
+
+	// Sub-messages that implement Marshaler but not Size are sized by
+	// encoding them; the encodings are kept here for marshal to write.
+	// So are the choices made for undecoded lazy fields.
+	encs    []marshaled
+	nextEnc int // index of the next encoding for marshal to use
+}
+
+// A marshaled is the result of calling Marshal on a sub-message. For a lazy
+// field it is instead what sizeMessage sized: the kept encoding, in data,
+// or, if isMsg is set, the message in the field. A getter may fill in the
+// message between the two passes, so marshal must not decide afresh.
+type marshaled struct {
+	data  []byte
+	err   error
+	isMsg bool
+}
+
+// reserve adds a slot for the size of a sub-message and returns its index.
+// The slot is reserved before the sub-message's own sub-messages are sized,
+// so slots end up in the order marshal visits the sub-messages.
+func (c *sizeCache) reserve() int {
+	if c == nil {
+		return -1
+	}
+	c.sizes = append(c.sizes, 0)
+	return len(c.sizes) - 1
+}
+
+// set records n in the slot i returned by reserve.
+func (c *sizeCache) set(i, n int) {
+	if c != nil {
+		c.sizes[i] = n
+	}
+}
+
+// take returns the next recorded size, or false if none is left.
+func (c *sizeCache) take() (int, bool) {
+	if c.next >= len(c.sizes) {
+		return 0, false
+	}
+	n := c.sizes[c.next]
+	c.next++
+	return n, true
+}
+
+// keep records the encoding of a sub-message that marshals itself, or the
+// state of a lazy field.
+func (c *sizeCache) keep(e marshaled) {
+	if c != nil {
+		c.encs = append(c.encs, e)
+	}
+}
+
+// takeEncoding returns the next recorded encoding, or false if none is left.
+func (c *sizeCache) takeEncoding() (marshaled, bool) {
+	if c.nextEnc >= len(c.encs) {
+		return marshaled{}, false
+	}
+	e := c.encs[c.nextEnc]
+	c.nextEnc++
+	return e, true
+}
+
+// truncate drops the entries recorded after the first sizes and encs
+// entries, and resets the read positions to next and nextEnc.
+func (c *sizeCache) truncate(sizes, next, encs, nextEnc int) {
+	for i := encs; i < len(c.encs); i++ {
+		c.encs[i] = marshaled{} // don't hold on to the caller's data
+	}
+	c.sizes, c.next = c.sizes[:sizes], next
+	c.encs, c.nextEnc = c.encs[:encs], nextEnc
+}
+
+// subSize returns the size of the sub-message at structp, which marshal is
+// about to write, from the sizes recorded by marshalSized.
+func (o *Buffer) subSize(mi *marshalInfo, structp structPointer) int {
+	if n, ok := o.sizes.take(); ok {
+		return n
+	}
+	return mi.size(structp, nil)
+}
+
+// marshalSized sizes the message at base, grows o to hold it and encodes it,
+// optionally preceded by its length.
+func (o *Buffer) marshalSized(mi *marshalInfo, base structPointer, withLen bool) error {
+	// Sub-message sizes are appended to o.sizes and used up by o.marshal.
+	// A nested call, such as EncodeMessage from a oneof marshaler, works
+	// past the caller's entries and removes its own when it is done.
+	c := &o.sizes
+	start, next := len(c.sizes), c.next
+	startEnc, nextEnc := len(c.encs), c.nextEnc
+	defer c.truncate(start, next, startEnc, nextEnc)
+	c.next, c.nextEnc = start, startEnc
+	n := mi.size(base, &o.sizes)
+	need := n
+	if withLen {
+		need += sizeVarint(uint64(n))
+	}
+	if len(o.buf)+need > maxMarshalSize {
+		return ErrTooLarge
+	}
+	if cap(o.buf)-len(o.buf) < need {
+		buf := make([]byte, len(o.buf), len(o.buf)+need)
+		copy(buf, o.buf)
+		o.buf = buf
+	}
+	if withLen {
+		o.EncodeVarint(uint64(n))
+	}
+	return o.marshal(mi, base)
+}
+
+// Message and group fields.
+
+func marshalMessage(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	structp := structPointer_GetStructPointer(base, f.field)
+	if structPointer_IsNil(structp) {
+		return ErrNil
+	}
+	if f.prop.Lazy {
+		e, ok := o.sizes.takeEncoding()
+		if !ok {
+			e.data, ok = lazyEncoding(f.prop, base, structp)
+			e.isMsg = !ok
+		}
+		if !e.isMsg {
+			o.buf = append(o.buf, f.tagcode...)
+			o.EncodeRawBytes(e.data)
+			return nil
+		}
+	}
+	if f.isMarshaler {
+		return o.marshalMarshaler(f, structp)
+	}
+	mi := f.prop.sprop.minfo
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(o.subSize(mi, structp)))
+	return o.marshal(mi, structp)
+}
+
+func sizeMessage(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	structp := structPointer_GetStructPointer(base, f.field)
+	if structPointer_IsNil(structp) {
+		return 0
+	}
+	if f.prop.Lazy {
+		if raw, ok := lazyEncoding(f.prop, base, structp); ok {
+			c.keep(marshaled{data: raw})
+			return len(f.tagcode) + sizeRawBytes(raw)
+		}
+		c.keep(marshaled{isMsg: true})
+	}
+	if f.isMarshaler {
+		return len(f.tagcode) + sizeMarshaler(f, structp, c)
+	}
+	i := c.reserve()
+	n := f.prop.sprop.minfo.size(structp, c)
+	c.set(i, n)
+	return len(f.tagcode) + sizeVarint(uint64(n)) + n
+}
+
+func marshalMessageSlice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	var state errorState
+	s := structPointer_StructPointerSlice(base, f.field)
+	mi := f.prop.sprop.minfo
+	for i, l := 0, s.Len(); i < l; i++ {
+		structp := s.Index(i)
+		if structPointer_IsNil(structp) {
+			return errRepeatedHasNil
+		}
+		if f.isMarshaler {
+			if err := o.marshalMarshaler(f, structp); err != nil && !state.shouldContinue(err, nil) {
+				return err
+			}
+			continue
+		}
+		o.buf = append(o.buf, f.tagcode...)
+		o.EncodeVarint(uint64(o.subSize(mi, structp)))
+		if err := o.marshal(mi, structp); err != nil && !state.shouldContinue(err, nil) {
+			return err
+		}
+	}
+	return state.err
+}
+
+func sizeMessageSlice(f *marshalFieldInfo, base structPointer, c *sizeCache) (n int) {
+	s := structPointer_StructPointerSlice(base, f.field)
+	mi := f.prop.sprop.minfo
+	l := s.Len()
+	n += l * len(f.tagcode)
+	for i := 0; i < l; i++ {
+		structp := s.Index(i)
+		if structPointer_IsNil(structp) {
+			return // return the size up to this point
+		}
+		if f.isMarshaler {
+			n += sizeMarshaler(f, structp, c)
+			continue
+		}
+		j := c.reserve()
+		n0 := mi.size(structp, c)
+		c.set(j, n0)
+		n += sizeVarint(uint64(n0)) + n0
+	}
+	return
+}
+
+// A marshalSizer is a Marshaler that can also report its encoded size without
+// encoding itself.
+type marshalSizer interface {
+	Size() int
+}
+
+// marshalMarshaler writes a sub-message that marshals itself, as enc_struct_message does.
+// Unless the sub-message is a marshalSizer, it reuses the encoding sizeMarshaler kept.
+func (o *Buffer) marshalMarshaler(f *marshalFieldInfo, structp structPointer) error {
+	var state errorState
+	m := structPointer_Interface(structp, f.prop.stype).(Marshaler)
+	e, ok := marshaled{}, false
+	if _, isSizer := m.(marshalSizer); !isSizer {
+		e, ok = o.sizes.takeEncoding()
+	}
+	if !ok {
+		e.data, e.err = m.Marshal()
+	}
+	if e.err != nil && !state.shouldContinue(e.err, nil) {
+		return e.err
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeRawBytes(e.data)
+	return state.err
+}
+
+// sizeMarshaler returns the size of a sub-message that marshals itself,
+// length prefix included. It uses the Size method if there is one;
+// otherwise it encodes the sub-message and keeps the encoding in c.
+func sizeMarshaler(f *marshalFieldInfo, structp structPointer, c *sizeCache) int {
+	m := structPointer_Interface(structp, f.prop.stype).(Marshaler)
+	if s, ok := m.(marshalSizer); ok {
+		n := s.Size()
+		return sizeVarint(uint64(n)) + n
+	}
+	data, err := m.Marshal()
+	c.keep(marshaled{data: data, err: err})
+	return sizeRawBytes(data)
+}
+
+func marshalGroup(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	structp := structPointer_GetStructPointer(base, f.field)
+	if structPointer_IsNil(structp) {
+		return ErrNil
+	}
+	var state errorState
+	o.EncodeVarint(uint64((f.prop.Tag << 3) | WireStartGroup))
+	if err := o.marshal(f.prop.sprop.minfo, structp); err != nil && !state.shouldContinue(err, nil) {
+		return err
+	}
+	o.EncodeVarint(uint64((f.prop.Tag << 3) | WireEndGroup))
+	return state.err
+}
+
+func sizeGroup(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	structp := structPointer_GetStructPointer(base, f.field)
+	if structPointer_IsNil(structp) {
+		return 0
+	}
+	n := sizeVarint(uint64((f.prop.Tag << 3) | WireStartGroup))
+	n += f.prop.sprop.minfo.size(structp, c)
+	n += sizeVarint(uint64((f.prop.Tag << 3) | WireEndGroup))
+	return n
+}
+
+func marshalGroupSlice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	var state errorState
+	s := structPointer_StructPointerSlice(base, f.field)
+	mi := f.prop.sprop.minfo
+	for i, l := 0, s.Len(); i < l; i++ {
+		structp := s.Index(i)
+		if structPointer_IsNil(structp) {
+			return errRepeatedHasNil
+		}
+		o.EncodeVarint(uint64((f.prop.Tag << 3) | WireStartGroup))
+		if err := o.marshal(mi, structp); err != nil && !state.shouldContinue(err, nil) {
+			return err
+		}
+		o.EncodeVarint(uint64((f.prop.Tag << 3) | WireEndGroup))
+	}
+	return state.err
+}
+
+func sizeGroupSlice(f *marshalFieldInfo, base structPointer, c *sizeCache) (n int) {
+	s := structPointer_StructPointerSlice(base, f.field)
+	mi := f.prop.sprop.minfo
+	l := s.Len()
+	n += l * sizeVarint(uint64((f.prop.Tag<<3)|WireStartGroup))
+	n += l * sizeVarint(uint64((f.prop.Tag<<3)|WireEndGroup))
+	for i := 0; i < l; i++ {
+		structp := s.Index(i)
+		if structPointer_IsNil(structp) {
+			return // return the size up to this point
+		}
+		n += mi.size(structp, c)
+	}
+	return
+}
+
+// appendVarint appends the varint encoding of x to b.
+func appendVarint(b []byte, x uint64) []byte {
+	for x >= 1<<7 {
+		b = append(b, uint8(x&0x7f|0x80))
+		x >>= 7
+	}
+	return append(b, uint8(x))
+}
+
+// Varint scalars. Other encodings of numeric fields use the per-field coders.
+
+func marshalBoolPtr(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := *structPointer_Bool(base, f.field)
+	if v == nil {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	if *v {
+		o.buf = append(o.buf, 1)
+	} else {
+		o.buf = append(o.buf, 0)
+	}
+	return nil
+}
+
+func sizeBoolPtr(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	if *structPointer_Bool(base, f.field) == nil {
+		return 0
+	}
+	return len(f.tagcode) + 1
+}
+
+func marshalBoolValue(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	if !*structPointer_BoolVal(base, f.field) {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.buf = append(o.buf, 1)
+	return nil
+}
+
+func sizeBoolValue(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	if !*structPointer_BoolVal(base, f.field) {
+		return 0
+	}
+	return len(f.tagcode) + 1
+}
+
+func marshalInt32Ptr(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := structPointer_Word32(base, f.field)
+	if word32_IsNil(v) {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(int32(word32_Get(v)))) // permit sign extension
+	return nil
+}
+
+func sizeInt32Ptr(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	v := structPointer_Word32(base, f.field)
+	if word32_IsNil(v) {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(uint64(int32(word32_Get(v))))
+}
+
+func marshalInt32Value(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	x := int32(word32Val_Get(structPointer_Word32Val(base, f.field)))
+	if x == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(x))
+	return nil
+}
+
+func sizeInt32Value(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	x := int32(word32Val_Get(structPointer_Word32Val(base, f.field)))
+	if x == 0 {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(uint64(x))
+}
+
+func marshalUint32Ptr(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := structPointer_Word32(base, f.field)
+	if word32_IsNil(v) {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(word32_Get(v)))
+	return nil
+}
+
+func sizeUint32Ptr(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	v := structPointer_Word32(base, f.field)
+	if word32_IsNil(v) {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(uint64(word32_Get(v)))
+}
+
+func marshalUint32Value(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	x := word32Val_Get(structPointer_Word32Val(base, f.field))
+	if x == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(x))
+	return nil
+}
+
+func sizeUint32Value(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	x := word32Val_Get(structPointer_Word32Val(base, f.field))
+	if x == 0 {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(uint64(x))
+}
+
+func marshalInt64Ptr(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := structPointer_Word64(base, f.field)
+	if word64_IsNil(v) {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(word64_Get(v))
+	return nil
+}
+
+func sizeInt64Ptr(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	v := structPointer_Word64(base, f.field)
+	if word64_IsNil(v) {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(word64_Get(v))
+}
+
+func marshalInt64Value(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	x := word64Val_Get(structPointer_Word64Val(base, f.field))
+	if x == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(x)
+	return nil
+}
+
+func sizeInt64Value(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	x := word64Val_Get(structPointer_Word64Val(base, f.field))
+	if x == 0 {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(x)
+}
+
+// Unpacked repeated scalars. These keep the output slice in a local
+// variable so that appending each element does not write back to the Buffer.
+
+func marshalInt32Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	b := o.buf
+	for i := 0; i < l; i++ {
+		b = append(b, f.tagcode...)
+		b = appendVarint(b, uint64(int32(s.Index(i)))) // permit sign extension
+	}
+	o.buf = b
+	return nil
+}
+
+func sizeInt32Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) (n int) {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	n += l * len(f.tagcode)
+	for i := 0; i < l; i++ {
+		n += sizeVarint(uint64(int32(s.Index(i))))
+	}
+	return
+}
+
+func marshalInt64Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word64Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	b := o.buf
+	for i := 0; i < l; i++ {
+		b = append(b, f.tagcode...)
+		b = appendVarint(b, s.Index(i))
+	}
+	o.buf = b
+	return nil
+}
+
+func sizeInt64Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) (n int) {
+	s := structPointer_Word64Slice(base, f.field)
+	l := s.Len()
+	n += l * len(f.tagcode)
+	for i := 0; i < l; i++ {
+		n += sizeVarint(s.Index(i))
+	}
+	return
+}
+
+func marshalFixed32Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	b := o.buf
+	for i := 0; i < l; i++ {
+		x := s.Index(i)
+		b = append(b, f.tagcode...)
+		b = append(b, uint8(x), uint8(x>>8), uint8(x>>16), uint8(x>>24))
+	}
+	o.buf = b
+	return nil
+}
+
+func sizeFixed32Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	return structPointer_Word32Slice(base, f.field).Len() * (len(f.tagcode) + 4)
+}
+
+func marshalFixed64Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word64Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	b := o.buf
+	for i := 0; i < l; i++ {
+		x := s.Index(i)
+		b = append(b, f.tagcode...)
+		b = append(b, uint8(x), uint8(x>>8), uint8(x>>16), uint8(x>>24),
+			uint8(x>>32), uint8(x>>40), uint8(x>>48), uint8(x>>56))
+	}
+	o.buf = b
+	return nil
+}
+
+func sizeFixed64Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	return structPointer_Word64Slice(base, f.field).Len() * (len(f.tagcode) + 8)
+}
+
+// Strings and bytes.
+
+func marshalStringPtr(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := *structPointer_String(base, f.field)
+	if v == nil {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeStringBytes(*v)
+	return nil
+}
+
+func sizeStringPtr(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	v := *structPointer_String(base, f.field)
+	if v == nil {
+		return 0
+	}
+	return len(f.tagcode) + sizeStringBytes(*v)
+}
+
+func marshalStringValue(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	v := *structPointer_StringVal(base, f.field)
+	if v == "" {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeStringBytes(v)
+	return nil
+}
+
+func sizeStringValue(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	v := *structPointer_StringVal(base, f.field)
+	if v == "" {
+		return 0
+	}
+	return len(f.tagcode) + sizeStringBytes(v)
+}
+
+func marshalStringSlice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	for _, s := range *structPointer_StringSlice(base, f.field) {
+		o.buf = append(o.buf, f.tagcode...)
+		o.EncodeStringBytes(s)
+	}
+	return nil
+}
+
+func sizeStringSlice(f *marshalFieldInfo, base structPointer, c *sizeCache) (n int) {
+	ss := *structPointer_StringSlice(base, f.field)
+	n += len(ss) * len(f.tagcode)
+	for _, s := range ss {
+		n += sizeStringBytes(s)
+	}
+	return
+}
+
+func marshalBytes(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := *structPointer_Bytes(base, f.field)
+	if s == nil {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeRawBytes(s)
+	return nil
+}
+
+func sizeBytes(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	s := *structPointer_Bytes(base, f.field)
+	if s == nil {
+		return 0
+	}
+	return len(f.tagcode) + sizeRawBytes(s)
+}
+
+func marshalBytes3(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := *structPointer_Bytes(base, f.field)
+	if len(s) == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeRawBytes(s)
+	return nil
+}
+
+func sizeBytes3(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	s := *structPointer_Bytes(base, f.field)
+	if len(s) == 0 {
+		return 0
+	}
+	return len(f.tagcode) + sizeRawBytes(s)
+}
+
+// Packed repeated scalars. The payload is sized up front and encoded in
+// place, instead of going through a temporary Buffer.
+
+func marshalPackedBoolSlice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := *structPointer_BoolSlice(base, f.field)
+	if len(s) == 0 {
+		return ErrNil
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(len(s))) // each bool takes exactly one byte
+	for _, x := range s {
+		if x {
+			o.buf = append(o.buf, 1)
+		} else {
+			o.buf = append(o.buf, 0)
+		}
+	}
+	return nil
+}
+
+func sizePackedBoolSlice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	l := len(*structPointer_BoolSlice(base, f.field))
+	if l == 0 {
+		return 0
+	}
+	return len(f.tagcode) + sizeVarint(uint64(l)) + l
+}
+
+func marshalPackedInt32Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(uint64(int32(s.Index(i)))) // permit sign extension
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(n))
+	for i := 0; i < l; i++ {
+		f.prop.valEnc(o, uint64(int32(s.Index(i))))
+	}
+	return nil
+}
+
+func sizePackedInt32Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return 0
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(uint64(int32(s.Index(i))))
+	}
+	return len(f.tagcode) + sizeVarint(uint64(n)) + n
+}
+
+func marshalPackedUint32Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(uint64(s.Index(i)))
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(n))
+	for i := 0; i < l; i++ {
+		f.prop.valEnc(o, uint64(s.Index(i)))
+	}
+	return nil
+}
+
+func sizePackedUint32Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	s := structPointer_Word32Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return 0
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(uint64(s.Index(i)))
+	}
+	return len(f.tagcode) + sizeVarint(uint64(n)) + n
+}
+
+func marshalPackedInt64Slice(o *Buffer, f *marshalFieldInfo, base structPointer) error {
+	s := structPointer_Word64Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return ErrNil
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(s.Index(i))
+	}
+	o.buf = append(o.buf, f.tagcode...)
+	o.EncodeVarint(uint64(n))
+	for i := 0; i < l; i++ {
+		f.prop.valEnc(o, s.Index(i))
+	}
+	return nil
+}
+
+func sizePackedInt64Slice(f *marshalFieldInfo, base structPointer, c *sizeCache) int {
+	s := structPointer_Word64Slice(base, f.field)
+	l := s.Len()
+	if l == 0 {
+		return 0
+	}
+	n := 0
+	for i := 0; i < l; i++ {
+		n += f.prop.valSize(s.Index(i))
+	}
+	return len(f.tagcode) + sizeVarint(uint64(n)) + n
+}
@@ -39,6 +39,7 @@ It has these top-level messages:
 	MessageWithMap
 	Oneof
 	Communique
+	LazyMessage
 */
 package testdata
 
@@ -2956,6 +2957,50 @@ func _Communique_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type LazyMessage struct {
+	Id               *int32               `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Payload          *GoTestField         `protobuf:"bytes,2,opt,name=payload,lazy" json:"payload,omitempty"`
+	Child            *LazyMessage         `protobuf:"bytes,3,opt,name=child,lazy" json:"child,omitempty"`
+	Eager            *GoTestField         `protobuf:"bytes,4,opt,name=eager" json:"eager,omitempty"`
+	XXX_lazy         proto.XXX_LazyFields `json:"-"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *LazyMessage) Reset()                    { *m = LazyMessage{} }
+func (m *LazyMessage) String() string            { return proto.CompactTextString(m) }
+func (*LazyMessage) ProtoMessage()               {}
+func (*LazyMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *LazyMessage) GetId() int32 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *LazyMessage) GetPayload() *GoTestField {
+	if m != nil {
+		m.XXX_lazy.Resolve(2)
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *LazyMessage) GetChild() *LazyMessage {
+	if m != nil {
+		m.XXX_lazy.Resolve(3)
+		return m.Child
+	}
+	return nil
+}
+
+func (m *LazyMessage) GetEager() *GoTestField {
+	if m != nil {
+		return m.Eager
+	}
+	return nil
+}
+
 var E_Greeting = &proto.ExtensionDesc{
 	ExtendedType:  (*MyMessage)(nil),
 	ExtensionType: ([]string)(nil),
@@ -3765,6 +3810,7 @@ func init() {
 	proto.RegisterType((*Oneof)(nil), "testdata.Oneof")
 	proto.RegisterType((*Oneof_F_Group)(nil), "testdata.Oneof.F_Group")
 	proto.RegisterType((*Communique)(nil), "testdata.Communique")
+	proto.RegisterType((*LazyMessage)(nil), "testdata.LazyMessage")
 	proto.RegisterEnum("testdata.FOO", FOO_name, FOO_value)
 	proto.RegisterEnum("testdata.GoTest_KIND", GoTest_KIND_name, GoTest_KIND_value)
 	proto.RegisterEnum("testdata.MyMessage_Color", MyMessage_Color_name, MyMessage_Color_value)
@@ -3864,284 +3910,287 @@ func init() {
 func init() { proto.RegisterFile("test.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 4453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0xc9, 0x77, 0xdb, 0x48,
-	0x7a, 0x37, 0xc0, 0xfd, 0x23, 0x25, 0x42, 0x65, 0xb5, 0x9b, 0x96, 0xbc, 0xc0, 0x9c, 0xe9, 0x6e,
-	0x7a, 0xd3, 0x48, 0x20, 0x44, 0xdb, 0x74, 0xa7, 0xdf, 0xf3, 0x42, 0xca, 0x7a, 0x63, 0x89, 0x0a,
-	0xa4, 0xee, 0x7e, 0xd3, 0x39, 0xf0, 0x51, 0x22, 0x44, 0xb3, 0x4d, 0x02, 0x34, 0x09, 0xc5, 0x52,
-	0x72, 0xe9, 0x4b, 0x72, 0xcd, 0x76, 0xc9, 0x35, 0xa7, 0x9c, 0x92, 0xbc, 0x97, 0x7f, 0x22, 0xe9,
-	0xee, 0x59, 0x7b, 0xd6, 0xac, 0x93, 0x7d, 0x99, 0xec, 0xdb, 0x4c, 0x92, 0x4b, 0xcf, 0xab, 0xaf,
-	0x0a, 0x40, 0x01, 0x24, 0x20, 0xf9, 0x24, 0x56, 0xd5, 0xef, 0xf7, 0xd5, 0xf6, 0xab, 0xef, 0xab,
-	0xaf, 0x20, 0x00, 0xc7, 0x9c, 0x38, 0x2b, 0xa3, 0xb1, 0xed, 0xd8, 0x24, 0x4b, 0x7f, 0x77, 0x3b,
-	0x4e, 0xa7, 0x7c, 0x1d, 0xd2, 0x1b, 0x76, 0xc3, 0x3a, 0x1a, 0x92, 0xab, 0x90, 0x38, 0xb4, 0xed,
-	0x92, 0xa4, 0xca, 0x95, 0x79, 0x6d, 0x6e, 0xc5, 0x45, 0xac, 0x34, 0x5b, 0x2d, 0x83, 0xb6, 0x94,
-	0xef, 0x40, 0x7e, 0xc3, 0xde, 0x33, 0x27, 0x4e, 0xb3, 0x6f, 0x0e, 0xba, 0x64, 0x11, 0x52, 0x4f,
-	0x3b, 0xfb, 0xe6, 0x00, 0x19, 0x39, 0x83, 0x15, 0x08, 0x81, 0xe4, 0xde, 0xc9, 0xc8, 0x2c, 0xc9,
-	0x58, 0x89, 0xbf, 0xcb, 0xbf, 0x72, 0x85, 0x76, 0x42, 0x99, 0xe4, 0x3a, 0x24, 0xbf, 0xdc, 0xb7,
-	0xba, 0xbc, 0x97, 0xd7, 0xfc, 0x5e, 0x58, 0xfb, 0xca, 0x97, 0x37, 0xb7, 0x1f, 0x1b, 0x08, 0xa1,
-	0xf6, 0xf7, 0x3a, 0xfb, 0x03, 0x6a, 0x4a, 0xa2, 0xf6, 0xb1, 0x40, 0x6b, 0x77, 0x3a, 0xe3, 0xce,
-	0xb0, 0x94, 0x50, 0xa5, 0x4a, 0xca, 0x60, 0x05, 0x72, 0x1f, 0xe6, 0x0c, 0xf3, 0xc5, 0x51, 0x7f,
-	0x6c, 0x76, 0x71, 0x70, 0xa5, 0xa4, 0x2a, 0x57, 0xf2, 0xd3, 0xf6, 0xb1, 0xd1, 0x08, 0x62, 0x19,
-	0x79, 0x64, 0x76, 0x1c, 0x97, 0x9c, 0x52, 0x13, 0xb1, 0x64, 0x01, 0x4b, 0xc9, 0xad, 0x91, 0xd3,
-	0xb7, 0xad, 0xce, 0x80, 0x91, 0xd3, 0xaa, 0x14, 0x43, 0x0e, 0x60, 0xc9, 0x9b, 0x50, 0x6c, 0xb6,
-	0x1f, 0xda, 0xf6, 0xa0, 0x3d, 0xe6, 0x23, 0x2a, 0x81, 0x2a, 0x57, 0xb2, 0xc6, 0x5c, 0x93, 0xd6,
-	0xba, 0xc3, 0x24, 0x15, 0x50, 0x9a, 0xed, 0x4d, 0xcb, 0xa9, 0x6a, 0x3e, 0x30, 0xaf, 0xca, 0x95,
-	0x94, 0x31, 0xdf, 0xc4, 0xea, 0x29, 0x64, 0x4d, 0xf7, 0x91, 0x05, 0x55, 0xae, 0x24, 0x18, 0xb2,
-	0xa6, 0x7b, 0xc8, 0x5b, 0x40, 0x9a, 0xed, 0x66, 0xff, 0xd8, 0xec, 0x8a, 0x56, 0xe7, 0x54, 0xb9,
-	0x92, 0x31, 0x94, 0x26, 0x6f, 0x98, 0x81, 0x16, 0x2d, 0xcf, 0xab, 0x72, 0x25, 0xed, 0xa2, 0x05,
-	0xdb, 0x37, 0x60, 0xa1, 0xd9, 0x7e, 0xb7, 0x1f, 0x1c, 0x70, 0x51, 0x95, 0x2b, 0x73, 0x46, 0xb1,
-	0xc9, 0xea, 0xa7, 0xb1, 0xa2, 0x61, 0x45, 0x95, 0x2b, 0x49, 0x8e, 0x15, 0xec, 0xe2, 0xec, 0x9a,
-	0x03, 0xbb, 0xe3, 0xf8, 0xd0, 0x05, 0x55, 0xae, 0xc8, 0xc6, 0x7c, 0x13, 0xab, 0x83, 0x56, 0x1f,
-	0xdb, 0x47, 0xfb, 0x03, 0xd3, 0x87, 0x12, 0x55, 0xae, 0x48, 0x46, 0xb1, 0xc9, 0xea, 0x83, 0xd8,
-	0x5d, 0x67, 0xdc, 0xb7, 0x7a, 0x3e, 0xf6, 0x3c, 0xea, 0xb7, 0xd8, 0x64, 0xf5, 0xc1, 0x11, 0x3c,
-	0x3c, 0x71, 0xcc, 0x89, 0x0f, 0x35, 0x55, 0xb9, 0x52, 0x30, 0xe6, 0x9b, 0x58, 0x1d, 0xb2, 0x1a,
-	0x5a, 0x83, 0x43, 0x55, 0xae, 0x2c, 0x50, 0xab, 0x33, 0xd6, 0x60, 0x37, 0xb4, 0x06, 0x3d, 0x55,
-	0xae, 0x10, 0x8e, 0x15, 0xd6, 0x40, 0xd4, 0x0c, 0x13, 0x62, 0x69, 0x51, 0x4d, 0x08, 0x9a, 0x61,
-	0x95, 0x41, 0xcd, 0x70, 0xe0, 0x6b, 0x6a, 0x42, 0xd4, 0x4c, 0x08, 0x89, 0x9d, 0x73, 0xe4, 0x05,
-	0x35, 0x21, 0x6a, 0x86, 0x23, 0x43, 0x9a, 0xe1, 0xd8, 0xd7, 0xd5, 0x44, 0x50, 0x33, 0x53, 0x68,
-	0xd1, 0x72, 0x49, 0x4d, 0x04, 0x35, 0xc3, 0xd1, 0x41, 0xcd, 0x70, 0xf0, 0x45, 0x35, 0x11, 0xd0,
-	0x4c, 0x18, 0x2b, 0x1a, 0x5e, 0x52, 0x13, 0x01, 0xcd, 0x88, 0xb3, 0x73, 0x35, 0xc3, 0xa1, 0xcb,
-	0x6a, 0x42, 0xd4, 0x8c, 0x68, 0xd5, 0xd3, 0x0c, 0x87, 0x5e, 0x52, 0x13, 0x01, 0xcd, 0x88, 0x58,
-	0x4f, 0x33, 0x1c, 0x7b, 0x59, 0x4d, 0x04, 0x34, 0xc3, 0xb1, 0xd7, 0x45, 0xcd, 0x70, 0xe8, 0xc7,
-	0x92, 0x9a, 0x10, 0x45, 0xc3, 0xa1, 0x37, 0x03, 0xa2, 0xe1, 0xd8, 0x4f, 0x28, 0x56, 0x54, 0x4d,
-	0x18, 0x2c, 0xae, 0xc2, 0xa7, 0x14, 0x2c, 0xca, 0x86, 0x83, 0x7d, 0xd9, 0xd8, 0xdc, 0x05, 0x95,
-	0xae, 0xa8, 0x92, 0x27, 0x1b, 0xd7, 0x2f, 0x89, 0xb2, 0xf1, 0x80, 0x57, 0xd1, 0xd5, 0x72, 0xd9,
-	0x4c, 0x21, 0x6b, 0xba, 0x8f, 0x54, 0x55, 0xc9, 0x97, 0x8d, 0x87, 0x0c, 0xc8, 0xc6, 0xc3, 0x5e,
-	0x53, 0x25, 0x51, 0x36, 0x33, 0xd0, 0xa2, 0xe5, 0xb2, 0x2a, 0x89, 0xb2, 0xf1, 0xd0, 0xa2, 0x6c,
-	0x3c, 0xf0, 0x17, 0x54, 0x49, 0x90, 0xcd, 0x34, 0x56, 0x34, 0xfc, 0x45, 0x55, 0x12, 0x64, 0x13,
-	0x9c, 0x1d, 0x93, 0x8d, 0x07, 0x7d, 0x43, 0x95, 0x7c, 0xd9, 0x04, 0xad, 0x72, 0xd9, 0x78, 0xd0,
-	0x37, 0x55, 0x49, 0x90, 0x4d, 0x10, 0xcb, 0x65, 0xe3, 0x61, 0xdf, 0xc2, 0xf8, 0xe6, 0xca, 0xc6,
-	0xc3, 0x0a, 0xb2, 0xf1, 0xa0, 0xbf, 0x43, 0x63, 0xa1, 0x27, 0x1b, 0x0f, 0x2a, 0xca, 0xc6, 0xc3,
-	0xfe, 0x2e, 0xc5, 0xfa, 0xb2, 0x99, 0x06, 0x8b, 0xab, 0xf0, 0x7b, 0x14, 0xec, 0xcb, 0xc6, 0x03,
-	0xaf, 0xe0, 0x20, 0xa8, 0x6c, 0xba, 0xe6, 0x61, 0xe7, 0x68, 0x40, 0x25, 0x56, 0xa1, 0xba, 0xa9,
-	0x27, 0x9d, 0xf1, 0x91, 0x49, 0x47, 0x62, 0xdb, 0x83, 0xc7, 0x6e, 0x1b, 0x59, 0xa1, 0xc6, 0x99,
-	0x7c, 0x7c, 0xc2, 0x75, 0xaa, 0x9f, 0xba, 0x5c, 0xd5, 0x8c, 0x22, 0xd3, 0xd0, 0x34, 0xbe, 0xa6,
-	0x0b, 0xf8, 0x1b, 0x54, 0x45, 0x75, 0xb9, 0xa6, 0x33, 0x7c, 0x4d, 0xf7, 0xf1, 0x55, 0x38, 0xef,
-	0x4b, 0xc9, 0x67, 0xdc, 0xa4, 0x5a, 0xaa, 0x27, 0xaa, 0xda, 0xaa, 0xb1, 0xe0, 0x0a, 0x6a, 0x16,
-	0x29, 0xd0, 0xcd, 0x2d, 0x2a, 0xa9, 0x7a, 0xa2, 0xa6, 0x7b, 0x24, 0xb1, 0x27, 0x8d, 0xca, 0x90,
-	0x0b, 0xcb, 0xe7, 0xdc, 0xa6, 0xca, 0xaa, 0x27, 0xab, 0xda, 0xea, 0xaa, 0xa1, 0x70, 0x7d, 0xcd,
-	0xe0, 0x04, 0xfa, 0x59, 0xa1, 0x0a, 0xab, 0x27, 0x6b, 0xba, 0xc7, 0x09, 0xf6, 0xb3, 0xe0, 0x0a,
-	0xcd, 0xa7, 0x7c, 0x89, 0x2a, 0xad, 0x9e, 0xae, 0xae, 0xe9, 0x6b, 0xeb, 0xf7, 0x8c, 0x22, 0x53,
-	0x9c, 0xcf, 0xd1, 0x69, 0x3f, 0x5c, 0x72, 0x3e, 0x69, 0x95, 0x6a, 0xae, 0x9e, 0xd6, 0xee, 0xac,
-	0xdd, 0xd5, 0xee, 0x1a, 0x0a, 0xd7, 0x9e, 0xcf, 0x7a, 0x87, 0xb2, 0xb8, 0xf8, 0x7c, 0xd6, 0x1a,
-	0x55, 0x5f, 0x5d, 0x79, 0x66, 0x0e, 0x06, 0xf6, 0x2d, 0xb5, 0xfc, 0xd2, 0x1e, 0x0f, 0xba, 0xd7,
-	0xca, 0x60, 0x28, 0x5c, 0x8f, 0x62, 0xaf, 0x0b, 0xae, 0x20, 0x7d, 0xfa, 0xaf, 0xd1, 0x7b, 0x58,
-	0xa1, 0x9e, 0x79, 0xd8, 0xef, 0x59, 0xf6, 0xc4, 0x34, 0x8a, 0x4c, 0x9a, 0xa1, 0x35, 0xd9, 0x0d,
-	0xaf, 0xe3, 0xaf, 0x53, 0xda, 0x42, 0x3d, 0x71, 0xbb, 0xaa, 0xd1, 0x9e, 0x66, 0xad, 0xe3, 0x6e,
-	0x78, 0x1d, 0x7f, 0x83, 0x72, 0x48, 0x3d, 0x71, 0xbb, 0xa6, 0x73, 0x8e, 0xb8, 0x8e, 0x77, 0xe0,
-	0x42, 0x28, 0x2e, 0xb6, 0x47, 0x9d, 0x83, 0xe7, 0x66, 0xb7, 0xa4, 0xd1, 0xf0, 0xf8, 0x50, 0x56,
-	0x24, 0xe3, 0x7c, 0x20, 0x44, 0xee, 0x60, 0x33, 0xb9, 0x07, 0xaf, 0x87, 0x03, 0xa5, 0xcb, 0xac,
-	0xd2, 0x78, 0x89, 0xcc, 0xc5, 0x60, 0xcc, 0x0c, 0x51, 0x05, 0x07, 0xec, 0x52, 0x75, 0x1a, 0x40,
-	0x7d, 0xaa, 0xef, 0x89, 0x39, 0xf5, 0x67, 0xe0, 0xe2, 0x74, 0x28, 0x75, 0xc9, 0xeb, 0x34, 0xa2,
-	0x22, 0xf9, 0x42, 0x38, 0xaa, 0x4e, 0xd1, 0x67, 0xf4, 0x5d, 0xa3, 0x21, 0x56, 0xa4, 0x4f, 0xf5,
-	0x7e, 0x1f, 0x4a, 0x53, 0xc1, 0xd6, 0x65, 0xdf, 0xa1, 0x31, 0x17, 0xd9, 0xaf, 0x85, 0xe2, 0x6e,
-	0x98, 0x3c, 0xa3, 0xeb, 0xbb, 0x34, 0x08, 0x0b, 0xe4, 0xa9, 0x9e, 0x71, 0xc9, 0x82, 0xe1, 0xd8,
-	0xe5, 0xde, 0xa3, 0x51, 0x99, 0x2f, 0x59, 0x20, 0x32, 0x8b, 0xfd, 0x86, 0xe2, 0xb3, 0xcb, 0xad,
-	0xd3, 0x30, 0xcd, 0xfb, 0x0d, 0x86, 0x6a, 0x4e, 0x7e, 0x9b, 0x92, 0x77, 0x67, 0xcf, 0xf8, 0xc7,
-	0x09, 0x1a, 0x60, 0x39, 0x7b, 0x77, 0xd6, 0x94, 0x3d, 0xf6, 0x8c, 0x29, 0xff, 0x84, 0xb2, 0x89,
-	0xc0, 0x9e, 0x9a, 0xf3, 0x63, 0x98, 0x73, 0x6f, 0x75, 0xbd, 0xb1, 0x7d, 0x34, 0x2a, 0x35, 0x55,
-	0xb9, 0x02, 0xda, 0x95, 0xa9, 0xec, 0xc7, 0xbd, 0xe4, 0x6d, 0x50, 0x94, 0x11, 0x24, 0x31, 0x2b,
-	0xcc, 0x2e, 0xb3, 0xb2, 0xa3, 0x26, 0x22, 0xac, 0x30, 0x94, 0x67, 0x45, 0x20, 0x51, 0x2b, 0xae,
-	0xd3, 0x67, 0x56, 0x3e, 0x50, 0xa5, 0x99, 0x56, 0xdc, 0x10, 0xc0, 0xad, 0x04, 0x48, 0x4b, 0xeb,
-	0x7e, 0xbe, 0x85, 0xed, 0xe4, 0x8b, 0xe1, 0x04, 0x6c, 0x03, 0xef, 0xcf, 0xc1, 0x4a, 0x46, 0x13,
-	0x06, 0x37, 0x4d, 0xfb, 0xd9, 0x08, 0x5a, 0x60, 0x34, 0xd3, 0xb4, 0x9f, 0x9b, 0x41, 0x2b, 0xff,
-	0xa6, 0x04, 0x49, 0x9a, 0x4f, 0x92, 0x2c, 0x24, 0xdf, 0x6b, 0x6d, 0x3e, 0x56, 0xce, 0xd1, 0x5f,
-	0x0f, 0x5b, 0xad, 0xa7, 0x8a, 0x44, 0x72, 0x90, 0x7a, 0xf8, 0x95, 0xbd, 0xc6, 0xae, 0x22, 0x93,
-	0x22, 0xe4, 0x9b, 0x9b, 0xdb, 0x1b, 0x0d, 0x63, 0xc7, 0xd8, 0xdc, 0xde, 0x53, 0x12, 0xb4, 0xad,
-	0xf9, 0xb4, 0xf5, 0x60, 0x4f, 0x49, 0x92, 0x0c, 0x24, 0x68, 0x5d, 0x8a, 0x00, 0xa4, 0x77, 0xf7,
-	0x8c, 0xcd, 0xed, 0x0d, 0x25, 0x4d, 0xad, 0xec, 0x6d, 0x6e, 0x35, 0x94, 0x0c, 0x45, 0xee, 0xbd,
-	0xbb, 0xf3, 0xb4, 0xa1, 0x64, 0xe9, 0xcf, 0x07, 0x86, 0xf1, 0xe0, 0x2b, 0x4a, 0x8e, 0x92, 0xb6,
-	0x1e, 0xec, 0x28, 0x80, 0xcd, 0x0f, 0x1e, 0x3e, 0x6d, 0x28, 0x79, 0x52, 0x80, 0x6c, 0xf3, 0xdd,
-	0xed, 0x47, 0x7b, 0x9b, 0xad, 0x6d, 0xa5, 0x50, 0x3e, 0x81, 0x12, 0x5b, 0xe6, 0xc0, 0x2a, 0xb2,
-	0xa4, 0xf0, 0x1d, 0x48, 0xb1, 0x9d, 0x91, 0x50, 0x25, 0x95, 0xf0, 0xce, 0x4c, 0x53, 0x56, 0xd8,
-	0x1e, 0x31, 0xda, 0xd2, 0x65, 0x48, 0xb1, 0x55, 0x5a, 0x84, 0x14, 0x5b, 0x1d, 0x19, 0x53, 0x45,
-	0x56, 0x28, 0xff, 0x96, 0x0c, 0xb0, 0x61, 0xef, 0x3e, 0xef, 0x8f, 0x30, 0x21, 0xbf, 0x0c, 0x30,
-	0x79, 0xde, 0x1f, 0xb5, 0x51, 0xf5, 0x3c, 0xa9, 0xcc, 0xd1, 0x1a, 0xf4, 0x77, 0xe4, 0x1a, 0x14,
-	0xb0, 0xf9, 0x90, 0x79, 0x21, 0xcc, 0x25, 0x33, 0x46, 0x9e, 0xd6, 0x71, 0xc7, 0x14, 0x84, 0xd4,
-	0x74, 0x4c, 0x21, 0xd3, 0x02, 0xa4, 0xa6, 0x93, 0xab, 0x80, 0xc5, 0xf6, 0x04, 0x23, 0x0a, 0xa6,
-	0x8d, 0x39, 0x03, 0xfb, 0x65, 0x31, 0x86, 0xbc, 0x0d, 0xd8, 0x27, 0x9b, 0x77, 0x71, 0xfa, 0x74,
-	0xb8, 0xc3, 0x5d, 0xa1, 0x3f, 0xd8, 0x6c, 0x7d, 0xc2, 0x52, 0x0b, 0x72, 0x5e, 0x3d, 0xed, 0x0b,
-	0x6b, 0xf9, 0x8c, 0x14, 0x9c, 0x11, 0x60, 0x95, 0x37, 0x25, 0x06, 0xe0, 0xa3, 0x59, 0xc0, 0xd1,
-	0x30, 0x12, 0x1b, 0x4e, 0xf9, 0x32, 0xcc, 0x6d, 0xdb, 0x16, 0x3b, 0xbd, 0xb8, 0x4a, 0x05, 0x90,
-	0x3a, 0x25, 0x09, 0xb3, 0x27, 0xa9, 0x53, 0xbe, 0x02, 0x20, 0xb4, 0x29, 0x20, 0xed, 0xb3, 0x36,
-	0xf4, 0x01, 0xd2, 0x7e, 0xf9, 0x26, 0xa4, 0xb7, 0x3a, 0xc7, 0x7b, 0x9d, 0x1e, 0xb9, 0x06, 0x30,
-	0xe8, 0x4c, 0x9c, 0xf6, 0x21, 0xee, 0xc3, 0xe7, 0x9f, 0x7f, 0xfe, 0xb9, 0x84, 0x97, 0xbd, 0x1c,
-	0xad, 0x65, 0xfb, 0xf1, 0x02, 0xa0, 0x35, 0xe8, 0x6e, 0x99, 0x93, 0x49, 0xa7, 0x67, 0x92, 0x2a,
-	0xa4, 0x2d, 0x73, 0x42, 0xa3, 0x9d, 0x84, 0xef, 0x08, 0xcb, 0xfe, 0x2a, 0xf8, 0xa8, 0x95, 0x6d,
-	0x84, 0x18, 0x1c, 0x4a, 0x14, 0x48, 0x58, 0x47, 0x43, 0x7c, 0x27, 0x49, 0x19, 0xf4, 0xe7, 0xd2,
-	0x25, 0x48, 0x33, 0x0c, 0x21, 0x90, 0xb4, 0x3a, 0x43, 0xb3, 0xc4, 0xfa, 0xc5, 0xdf, 0xe5, 0x5f,
-	0x95, 0x00, 0xb6, 0xcd, 0x97, 0x67, 0xe8, 0xd3, 0x47, 0xc5, 0xf4, 0x99, 0x60, 0x7d, 0xde, 0x8f,
-	0xeb, 0x93, 0xea, 0xec, 0xd0, 0xb6, 0xbb, 0x6d, 0xb6, 0xc5, 0xec, 0x49, 0x27, 0x47, 0x6b, 0x70,
-	0xd7, 0xca, 0x1f, 0x40, 0x61, 0xd3, 0xb2, 0xcc, 0xb1, 0x3b, 0x26, 0x02, 0xc9, 0x67, 0xf6, 0xc4,
-	0xe1, 0x6f, 0x4b, 0xf8, 0x9b, 0x94, 0x20, 0x39, 0xb2, 0xc7, 0x0e, 0x9b, 0x67, 0x3d, 0xa9, 0xaf,
-	0xae, 0xae, 0x1a, 0x58, 0x43, 0x2e, 0x41, 0xee, 0xc0, 0xb6, 0x2c, 0xf3, 0x80, 0x4e, 0x22, 0x81,
-	0x69, 0x8d, 0x5f, 0x51, 0xfe, 0x65, 0x09, 0x0a, 0x2d, 0xe7, 0x99, 0x6f, 0x5c, 0x81, 0xc4, 0x73,
-	0xf3, 0x04, 0x87, 0x97, 0x30, 0xe8, 0x4f, 0x7a, 0x54, 0x7e, 0xbe, 0x33, 0x38, 0x62, 0x6f, 0x4d,
-	0x05, 0x83, 0x15, 0xc8, 0x05, 0x48, 0xbf, 0x34, 0xfb, 0xbd, 0x67, 0x0e, 0xda, 0x94, 0x0d, 0x5e,
-	0x22, 0xb7, 0x20, 0xd5, 0xa7, 0x83, 0x2d, 0x25, 0x71, 0xbd, 0x2e, 0xf8, 0xeb, 0x25, 0xce, 0xc1,
-	0x60, 0xa0, 0x1b, 0xd9, 0x6c, 0x57, 0xf9, 0xe8, 0xa3, 0x8f, 0x3e, 0x92, 0xcb, 0x87, 0xb0, 0xe8,
-	0x1e, 0xde, 0xc0, 0x64, 0xb7, 0xa1, 0x34, 0x30, 0xed, 0xf6, 0x61, 0xdf, 0xea, 0x0c, 0x06, 0x27,
-	0xed, 0x97, 0xb6, 0xd5, 0xee, 0x58, 0x6d, 0x7b, 0x72, 0xd0, 0x19, 0xe3, 0x02, 0x44, 0x77, 0xb1,
-	0x38, 0x30, 0xed, 0x26, 0xa3, 0xbd, 0x6f, 0x5b, 0x0f, 0xac, 0x16, 0xe5, 0x94, 0xff, 0x20, 0x09,
-	0xb9, 0xad, 0x13, 0xd7, 0xfa, 0x22, 0xa4, 0x0e, 0xec, 0x23, 0x8b, 0xad, 0x65, 0xca, 0x60, 0x05,
-	0x6f, 0x8f, 0x64, 0x61, 0x8f, 0x16, 0x21, 0xf5, 0xe2, 0xc8, 0x76, 0x4c, 0x9c, 0x6e, 0xce, 0x60,
-	0x05, 0xba, 0x5a, 0x23, 0xd3, 0x29, 0x25, 0x31, 0xb9, 0xa5, 0x3f, 0xfd, 0xf9, 0xa7, 0xce, 0x30,
-	0x7f, 0xb2, 0x02, 0x69, 0x9b, 0xae, 0xfe, 0xa4, 0x94, 0xc6, 0x77, 0x35, 0x01, 0x2e, 0xee, 0x8a,
-	0xc1, 0x51, 0x64, 0x13, 0x16, 0x5e, 0x9a, 0xed, 0xe1, 0xd1, 0xc4, 0x69, 0xf7, 0xec, 0x76, 0xd7,
-	0x34, 0x47, 0xe6, 0xb8, 0x34, 0x87, 0x3d, 0x09, 0x3e, 0x61, 0xd6, 0x42, 0x1a, 0xf3, 0x2f, 0xcd,
-	0xad, 0xa3, 0x89, 0xb3, 0x61, 0x3f, 0x46, 0x16, 0xa9, 0x42, 0x6e, 0x6c, 0x52, 0x4f, 0x40, 0x07,
-	0x5b, 0x08, 0xf7, 0x1e, 0xa0, 0x66, 0xc7, 0xe6, 0x08, 0x2b, 0xc8, 0x3a, 0x64, 0xf7, 0xfb, 0xcf,
-	0xcd, 0xc9, 0x33, 0xb3, 0x5b, 0xca, 0xa8, 0x52, 0x65, 0x5e, 0xbb, 0xe8, 0x73, 0xbc, 0x65, 0x5d,
-	0x79, 0x64, 0x0f, 0xec, 0xb1, 0xe1, 0x41, 0xc9, 0x7d, 0xc8, 0x4d, 0xec, 0xa1, 0xc9, 0xf4, 0x9d,
-	0xc5, 0xa0, 0x7a, 0x79, 0x16, 0x6f, 0xd7, 0x1e, 0x9a, 0xae, 0x07, 0x73, 0xf1, 0x64, 0x99, 0x0d,
-	0x74, 0x9f, 0x5e, 0x9d, 0x4b, 0x80, 0x4f, 0x03, 0x74, 0x40, 0x78, 0x95, 0x26, 0x4b, 0x74, 0x40,
-	0xbd, 0x43, 0x7a, 0x23, 0x2a, 0xe5, 0x31, 0xaf, 0xf4, 0xca, 0x4b, 0xb7, 0x20, 0xe7, 0x19, 0xf4,
-	0x5d, 0x1f, 0x73, 0x37, 0x39, 0xf4, 0x07, 0xcc, 0xf5, 0x31, 0x5f, 0xf3, 0x06, 0xa4, 0x70, 0xd8,
-	0x34, 0x42, 0x19, 0x0d, 0x1a, 0x10, 0x73, 0x90, 0xda, 0x30, 0x1a, 0x8d, 0x6d, 0x45, 0xc2, 0xd8,
-	0xf8, 0xf4, 0xdd, 0x86, 0x22, 0x0b, 0x8a, 0xfd, 0x6d, 0x09, 0x12, 0x8d, 0x63, 0x54, 0x0b, 0x9d,
-	0x86, 0x7b, 0xa2, 0xe9, 0x6f, 0xad, 0x06, 0xc9, 0xa1, 0x3d, 0x36, 0xc9, 0xf9, 0x19, 0xb3, 0x2c,
-	0xf5, 0x70, 0xbf, 0x84, 0x57, 0xe4, 0xc6, 0xb1, 0x63, 0x20, 0x5e, 0x7b, 0x0b, 0x92, 0x8e, 0x79,
-	0xec, 0xcc, 0xe6, 0x3d, 0x63, 0x1d, 0x50, 0x80, 0x76, 0x13, 0xd2, 0xd6, 0xd1, 0x70, 0xdf, 0x1c,
-	0xcf, 0x86, 0xf6, 0x71, 0x7a, 0x1c, 0x52, 0x7e, 0x0f, 0x94, 0x47, 0xf6, 0x70, 0x34, 0x30, 0x8f,
-	0x1b, 0xc7, 0x8e, 0x69, 0x4d, 0xfa, 0xb6, 0x45, 0xf5, 0x7c, 0xd8, 0x1f, 0xa3, 0x17, 0xc1, 0xb7,
-	0x62, 0x2c, 0xd0, 0x53, 0x3d, 0x31, 0x0f, 0x6c, 0xab, 0xcb, 0x1d, 0x26, 0x2f, 0x51, 0xb4, 0xf3,
-	0xac, 0x3f, 0xa6, 0x0e, 0x84, 0xfa, 0x79, 0x56, 0x28, 0x6f, 0x40, 0x91, 0xe7, 0x18, 0x13, 0xde,
-	0x71, 0xf9, 0x06, 0x14, 0xdc, 0x2a, 0x7c, 0x38, 0xcf, 0x42, 0xf2, 0x83, 0x86, 0xd1, 0x52, 0xce,
-	0xd1, 0x65, 0x6d, 0x6d, 0x37, 0x14, 0x89, 0xfe, 0xd8, 0x7b, 0xbf, 0x15, 0x58, 0xca, 0x4b, 0x50,
-	0xf0, 0xc6, 0xbe, 0x6b, 0x3a, 0xd8, 0x42, 0x03, 0x42, 0xa6, 0x2e, 0x67, 0xa5, 0x72, 0x06, 0x52,
-	0x8d, 0xe1, 0xc8, 0x39, 0x29, 0xff, 0x22, 0xe4, 0x39, 0xe8, 0x69, 0x7f, 0xe2, 0x90, 0x3b, 0x90,
-	0x19, 0xf2, 0xf9, 0x4a, 0x78, 0xdd, 0x13, 0x35, 0xe5, 0xe3, 0xdc, 0xdf, 0x86, 0x8b, 0x5e, 0xaa,
-	0x42, 0x46, 0xf0, 0xa5, 0xfc, 0xa8, 0xcb, 0xe2, 0x51, 0x67, 0x4e, 0x21, 0x21, 0x38, 0x85, 0xf2,
-	0x16, 0x64, 0x58, 0x04, 0x9c, 0x60, 0x54, 0x67, 0xa9, 0x22, 0x13, 0x13, 0xdb, 0xf9, 0x3c, 0xab,
-	0x63, 0x17, 0x95, 0xab, 0x90, 0x47, 0xc1, 0x72, 0x04, 0x73, 0x9d, 0x80, 0x55, 0x4c, 0x6e, 0xbf,
-	0x9f, 0x82, 0xac, 0xbb, 0x52, 0x64, 0x19, 0xd2, 0x2c, 0x3f, 0x43, 0x53, 0xee, 0xfb, 0x41, 0x0a,
-	0x33, 0x32, 0xb2, 0x0c, 0x19, 0x9e, 0x83, 0x71, 0xef, 0x2e, 0x57, 0x35, 0x23, 0xcd, 0x72, 0x2e,
-	0xaf, 0xb1, 0xa6, 0xa3, 0x63, 0x62, 0x2f, 0x03, 0x69, 0x96, 0x55, 0x11, 0x15, 0x72, 0x5e, 0x1e,
-	0x85, 0xfe, 0x98, 0x3f, 0x03, 0x64, 0xdd, 0xc4, 0x49, 0x40, 0xd4, 0x74, 0xf4, 0x58, 0x3c, 0xe7,
-	0xcf, 0x36, 0xfd, 0xeb, 0x49, 0xd6, 0xcd, 0x86, 0xf0, 0xf9, 0xde, 0x4d, 0xf0, 0x33, 0x3c, 0xff,
-	0xf1, 0x01, 0x35, 0x1d, 0x5d, 0x82, 0x9b, 0xcd, 0x67, 0x78, 0x8e, 0x43, 0xae, 0xd2, 0x21, 0x62,
-	0xce, 0x82, 0x47, 0xdf, 0x4f, 0xdd, 0xd3, 0x2c, 0x93, 0x21, 0xd7, 0xa8, 0x05, 0x96, 0x98, 0xe0,
-	0xb9, 0xf4, 0xf3, 0xf4, 0x0c, 0xcf, 0x57, 0xc8, 0x4d, 0x0a, 0x61, 0xcb, 0x5f, 0x82, 0x88, 0xa4,
-	0x3c, 0xc3, 0x93, 0x72, 0xa2, 0xd2, 0x0e, 0xd1, 0x3d, 0xa0, 0x4b, 0x10, 0x12, 0xf0, 0x34, 0x4b,
-	0xc0, 0xc9, 0x15, 0x34, 0xc7, 0x26, 0x55, 0xf0, 0x93, 0xed, 0x0c, 0x4f, 0x70, 0xfc, 0x76, 0xbc,
-	0xb2, 0x79, 0x89, 0x75, 0x86, 0xa7, 0x30, 0xa4, 0x46, 0xf7, 0x8b, 0xea, 0xbb, 0x34, 0x8f, 0x4e,
-	0xb0, 0xe4, 0x0b, 0xcf, 0xdd, 0x53, 0xe6, 0x03, 0xeb, 0xcc, 0x83, 0x18, 0xa9, 0x26, 0x9e, 0x86,
-	0x25, 0xca, 0xdb, 0xe9, 0x5b, 0x87, 0xa5, 0x22, 0xae, 0x44, 0xa2, 0x6f, 0x1d, 0x1a, 0xa9, 0x26,
-	0xad, 0x61, 0x1a, 0xd8, 0xa6, 0x6d, 0x0a, 0xb6, 0x25, 0x6f, 0xb3, 0x46, 0x5a, 0x45, 0x4a, 0x90,
-	0x6a, 0xb6, 0xb7, 0x3b, 0x56, 0x69, 0x81, 0xf1, 0xac, 0x8e, 0x65, 0x24, 0x9b, 0xdb, 0x1d, 0x8b,
-	0xbc, 0x05, 0x89, 0xc9, 0xd1, 0x7e, 0x89, 0x84, 0xbf, 0xac, 0xec, 0x1e, 0xed, 0xbb, 0x43, 0x31,
-	0x28, 0x82, 0x2c, 0x43, 0x76, 0xe2, 0x8c, 0xdb, 0xbf, 0x60, 0x8e, 0xed, 0xd2, 0x79, 0x5c, 0xc2,
-	0x73, 0x46, 0x66, 0xe2, 0x8c, 0x3f, 0x30, 0xc7, 0xf6, 0x19, 0x9d, 0x5f, 0xf9, 0x0a, 0xe4, 0x05,
-	0xbb, 0xa4, 0x08, 0x92, 0xc5, 0x6e, 0x0a, 0x75, 0xe9, 0x8e, 0x21, 0x59, 0xe5, 0x3d, 0x28, 0xb8,
-	0x39, 0x0c, 0xce, 0x57, 0xa3, 0x27, 0x69, 0x60, 0x8f, 0xf1, 0x7c, 0xce, 0x6b, 0x97, 0xc4, 0x10,
-	0xe5, 0xc3, 0x78, 0xb8, 0x60, 0xd0, 0xb2, 0x12, 0x1a, 0x8a, 0x54, 0xfe, 0xa1, 0x04, 0x85, 0x2d,
-	0x7b, 0xec, 0x3f, 0x30, 0x2f, 0x42, 0x6a, 0xdf, 0xb6, 0x07, 0x13, 0x34, 0x9b, 0x35, 0x58, 0x81,
-	0xbc, 0x01, 0x05, 0xfc, 0xe1, 0xe6, 0x9e, 0xb2, 0xf7, 0xb4, 0x91, 0xc7, 0x7a, 0x9e, 0x70, 0x12,
-	0x48, 0xf6, 0x2d, 0x67, 0xc2, 0x3d, 0x19, 0xfe, 0x26, 0x5f, 0x80, 0x3c, 0xfd, 0xeb, 0x32, 0x93,
-	0xde, 0x85, 0x15, 0x68, 0x35, 0x27, 0xbe, 0x05, 0x73, 0xb8, 0xfb, 0x1e, 0x2c, 0xe3, 0x3d, 0x63,
-	0x14, 0x58, 0x03, 0x07, 0x96, 0x20, 0xc3, 0x5c, 0xc1, 0x04, 0xbf, 0x96, 0xe5, 0x0c, 0xb7, 0x48,
-	0xdd, 0x2b, 0x66, 0x02, 0x2c, 0xdc, 0x67, 0x0c, 0x5e, 0x2a, 0x3f, 0x80, 0x2c, 0x46, 0xa9, 0xd6,
-	0xa0, 0x4b, 0xca, 0x20, 0xf5, 0x4a, 0x26, 0xc6, 0xc8, 0x45, 0xe1, 0x9a, 0xcf, 0x9b, 0x57, 0x36,
-	0x0c, 0xa9, 0xb7, 0xb4, 0x00, 0xd2, 0x06, 0xbd, 0x77, 0x1f, 0x73, 0x37, 0x2d, 0x1d, 0x97, 0x5b,
-	0xdc, 0xc4, 0xb6, 0xf9, 0x32, 0xce, 0xc4, 0xb6, 0xf9, 0x92, 0x99, 0xb8, 0x3a, 0x65, 0x82, 0x96,
-	0x4e, 0xf8, 0xa7, 0x43, 0xe9, 0xa4, 0x5c, 0x85, 0x39, 0x3c, 0x9e, 0x7d, 0xab, 0xb7, 0x63, 0xf7,
-	0x2d, 0xbc, 0xe7, 0x1f, 0xe2, 0x3d, 0x49, 0x32, 0xa4, 0x43, 0xba, 0x07, 0xe6, 0x71, 0xe7, 0x80,
-	0xdd, 0x38, 0xb3, 0x06, 0x2b, 0x94, 0x3f, 0x4b, 0xc2, 0x3c, 0x77, 0xad, 0xef, 0xf7, 0x9d, 0x67,
-	0x5b, 0x9d, 0x11, 0x79, 0x0a, 0x05, 0xea, 0x55, 0xdb, 0xc3, 0xce, 0x68, 0x44, 0x8f, 0xaf, 0x84,
-	0x57, 0x8d, 0xeb, 0x53, 0xae, 0x9a, 0xe3, 0x57, 0xb6, 0x3b, 0x43, 0x73, 0x8b, 0x61, 0x1b, 0x96,
-	0x33, 0x3e, 0x31, 0xf2, 0x96, 0x5f, 0x43, 0x36, 0x21, 0x3f, 0x9c, 0xf4, 0x3c, 0x63, 0x32, 0x1a,
-	0xab, 0x44, 0x1a, 0xdb, 0x9a, 0xf4, 0x02, 0xb6, 0x60, 0xe8, 0x55, 0xd0, 0x81, 0x51, 0x7f, 0xec,
-	0xd9, 0x4a, 0x9c, 0x32, 0x30, 0xea, 0x3a, 0x82, 0x03, 0xdb, 0xf7, 0x6b, 0xc8, 0x63, 0x00, 0x7a,
-	0xbc, 0x1c, 0x9b, 0xa6, 0x4e, 0xa8, 0xa0, 0xbc, 0xf6, 0x66, 0xa4, 0xad, 0x5d, 0x67, 0xbc, 0x67,
-	0xef, 0x3a, 0x63, 0x66, 0x88, 0x1e, 0x4c, 0x2c, 0x2e, 0xbd, 0x03, 0x4a, 0x78, 0xfe, 0xe2, 0x8d,
-	0x3c, 0x35, 0xe3, 0x46, 0x9e, 0xe3, 0x37, 0xf2, 0xba, 0x7c, 0x57, 0x5a, 0x7a, 0x0f, 0x8a, 0xa1,
-	0x29, 0x8b, 0x74, 0xc2, 0xe8, 0xb7, 0x45, 0x7a, 0x5e, 0x7b, 0x5d, 0xf8, 0x9c, 0x2d, 0x6e, 0xb8,
-	0x68, 0xf7, 0x1d, 0x50, 0xc2, 0xd3, 0x17, 0x0d, 0x67, 0x63, 0x32, 0x05, 0xe4, 0xdf, 0x87, 0xb9,
-	0xc0, 0x94, 0x45, 0x72, 0xee, 0x94, 0x49, 0x95, 0x7f, 0x29, 0x05, 0xa9, 0x96, 0x65, 0xda, 0x87,
-	0xe4, 0xf5, 0x60, 0x9c, 0x7c, 0x72, 0xce, 0x8d, 0x91, 0x17, 0x43, 0x31, 0xf2, 0xc9, 0x39, 0x2f,
-	0x42, 0x5e, 0x0c, 0x45, 0x48, 0xb7, 0xa9, 0xa6, 0x93, 0xcb, 0x53, 0xf1, 0xf1, 0xc9, 0x39, 0x21,
-	0x38, 0x5e, 0x9e, 0x0a, 0x8e, 0x7e, 0x73, 0x4d, 0xa7, 0x0e, 0x35, 0x18, 0x19, 0x9f, 0x9c, 0xf3,
-	0xa3, 0xe2, 0x72, 0x38, 0x2a, 0x7a, 0x8d, 0x35, 0x9d, 0x0d, 0x49, 0x88, 0x88, 0x38, 0x24, 0x16,
-	0x0b, 0x97, 0xc3, 0xb1, 0x10, 0x79, 0x3c, 0x0a, 0x2e, 0x87, 0xa3, 0x20, 0x36, 0xf2, 0xa8, 0x77,
-	0x31, 0x14, 0xf5, 0xd0, 0x28, 0x0b, 0x77, 0xcb, 0xe1, 0x70, 0xc7, 0x78, 0xc2, 0x48, 0xc5, 0x58,
-	0xe7, 0x35, 0xd6, 0x74, 0xa2, 0x85, 0x02, 0x5d, 0xf4, 0x6d, 0x1f, 0xf7, 0x02, 0x9d, 0xbe, 0x4e,
-	0x97, 0xcd, 0xbd, 0x88, 0x16, 0x63, 0xbe, 0xf8, 0xe3, 0x6a, 0xba, 0x17, 0x31, 0x0d, 0x32, 0x87,
-	0x3c, 0x01, 0x56, 0xd0, 0x73, 0x09, 0xb2, 0xc4, 0xcd, 0x5f, 0x69, 0xb6, 0xd1, 0x83, 0xd1, 0x79,
-	0x1d, 0xb2, 0x3b, 0x7d, 0x05, 0xe6, 0x9a, 0xed, 0xa7, 0x9d, 0x71, 0xcf, 0x9c, 0x38, 0xed, 0xbd,
-	0x4e, 0xcf, 0x7b, 0x44, 0xa0, 0xfb, 0x9f, 0x6f, 0xf2, 0x96, 0xbd, 0x4e, 0x8f, 0x5c, 0x70, 0xc5,
-	0xd5, 0xc5, 0x56, 0x89, 0xcb, 0x6b, 0xe9, 0x75, 0xba, 0x68, 0xcc, 0x18, 0xfa, 0xc2, 0x05, 0xee,
-	0x0b, 0x1f, 0x66, 0x20, 0x75, 0x64, 0xf5, 0x6d, 0xeb, 0x61, 0x0e, 0x32, 0x8e, 0x3d, 0x1e, 0x76,
-	0x1c, 0xbb, 0xfc, 0x23, 0x09, 0xe0, 0x91, 0x3d, 0x1c, 0x1e, 0x59, 0xfd, 0x17, 0x47, 0x26, 0xb9,
-	0x02, 0xf9, 0x61, 0xe7, 0xb9, 0xd9, 0x1e, 0x9a, 0xed, 0x83, 0xb1, 0x7b, 0x0e, 0x72, 0xb4, 0x6a,
-	0xcb, 0x7c, 0x34, 0x3e, 0x21, 0x25, 0xf7, 0x8a, 0x8e, 0xda, 0x41, 0x49, 0xf2, 0x2b, 0xfb, 0x22,
-	0xbf, 0x74, 0xa6, 0xf9, 0x1e, 0xba, 0xd7, 0x4e, 0x96, 0x47, 0x64, 0xf8, 0xee, 0x61, 0x89, 0x4a,
-	0xde, 0x31, 0x87, 0xa3, 0xf6, 0x01, 0x4a, 0x85, 0xca, 0x21, 0x45, 0xcb, 0x8f, 0xc8, 0x6d, 0x48,
-	0x1c, 0xd8, 0x03, 0x14, 0xc9, 0x29, 0xfb, 0x42, 0x71, 0xe4, 0x0d, 0x48, 0x0c, 0x27, 0x4c, 0x36,
-	0x79, 0x6d, 0x41, 0xb8, 0x27, 0xb0, 0xd0, 0x44, 0x61, 0xc3, 0x49, 0xcf, 0x9b, 0xf7, 0x8d, 0x22,
-	0x24, 0x9a, 0xad, 0x16, 0x8d, 0xfd, 0xcd, 0x56, 0x6b, 0x4d, 0x91, 0xea, 0x5f, 0x82, 0x6c, 0x6f,
-	0x6c, 0x9a, 0xd4, 0x3d, 0xcc, 0xce, 0x39, 0x3e, 0xc4, 0x58, 0xe7, 0x81, 0xea, 0x5b, 0x90, 0x39,
-	0x60, 0x59, 0x07, 0x89, 0x48, 0x6b, 0x4b, 0x7f, 0xc8, 0x1e, 0x55, 0x96, 0xfc, 0xe6, 0x70, 0x9e,
-	0x62, 0xb8, 0x36, 0xea, 0x3b, 0x90, 0x1b, 0xb7, 0x4f, 0x33, 0xf8, 0x31, 0x8b, 0x2e, 0x71, 0x06,
-	0xb3, 0x63, 0x5e, 0x55, 0x6f, 0xc0, 0x82, 0x65, 0xbb, 0xdf, 0x50, 0xda, 0x5d, 0x76, 0xc6, 0x2e,
-	0x4e, 0x5f, 0xe5, 0x5c, 0xe3, 0x26, 0xfb, 0x6e, 0x69, 0xd9, 0xbc, 0x81, 0x9d, 0xca, 0xfa, 0x23,
-	0x50, 0x04, 0x33, 0x98, 0x7a, 0xc6, 0x59, 0x39, 0x64, 0x1f, 0x4a, 0x3d, 0x2b, 0x78, 0xee, 0x43,
-	0x46, 0xd8, 0xc9, 0x8c, 0x31, 0xd2, 0x63, 0x5f, 0x9d, 0x3d, 0x23, 0xe8, 0xea, 0xa6, 0x8d, 0x50,
-	0x5f, 0x13, 0x6d, 0xe4, 0x19, 0xfb, 0x20, 0x2d, 0x1a, 0xa9, 0xe9, 0xa1, 0x55, 0x39, 0x3a, 0x75,
-	0x28, 0x7d, 0xf6, 0x3d, 0xd9, 0xb3, 0xc2, 0x1c, 0xe0, 0x0c, 0x33, 0xf1, 0x83, 0xf9, 0x90, 0x7d,
-	0x6a, 0x0e, 0x98, 0x99, 0x1a, 0xcd, 0xe4, 0xd4, 0xd1, 0x3c, 0x67, 0xdf, 0x75, 0x3d, 0x33, 0xbb,
-	0xb3, 0x46, 0x33, 0x39, 0x75, 0x34, 0x03, 0xf6, 0xc5, 0x37, 0x60, 0xa6, 0xa6, 0xd7, 0x37, 0x80,
-	0x88, 0x5b, 0xcd, 0xe3, 0x44, 0x8c, 0x9d, 0x21, 0xfb, 0x8e, 0xef, 0x6f, 0x36, 0xa3, 0xcc, 0x32,
-	0x14, 0x3f, 0x20, 0x8b, 0x7d, 0xe2, 0x0f, 0x1a, 0xaa, 0xe9, 0xf5, 0x4d, 0x38, 0x2f, 0x4e, 0xec,
-	0x0c, 0x43, 0xb2, 0x55, 0xa9, 0x52, 0x34, 0x16, 0xfc, 0xa9, 0x71, 0xce, 0x4c, 0x53, 0xf1, 0x83,
-	0x1a, 0xa9, 0x52, 0x45, 0x99, 0x32, 0x55, 0xd3, 0xeb, 0x0f, 0xa0, 0x28, 0x98, 0xda, 0xc7, 0x08,
-	0x1d, 0x6d, 0xe6, 0x05, 0xfb, 0x5f, 0x0b, 0xcf, 0x0c, 0x8d, 0xe8, 0xe1, 0x1d, 0xe3, 0x31, 0x2e,
-	0xda, 0xc8, 0x98, 0xfd, 0xa3, 0x80, 0x3f, 0x16, 0x64, 0x84, 0x8e, 0x04, 0xe6, 0xdf, 0x71, 0x56,
-	0x26, 0xec, 0x5f, 0x08, 0xfc, 0xa1, 0x50, 0x42, 0xbd, 0x1f, 0x98, 0x8e, 0x49, 0x83, 0x5c, 0x8c,
-	0x0d, 0x07, 0x3d, 0xf2, 0x9b, 0x91, 0x80, 0x15, 0xf1, 0x81, 0x44, 0x98, 0x36, 0x2d, 0xd6, 0x37,
-	0x61, 0xfe, 0xec, 0x0e, 0xe9, 0x63, 0x89, 0x65, 0xcb, 0xd5, 0x15, 0x9a, 0x50, 0x1b, 0x73, 0xdd,
-	0x80, 0x5f, 0x6a, 0xc0, 0xdc, 0x99, 0x9d, 0xd2, 0x27, 0x12, 0xcb, 0x39, 0xa9, 0x25, 0xa3, 0xd0,
-	0x0d, 0x7a, 0xa6, 0xb9, 0x33, 0xbb, 0xa5, 0x4f, 0x25, 0xf6, 0x40, 0xa1, 0x6b, 0x9e, 0x11, 0xd7,
-	0x33, 0xcd, 0x9d, 0xd9, 0x2d, 0x7d, 0x95, 0x65, 0x94, 0xb2, 0x5e, 0x15, 0x8d, 0xa0, 0x2f, 0x98,
-	0x3f, 0xbb, 0x5b, 0xfa, 0x9a, 0x84, 0x8f, 0x15, 0xb2, 0xae, 0x7b, 0xeb, 0xe2, 0x79, 0xa6, 0xf9,
-	0xb3, 0xbb, 0xa5, 0xaf, 0x4b, 0xf8, 0xa4, 0x21, 0xeb, 0xeb, 0x01, 0x33, 0xc1, 0xd1, 0x9c, 0xee,
-	0x96, 0xbe, 0x21, 0xe1, 0x2b, 0x83, 0xac, 0xd7, 0x3c, 0x33, 0xbb, 0x53, 0xa3, 0x39, 0xdd, 0x2d,
-	0x7d, 0x13, 0x6f, 0xf1, 0x75, 0x59, 0xbf, 0x13, 0x30, 0x83, 0x9e, 0xa9, 0xf8, 0x0a, 0x6e, 0xe9,
-	0x5b, 0x12, 0x3e, 0x06, 0xc9, 0xfa, 0x5d, 0xc3, 0xed, 0xdd, 0xf7, 0x4c, 0xc5, 0x57, 0x70, 0x4b,
-	0x9f, 0x49, 0xf8, 0x66, 0x24, 0xeb, 0xf7, 0x82, 0x86, 0xd0, 0x33, 0x29, 0xaf, 0xe2, 0x96, 0xbe,
-	0x4d, 0x2d, 0x15, 0xeb, 0xf2, 0xfa, 0xaa, 0xe1, 0x0e, 0x40, 0xf0, 0x4c, 0xca, 0xab, 0xb8, 0xa5,
-	0xef, 0x50, 0x53, 0x4a, 0x5d, 0x5e, 0x5f, 0x0b, 0x99, 0xaa, 0xe9, 0xf5, 0x47, 0x50, 0x38, 0xab,
-	0x5b, 0xfa, 0xae, 0xf8, 0x16, 0x97, 0xef, 0x0a, 0xbe, 0x69, 0x47, 0xd8, 0xb3, 0x53, 0x1d, 0xd3,
-	0xf7, 0x30, 0xc7, 0xa9, 0xcf, 0x3d, 0x61, 0xef, 0x55, 0x8c, 0xe0, 0x6f, 0x1f, 0x73, 0x53, 0x5b,
-	0xfe, 0xf9, 0x38, 0xd5, 0x47, 0x7d, 0x5f, 0xc2, 0x47, 0xad, 0x02, 0x37, 0x88, 0x78, 0xef, 0xa4,
-	0x30, 0x87, 0xf5, 0xa1, 0x3f, 0xcb, 0xd3, 0xbc, 0xd5, 0x0f, 0xa4, 0x57, 0x71, 0x57, 0xf5, 0x44,
-	0x6b, 0xbb, 0xe1, 0x2d, 0x06, 0xd6, 0xbc, 0x0d, 0xc9, 0x63, 0x6d, 0x75, 0x4d, 0xbc, 0x92, 0x89,
-	0x6f, 0xb9, 0xcc, 0x49, 0xe5, 0xb5, 0xa2, 0xf0, 0xdc, 0x3d, 0x1c, 0x39, 0x27, 0x06, 0xb2, 0x38,
-	0x5b, 0x8b, 0x64, 0x7f, 0x12, 0xc3, 0xd6, 0x38, 0xbb, 0x1a, 0xc9, 0xfe, 0x34, 0x86, 0x5d, 0xe5,
-	0x6c, 0x3d, 0x92, 0xfd, 0xd5, 0x18, 0xb6, 0xce, 0xd9, 0xeb, 0x91, 0xec, 0xaf, 0xc5, 0xb0, 0xd7,
-	0x39, 0xbb, 0x16, 0xc9, 0xfe, 0x7a, 0x0c, 0xbb, 0xc6, 0xd9, 0x77, 0x22, 0xd9, 0xdf, 0x88, 0x61,
-	0xdf, 0xe1, 0xec, 0xbb, 0x91, 0xec, 0x6f, 0xc6, 0xb0, 0xef, 0x72, 0xf6, 0xbd, 0x48, 0xf6, 0xb7,
-	0x62, 0xd8, 0xf7, 0x18, 0x7b, 0x6d, 0x35, 0x92, 0xfd, 0x59, 0x34, 0x7b, 0x6d, 0x95, 0xb3, 0xa3,
-	0xb5, 0xf6, 0xed, 0x18, 0x36, 0xd7, 0xda, 0x5a, 0xb4, 0xd6, 0xbe, 0x13, 0xc3, 0xe6, 0x5a, 0x5b,
-	0x8b, 0xd6, 0xda, 0x77, 0x63, 0xd8, 0x5c, 0x6b, 0x6b, 0xd1, 0x5a, 0xfb, 0x5e, 0x0c, 0x9b, 0x6b,
-	0x6d, 0x2d, 0x5a, 0x6b, 0xdf, 0x8f, 0x61, 0x73, 0xad, 0xad, 0x45, 0x6b, 0xed, 0x07, 0x31, 0x6c,
-	0xae, 0xb5, 0xb5, 0x68, 0xad, 0xfd, 0x51, 0x0c, 0x9b, 0x6b, 0x6d, 0x2d, 0x5a, 0x6b, 0x7f, 0x1c,
-	0xc3, 0xe6, 0x5a, 0x5b, 0x8b, 0xd6, 0xda, 0x9f, 0xc4, 0xb0, 0xb9, 0xd6, 0xb4, 0x68, 0xad, 0xfd,
-	0x69, 0x34, 0x5b, 0xe3, 0x5a, 0xd3, 0xa2, 0xb5, 0xf6, 0x67, 0x31, 0x6c, 0xae, 0x35, 0x2d, 0x5a,
-	0x6b, 0x7f, 0x1e, 0xc3, 0xe6, 0x5a, 0xd3, 0xa2, 0xb5, 0xf6, 0xc3, 0x18, 0x36, 0xd7, 0x9a, 0x16,
-	0xad, 0xb5, 0xbf, 0x88, 0x61, 0x73, 0xad, 0x69, 0xd1, 0x5a, 0xfb, 0xcb, 0x18, 0x36, 0xd7, 0x9a,
-	0x16, 0xad, 0xb5, 0xbf, 0x8a, 0x61, 0x73, 0xad, 0x69, 0xd1, 0x5a, 0xfb, 0xeb, 0x18, 0x36, 0xd7,
-	0x9a, 0x16, 0xad, 0xb5, 0xbf, 0x89, 0x61, 0x73, 0xad, 0x69, 0xd1, 0x5a, 0xfb, 0xdb, 0x18, 0x36,
-	0xd7, 0x5a, 0x35, 0x5a, 0x6b, 0x7f, 0x17, 0xcd, 0xae, 0x72, 0xad, 0x55, 0xa3, 0xb5, 0xf6, 0xf7,
-	0x31, 0x6c, 0xae, 0xb5, 0x6a, 0xb4, 0xd6, 0xfe, 0x21, 0x86, 0xcd, 0xb5, 0x56, 0x8d, 0xd6, 0xda,
-	0x3f, 0xc6, 0xb0, 0xb9, 0xd6, 0xaa, 0xd1, 0x5a, 0xfb, 0x51, 0x0c, 0x9b, 0x6b, 0xad, 0x1a, 0xad,
-	0xb5, 0x7f, 0x8a, 0x61, 0x73, 0xad, 0x55, 0xa3, 0xb5, 0xf6, 0xcf, 0x31, 0x6c, 0xae, 0xb5, 0x6a,
-	0xb4, 0xd6, 0xfe, 0x25, 0x86, 0xcd, 0xb5, 0x56, 0x8d, 0xd6, 0xda, 0xbf, 0xc6, 0xb0, 0xb9, 0xd6,
-	0xaa, 0xd1, 0x5a, 0xfb, 0xb7, 0x18, 0x36, 0xd7, 0x9a, 0x1e, 0xad, 0xb5, 0x7f, 0x8f, 0x66, 0xeb,
-	0x5c, 0x6b, 0x7a, 0xb4, 0xd6, 0xfe, 0x23, 0x86, 0xcd, 0xb5, 0xa6, 0x47, 0x6b, 0xed, 0x3f, 0x63,
-	0xd8, 0x5c, 0x6b, 0x7a, 0xb4, 0xd6, 0xfe, 0x2b, 0x86, 0xcd, 0xb5, 0xa6, 0x47, 0x6b, 0xed, 0xbf,
-	0x63, 0xd8, 0x5c, 0x6b, 0x7a, 0xb4, 0xd6, 0xfe, 0x27, 0x86, 0xcd, 0xb5, 0xa6, 0x47, 0x6b, 0xed,
-	0xc7, 0x31, 0x6c, 0xae, 0x35, 0x3d, 0x5a, 0x6b, 0x3f, 0x89, 0x61, 0x73, 0xad, 0xe9, 0xd1, 0x5a,
-	0xfb, 0xdf, 0x18, 0x36, 0xd7, 0x9a, 0x1e, 0xad, 0xb5, 0xff, 0x8b, 0x61, 0x73, 0xad, 0xad, 0x47,
-	0x6b, 0xed, 0xff, 0xa3, 0xd9, 0xeb, 0xab, 0x3f, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x00, 0xcd,
-	0x32, 0x57, 0x39, 0x00, 0x00,
+	// 4504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5a, 0xc9, 0x77, 0xe3, 0x46,
+	0x7a, 0x6f, 0x00, 0x5c, 0x3f, 0x52, 0x22, 0x54, 0x2d, 0xb7, 0xd1, 0xea, 0x0d, 0xcd, 0x19, 0xdb,
+	0xec, 0x4d, 0x96, 0x40, 0x88, 0xdd, 0xcd, 0x76, 0xfc, 0x5e, 0xab, 0x9b, 0x54, 0xeb, 0x8d, 0x24,
+	0x2a, 0x90, 0x6c, 0xbf, 0x71, 0x0e, 0x7c, 0x94, 0x08, 0x51, 0x74, 0x93, 0x00, 0x9b, 0x00, 0xd3,
+	0xd2, 0xe4, 0xe2, 0x4b, 0x72, 0xcd, 0x76, 0xc9, 0x35, 0xa7, 0x9c, 0xb2, 0xbc, 0xfc, 0x13, 0x89,
+	0xed, 0x59, 0x3d, 0x6b, 0xd6, 0xc9, 0xbe, 0x4c, 0xf6, 0x6d, 0x26, 0xc9, 0xc5, 0xf3, 0x6a, 0x01,
+	0x50, 0x00, 0x09, 0x48, 0x3e, 0x09, 0xa8, 0xfa, 0xfd, 0xbe, 0xfa, 0xaa, 0xea, 0x87, 0xef, 0xab,
+	0xaf, 0x28, 0x00, 0xd7, 0x74, 0xdc, 0xe5, 0xd1, 0xd8, 0x76, 0x6d, 0x94, 0xc3, 0xcf, 0xdd, 0x8e,
+	0xdb, 0x29, 0xdf, 0x82, 0xcc, 0x86, 0xdd, 0xb0, 0x26, 0x43, 0x74, 0x03, 0xa4, 0x23, 0xdb, 0x56,
+	0x04, 0x55, 0xac, 0xcc, 0x6b, 0x73, 0xcb, 0x1e, 0x62, 0xb9, 0xd9, 0x6a, 0x19, 0xb8, 0xa7, 0x7c,
+	0x1f, 0x0a, 0x1b, 0xf6, 0xbe, 0xe9, 0xb8, 0xcd, 0xbe, 0x39, 0xe8, 0xa2, 0x45, 0x48, 0x6f, 0x75,
+	0x0e, 0xcc, 0x01, 0x61, 0xe4, 0x0d, 0xfa, 0x82, 0x10, 0xa4, 0xf6, 0x4f, 0x47, 0xa6, 0x22, 0x92,
+	0x46, 0xf2, 0x5c, 0xfe, 0xe5, 0xeb, 0x90, 0xa1, 0x4c, 0x74, 0x0b, 0x52, 0x5f, 0xea, 0x5b, 0x5d,
+	0x36, 0xca, 0x2b, 0xc1, 0x28, 0xb4, 0x7f, 0xf9, 0x4b, 0x9b, 0x3b, 0x4f, 0x0d, 0x02, 0xc1, 0xf6,
+	0xf7, 0x3b, 0x07, 0x03, 0x6c, 0x4a, 0xc0, 0xf6, 0xc9, 0x0b, 0x6e, 0xdd, 0xed, 0x8c, 0x3b, 0x43,
+	0x45, 0x52, 0x85, 0x4a, 0xda, 0xa0, 0x2f, 0xe8, 0x11, 0xcc, 0x19, 0xe6, 0x8b, 0x49, 0x7f, 0x6c,
+	0x76, 0x89, 0x73, 0x4a, 0x4a, 0x15, 0x2b, 0x85, 0x69, 0xfb, 0xa4, 0xd3, 0x08, 0x63, 0x29, 0x79,
+	0x64, 0x76, 0x5c, 0x8f, 0x9c, 0x56, 0xa5, 0x44, 0x32, 0x87, 0xc5, 0xe4, 0xd6, 0xc8, 0xed, 0xdb,
+	0x56, 0x67, 0x40, 0xc9, 0x19, 0x55, 0x48, 0x20, 0x87, 0xb0, 0xe8, 0x75, 0x28, 0x35, 0xdb, 0xeb,
+	0xb6, 0x3d, 0x68, 0x8f, 0x99, 0x47, 0x0a, 0xa8, 0x62, 0x25, 0x67, 0xcc, 0x35, 0x71, 0xab, 0xe7,
+	0x26, 0xaa, 0x80, 0xdc, 0x6c, 0x6f, 0x5a, 0x6e, 0x55, 0x0b, 0x80, 0x05, 0x55, 0xac, 0xa4, 0x8d,
+	0xf9, 0x26, 0x69, 0x9e, 0x42, 0xd6, 0xf4, 0x00, 0x59, 0x54, 0xc5, 0x8a, 0x44, 0x91, 0x35, 0xdd,
+	0x47, 0xde, 0x05, 0xd4, 0x6c, 0x37, 0xfb, 0x27, 0x66, 0x97, 0xb7, 0x3a, 0xa7, 0x8a, 0x95, 0xac,
+	0x21, 0x37, 0x59, 0xc7, 0x0c, 0x34, 0x6f, 0x79, 0x5e, 0x15, 0x2b, 0x19, 0x0f, 0xcd, 0xd9, 0xbe,
+	0x0d, 0x0b, 0xcd, 0xf6, 0x3b, 0xfd, 0xb0, 0xc3, 0x25, 0x55, 0xac, 0xcc, 0x19, 0xa5, 0x26, 0x6d,
+	0x9f, 0xc6, 0xf2, 0x86, 0x65, 0x55, 0xac, 0xa4, 0x18, 0xb6, 0xa6, 0x87, 0x67, 0xd7, 0x1c, 0xd8,
+	0x1d, 0x37, 0x80, 0x2e, 0xa8, 0x62, 0x45, 0x34, 0xe6, 0x9b, 0xa4, 0x39, 0x6c, 0xf5, 0xa9, 0x3d,
+	0x39, 0x18, 0x98, 0x01, 0x14, 0xa9, 0x62, 0x45, 0x30, 0x4a, 0x4d, 0xda, 0x1e, 0xc6, 0xee, 0xb9,
+	0xe3, 0xbe, 0xd5, 0x0b, 0xb0, 0x17, 0x89, 0x7e, 0x4b, 0x4d, 0xda, 0x1e, 0xf6, 0x60, 0xfd, 0xd4,
+	0x35, 0x9d, 0x00, 0x6a, 0xaa, 0x62, 0xa5, 0x68, 0xcc, 0x37, 0x49, 0x73, 0xc4, 0x6a, 0x64, 0x0d,
+	0x8e, 0x54, 0xb1, 0xb2, 0x80, 0xad, 0xce, 0x58, 0x83, 0xbd, 0xc8, 0x1a, 0xf4, 0x54, 0xb1, 0x82,
+	0x18, 0x96, 0x5b, 0x03, 0x5e, 0x33, 0x54, 0x88, 0xca, 0xa2, 0x2a, 0x71, 0x9a, 0xa1, 0x8d, 0x61,
+	0xcd, 0x30, 0xe0, 0x2b, 0xaa, 0xc4, 0x6b, 0x26, 0x82, 0x24, 0x83, 0x33, 0xe4, 0x25, 0x55, 0xe2,
+	0x35, 0xc3, 0x90, 0x11, 0xcd, 0x30, 0xec, 0xab, 0xaa, 0x14, 0xd6, 0xcc, 0x14, 0x9a, 0xb7, 0xac,
+	0xa8, 0x52, 0x58, 0x33, 0x0c, 0x1d, 0xd6, 0x0c, 0x03, 0x5f, 0x56, 0xa5, 0x90, 0x66, 0xa2, 0x58,
+	0xde, 0xf0, 0x92, 0x2a, 0x85, 0x34, 0xc3, 0xcf, 0xce, 0xd3, 0x0c, 0x83, 0x5e, 0x51, 0x25, 0x5e,
+	0x33, 0xbc, 0x55, 0x5f, 0x33, 0x0c, 0x7a, 0x55, 0x95, 0x42, 0x9a, 0xe1, 0xb1, 0xbe, 0x66, 0x18,
+	0xf6, 0x9a, 0x2a, 0x85, 0x34, 0xc3, 0xb0, 0xb7, 0x78, 0xcd, 0x30, 0xe8, 0x47, 0x82, 0x2a, 0xf1,
+	0xa2, 0x61, 0xd0, 0x3b, 0x21, 0xd1, 0x30, 0xec, 0xc7, 0x18, 0xcb, 0xab, 0x26, 0x0a, 0xe6, 0x57,
+	0xe1, 0x13, 0x0c, 0xe6, 0x65, 0xc3, 0xc0, 0x81, 0x6c, 0x6c, 0x16, 0x82, 0x94, 0xeb, 0xaa, 0xe0,
+	0xcb, 0xc6, 0x8b, 0x4b, 0xbc, 0x6c, 0x7c, 0xe0, 0x0d, 0x12, 0x6a, 0x99, 0x6c, 0xa6, 0x90, 0x35,
+	0x3d, 0x40, 0xaa, 0xaa, 0x10, 0xc8, 0xc6, 0x47, 0x86, 0x64, 0xe3, 0x63, 0x6f, 0xaa, 0x02, 0x2f,
+	0x9b, 0x19, 0x68, 0xde, 0x72, 0x59, 0x15, 0x78, 0xd9, 0xf8, 0x68, 0x5e, 0x36, 0x3e, 0xf8, 0x0b,
+	0xaa, 0xc0, 0xc9, 0x66, 0x1a, 0xcb, 0x1b, 0xfe, 0xa2, 0x2a, 0x70, 0xb2, 0x09, 0xcf, 0x8e, 0xca,
+	0xc6, 0x87, 0xbe, 0xa6, 0x0a, 0x81, 0x6c, 0xc2, 0x56, 0x99, 0x6c, 0x7c, 0xe8, 0xeb, 0xaa, 0xc0,
+	0xc9, 0x26, 0x8c, 0x65, 0xb2, 0xf1, 0xb1, 0x6f, 0xa8, 0x02, 0x27, 0x1b, 0x1f, 0xcb, 0xc9, 0xc6,
+	0x87, 0xfe, 0x36, 0xce, 0x85, 0xbe, 0x6c, 0x7c, 0x28, 0x2f, 0x1b, 0x1f, 0xfb, 0x3b, 0x18, 0x1b,
+	0xc8, 0x66, 0x1a, 0xcc, 0xaf, 0xc2, 0xef, 0x62, 0x70, 0x20, 0x1b, 0x1f, 0xbc, 0x0c, 0x32, 0x93,
+	0x4d, 0xd7, 0x3c, 0xea, 0x4c, 0x06, 0x58, 0x62, 0x15, 0xac, 0x9b, 0x7a, 0xca, 0x1d, 0x4f, 0x4c,
+	0xec, 0x89, 0x6d, 0x0f, 0x9e, 0x7a, 0x7d, 0x68, 0x19, 0x1b, 0xa7, 0xf2, 0x09, 0x08, 0xb7, 0xb0,
+	0x7e, 0xea, 0x62, 0x55, 0x33, 0x4a, 0x54, 0x43, 0xd3, 0xf8, 0x9a, 0xce, 0xe1, 0x6f, 0x63, 0x15,
+	0xd5, 0xc5, 0x9a, 0x4e, 0xf1, 0x35, 0x3d, 0xc0, 0x57, 0xe1, 0x62, 0x20, 0xa5, 0x80, 0x71, 0x07,
+	0x6b, 0xa9, 0x2e, 0x55, 0xb5, 0x15, 0x63, 0xc1, 0x13, 0xd4, 0x2c, 0x52, 0x68, 0x98, 0xbb, 0x58,
+	0x52, 0x75, 0xa9, 0xa6, 0xfb, 0x24, 0x7e, 0x24, 0x0d, 0xcb, 0x90, 0x09, 0x2b, 0xe0, 0xdc, 0xc3,
+	0xca, 0xaa, 0xa7, 0xaa, 0xda, 0xca, 0x8a, 0x21, 0x33, 0x7d, 0xcd, 0xe0, 0x84, 0xc6, 0x59, 0xc6,
+	0x0a, 0xab, 0xa7, 0x6a, 0xba, 0xcf, 0x09, 0x8f, 0xb3, 0xe0, 0x09, 0x2d, 0xa0, 0xbc, 0x89, 0x95,
+	0x56, 0xcf, 0x54, 0x57, 0xf5, 0xd5, 0xb5, 0x87, 0x46, 0x89, 0x2a, 0x2e, 0xe0, 0xe8, 0x78, 0x1c,
+	0x26, 0xb9, 0x80, 0xb4, 0x82, 0x35, 0x57, 0xcf, 0x68, 0xf7, 0x57, 0x1f, 0x68, 0x0f, 0x0c, 0x99,
+	0x69, 0x2f, 0x60, 0xbd, 0x8d, 0x59, 0x4c, 0x7c, 0x01, 0x6b, 0x15, 0xab, 0xaf, 0x2e, 0x1f, 0x9b,
+	0x83, 0x81, 0x7d, 0x57, 0x2d, 0xbf, 0xb4, 0xc7, 0x83, 0xee, 0xcd, 0x32, 0x18, 0x32, 0xd3, 0x23,
+	0x3f, 0xea, 0x82, 0x27, 0xc8, 0x80, 0xfe, 0xab, 0xf8, 0x1c, 0x56, 0xac, 0x67, 0xd7, 0xfb, 0x3d,
+	0xcb, 0x76, 0x4c, 0xa3, 0x44, 0xa5, 0x19, 0x59, 0x93, 0xbd, 0xe8, 0x3a, 0xfe, 0x1a, 0xa6, 0x2d,
+	0xd4, 0xa5, 0x7b, 0x55, 0x0d, 0x8f, 0x34, 0x6b, 0x1d, 0xf7, 0xa2, 0xeb, 0xf8, 0xeb, 0x98, 0x83,
+	0xea, 0xd2, 0xbd, 0x9a, 0xce, 0x38, 0xfc, 0x3a, 0xde, 0x87, 0x4b, 0x91, 0xbc, 0xd8, 0x1e, 0x75,
+	0x0e, 0x9f, 0x9b, 0x5d, 0x45, 0xc3, 0xe9, 0x71, 0x5d, 0x94, 0x05, 0xe3, 0x62, 0x28, 0x45, 0xee,
+	0x92, 0x6e, 0xf4, 0x10, 0x5e, 0x8d, 0x26, 0x4a, 0x8f, 0x59, 0xc5, 0xf9, 0x92, 0x30, 0x17, 0xc3,
+	0x39, 0x33, 0x42, 0xad, 0xe9, 0x53, 0x54, 0x1d, 0x27, 0xd0, 0x80, 0x5a, 0xd3, 0x23, 0xd4, 0x9f,
+	0x81, 0xcb, 0xd3, 0xa9, 0xd4, 0x23, 0xaf, 0xe1, 0x8c, 0x4a, 0xc8, 0x97, 0xa2, 0x59, 0x75, 0x8a,
+	0x3e, 0x63, 0xec, 0x1a, 0x4e, 0xb1, 0x3c, 0x7d, 0x6a, 0xf4, 0x47, 0xa0, 0x4c, 0x25, 0x5b, 0x8f,
+	0x7d, 0x1f, 0xe7, 0x5c, 0xc2, 0x7e, 0x25, 0x92, 0x77, 0xa3, 0xe4, 0x19, 0x43, 0x3f, 0xc0, 0x49,
+	0x98, 0x23, 0xd7, 0xf4, 0x59, 0x4b, 0x16, 0x4e, 0xc7, 0x1e, 0xf7, 0x21, 0xce, 0xca, 0x6c, 0xc9,
+	0x42, 0x99, 0x99, 0x1f, 0x37, 0x92, 0x9f, 0x3d, 0x6e, 0x1d, 0xa7, 0x69, 0x36, 0x6e, 0x38, 0x55,
+	0x33, 0xf2, 0x5b, 0x98, 0xbc, 0x37, 0x7b, 0xc6, 0x3f, 0x96, 0x70, 0x82, 0x65, 0xec, 0xbd, 0x59,
+	0x53, 0xf6, 0xd9, 0x33, 0xa6, 0xfc, 0x13, 0xcc, 0x46, 0x1c, 0x7b, 0x6a, 0xce, 0x4f, 0x61, 0xce,
+	0x3b, 0xd5, 0xf5, 0xc6, 0xf6, 0x64, 0xa4, 0x34, 0x55, 0xb1, 0x02, 0xda, 0xf5, 0xa9, 0xea, 0xc7,
+	0x3b, 0xe4, 0x6d, 0x60, 0x94, 0x11, 0x26, 0x51, 0x2b, 0xd4, 0x2e, 0xb5, 0xb2, 0xab, 0x4a, 0x31,
+	0x56, 0x28, 0xca, 0xb7, 0xc2, 0x91, 0xb0, 0x15, 0x2f, 0xe8, 0x53, 0x2b, 0xef, 0xab, 0xc2, 0x4c,
+	0x2b, 0x5e, 0x0a, 0x60, 0x56, 0x42, 0xa4, 0xa5, 0xb5, 0xa0, 0xde, 0x22, 0xfd, 0xe8, 0x8b, 0xd1,
+	0x02, 0x6c, 0x83, 0x9c, 0x9f, 0xc3, 0x8d, 0x94, 0xc6, 0x39, 0x37, 0x4d, 0xfb, 0xd9, 0x18, 0x5a,
+	0xc8, 0x9b, 0x69, 0xda, 0xcf, 0xcd, 0xa0, 0x95, 0x7f, 0x43, 0x80, 0x14, 0xae, 0x27, 0x51, 0x0e,
+	0x52, 0xef, 0xb6, 0x36, 0x9f, 0xca, 0x17, 0xf0, 0xd3, 0x7a, 0xab, 0xb5, 0x25, 0x0b, 0x28, 0x0f,
+	0xe9, 0xf5, 0x2f, 0xef, 0x37, 0xf6, 0x64, 0x11, 0x95, 0xa0, 0xd0, 0xdc, 0xdc, 0xd9, 0x68, 0x18,
+	0xbb, 0xc6, 0xe6, 0xce, 0xbe, 0x2c, 0xe1, 0xbe, 0xe6, 0x56, 0xeb, 0xf1, 0xbe, 0x9c, 0x42, 0x59,
+	0x90, 0x70, 0x5b, 0x1a, 0x01, 0x64, 0xf6, 0xf6, 0x8d, 0xcd, 0x9d, 0x0d, 0x39, 0x83, 0xad, 0xec,
+	0x6f, 0x6e, 0x37, 0xe4, 0x2c, 0x46, 0xee, 0xbf, 0xb3, 0xbb, 0xd5, 0x90, 0x73, 0xf8, 0xf1, 0xb1,
+	0x61, 0x3c, 0xfe, 0xb2, 0x9c, 0xc7, 0xa4, 0xed, 0xc7, 0xbb, 0x32, 0x90, 0xee, 0xc7, 0xeb, 0x5b,
+	0x0d, 0xb9, 0x80, 0x8a, 0x90, 0x6b, 0xbe, 0xb3, 0xf3, 0x64, 0x7f, 0xb3, 0xb5, 0x23, 0x17, 0xcb,
+	0xa7, 0xa0, 0xd0, 0x65, 0x0e, 0xad, 0x22, 0x2d, 0x0a, 0xdf, 0x86, 0x34, 0xdd, 0x19, 0x81, 0xa8,
+	0xa4, 0x12, 0xdd, 0x99, 0x69, 0xca, 0x32, 0x79, 0x34, 0x28, 0x6d, 0xe9, 0x1a, 0xa4, 0xe9, 0x2a,
+	0x2d, 0x42, 0x9a, 0xae, 0x8e, 0x48, 0x4a, 0x45, 0xfa, 0x52, 0xfe, 0x4d, 0x11, 0x60, 0xc3, 0xde,
+	0x7b, 0xde, 0x1f, 0x61, 0x63, 0xe8, 0x1a, 0x80, 0xf3, 0xbc, 0x3f, 0x6a, 0x13, 0xd5, 0xb3, 0xa2,
+	0x32, 0x8f, 0x5b, 0x48, 0xbc, 0x43, 0x37, 0xa1, 0x48, 0xba, 0x8f, 0x68, 0x14, 0x22, 0xb5, 0x64,
+	0xd6, 0x28, 0xe0, 0x36, 0x16, 0x98, 0xc2, 0x90, 0x9a, 0x4e, 0x4a, 0xc8, 0x0c, 0x07, 0xa9, 0xe9,
+	0xe8, 0x06, 0x90, 0xd7, 0xb6, 0x43, 0x32, 0x0a, 0x29, 0x1b, 0xf3, 0x06, 0x19, 0x97, 0xe6, 0x18,
+	0xf4, 0x16, 0x90, 0x31, 0xe9, 0xbc, 0x4b, 0xd3, 0x5f, 0x87, 0xe7, 0xee, 0x32, 0x7e, 0xa0, 0xb3,
+	0x0d, 0x08, 0x4b, 0x2d, 0xc8, 0xfb, 0xed, 0x78, 0x2c, 0xd2, 0xca, 0x66, 0x24, 0x93, 0x19, 0x01,
+	0x69, 0xf2, 0xa7, 0x44, 0x01, 0xcc, 0x9b, 0x05, 0xe2, 0x0d, 0x25, 0x51, 0x77, 0xca, 0xd7, 0x60,
+	0x6e, 0xc7, 0xb6, 0xe8, 0xd7, 0x4b, 0x56, 0xa9, 0x08, 0x42, 0x47, 0x11, 0x48, 0xf5, 0x24, 0x74,
+	0xca, 0xd7, 0x01, 0xb8, 0x3e, 0x19, 0x84, 0x03, 0xda, 0x47, 0x62, 0x80, 0x70, 0x50, 0xbe, 0x03,
+	0x99, 0xed, 0xce, 0xc9, 0x7e, 0xa7, 0x87, 0x6e, 0x02, 0x0c, 0x3a, 0x8e, 0xdb, 0x3e, 0x22, 0xfb,
+	0xf0, 0xd9, 0x67, 0x9f, 0x7d, 0x26, 0x90, 0xc3, 0x5e, 0x1e, 0xb7, 0xd2, 0xfd, 0x78, 0x01, 0xd0,
+	0x1a, 0x74, 0xb7, 0x4d, 0xc7, 0xe9, 0xf4, 0x4c, 0x54, 0x85, 0x8c, 0x65, 0x3a, 0x38, 0xdb, 0x09,
+	0xe4, 0x1e, 0xe1, 0x4a, 0xb0, 0x0a, 0x01, 0x6a, 0x79, 0x87, 0x40, 0x0c, 0x06, 0x45, 0x32, 0x48,
+	0xd6, 0x64, 0x48, 0xee, 0x49, 0xd2, 0x06, 0x7e, 0x5c, 0xba, 0x0a, 0x19, 0x8a, 0xc1, 0xf7, 0x31,
+	0x56, 0x67, 0x68, 0x2a, 0x74, 0x5c, 0xf2, 0x5c, 0xfe, 0x15, 0x01, 0x60, 0xc7, 0x7c, 0x79, 0x8e,
+	0x31, 0x03, 0x54, 0xc2, 0x98, 0x12, 0x1d, 0xf3, 0x51, 0xd2, 0x98, 0x58, 0x67, 0x47, 0xb6, 0xdd,
+	0x6d, 0xd3, 0x2d, 0xa6, 0x57, 0x3a, 0x79, 0xdc, 0x42, 0x76, 0xad, 0xfc, 0x3e, 0x14, 0x37, 0x2d,
+	0xcb, 0x1c, 0x7b, 0x3e, 0x21, 0x48, 0x1d, 0xdb, 0x8e, 0xcb, 0xee, 0x96, 0xc8, 0x33, 0x52, 0x20,
+	0x35, 0xb2, 0xc7, 0x2e, 0x9d, 0x67, 0x3d, 0xa5, 0xaf, 0xac, 0xac, 0x18, 0xa4, 0x05, 0x5d, 0x85,
+	0xfc, 0xa1, 0x6d, 0x59, 0xe6, 0x21, 0x9e, 0x84, 0x44, 0xca, 0x9a, 0xa0, 0xa1, 0xfc, 0x4b, 0x02,
+	0x14, 0x5b, 0xee, 0x71, 0x60, 0x5c, 0x06, 0xe9, 0xb9, 0x79, 0x4a, 0xdc, 0x93, 0x0c, 0xfc, 0x88,
+	0x3f, 0x95, 0x9f, 0xef, 0x0c, 0x26, 0xf4, 0xae, 0xa9, 0x68, 0xd0, 0x17, 0x74, 0x09, 0x32, 0x2f,
+	0xcd, 0x7e, 0xef, 0xd8, 0x25, 0x36, 0x45, 0x83, 0xbd, 0xa1, 0xbb, 0x90, 0xee, 0x63, 0x67, 0x95,
+	0x14, 0x59, 0xaf, 0x4b, 0xc1, 0x7a, 0xf1, 0x73, 0x30, 0x28, 0xe8, 0x76, 0x2e, 0xd7, 0x95, 0x3f,
+	0xfc, 0xf0, 0xc3, 0x0f, 0xc5, 0xf2, 0x11, 0x2c, 0x7a, 0x1f, 0x6f, 0x68, 0xb2, 0x3b, 0xa0, 0x0c,
+	0x4c, 0xbb, 0x7d, 0xd4, 0xb7, 0x3a, 0x83, 0xc1, 0x69, 0xfb, 0xa5, 0x6d, 0xb5, 0x3b, 0x56, 0xdb,
+	0x76, 0x0e, 0x3b, 0x63, 0xb2, 0x00, 0xf1, 0x43, 0x2c, 0x0e, 0x4c, 0xbb, 0x49, 0x69, 0xef, 0xd9,
+	0xd6, 0x63, 0xab, 0x85, 0x39, 0xe5, 0x3f, 0x48, 0x41, 0x7e, 0xfb, 0xd4, 0xb3, 0xbe, 0x08, 0xe9,
+	0x43, 0x7b, 0x62, 0xd1, 0xb5, 0x4c, 0x1b, 0xf4, 0xc5, 0xdf, 0x23, 0x91, 0xdb, 0xa3, 0x45, 0x48,
+	0xbf, 0x98, 0xd8, 0xae, 0x49, 0xa6, 0x9b, 0x37, 0xe8, 0x0b, 0x5e, 0xad, 0x91, 0xe9, 0x2a, 0x29,
+	0x52, 0xdc, 0xe2, 0xc7, 0x60, 0xfe, 0xe9, 0x73, 0xcc, 0x1f, 0x2d, 0x43, 0xc6, 0xc6, 0xab, 0xef,
+	0x28, 0x19, 0x55, 0x0a, 0xc3, 0xf9, 0x5d, 0x31, 0x18, 0x0a, 0x6d, 0xc2, 0xc2, 0x4b, 0xb3, 0x3d,
+	0x9c, 0x38, 0x6e, 0xbb, 0x67, 0xb7, 0xbb, 0xa6, 0x39, 0x32, 0xc7, 0xca, 0x1c, 0x19, 0x89, 0x8b,
+	0x09, 0xb3, 0x16, 0xd2, 0x98, 0x7f, 0x69, 0x6e, 0x4f, 0x1c, 0x77, 0xc3, 0x7e, 0x4a, 0x58, 0xa8,
+	0x0a, 0xf9, 0xb1, 0x39, 0x6a, 0x53, 0x67, 0x8b, 0xd1, 0xd1, 0x43, 0xd4, 0xdc, 0xd8, 0x1c, 0x91,
+	0x06, 0xb4, 0x06, 0xb9, 0x83, 0xfe, 0x73, 0xd3, 0x39, 0x36, 0xbb, 0x4a, 0x56, 0x15, 0x2a, 0xf3,
+	0xda, 0xe5, 0x80, 0xe3, 0x2f, 0xeb, 0xf2, 0x13, 0x7b, 0x60, 0x8f, 0x0d, 0x1f, 0x8a, 0x1e, 0x41,
+	0xde, 0xb1, 0x87, 0x26, 0xd5, 0x77, 0x8e, 0x24, 0xd5, 0x6b, 0xb3, 0x78, 0x7b, 0xf6, 0xd0, 0xf4,
+	0x22, 0x98, 0x87, 0x47, 0x57, 0xa8, 0xa3, 0x07, 0xf8, 0xe8, 0xac, 0x00, 0xb9, 0x1a, 0xc0, 0x0e,
+	0x91, 0xa3, 0x34, 0x5a, 0xc2, 0x0e, 0xf5, 0x8e, 0xf0, 0x89, 0x48, 0x29, 0x90, 0xba, 0xd2, 0x7f,
+	0x5f, 0xba, 0x0b, 0x79, 0xdf, 0x60, 0x10, 0xfa, 0x68, 0xb8, 0xc9, 0xab, 0x82, 0x1f, 0xfa, 0x68,
+	0xac, 0x79, 0x0d, 0xd2, 0xc4, 0x6d, 0x9c, 0xa1, 0x8c, 0x06, 0x4e, 0x88, 0x79, 0x48, 0x6f, 0x18,
+	0x8d, 0xc6, 0x8e, 0x2c, 0x90, 0xdc, 0xb8, 0xf5, 0x4e, 0x43, 0x16, 0x39, 0xc5, 0xfe, 0x96, 0x00,
+	0x52, 0xe3, 0x84, 0xa8, 0x05, 0x4f, 0xc3, 0xfb, 0xa2, 0xf1, 0xb3, 0x56, 0x83, 0xd4, 0xd0, 0x1e,
+	0x9b, 0xe8, 0xe2, 0x8c, 0x59, 0x2a, 0x3d, 0xb2, 0x5f, 0xdc, 0x2d, 0x72, 0xe3, 0xc4, 0x35, 0x08,
+	0x5e, 0x7b, 0x03, 0x52, 0xae, 0x79, 0xe2, 0xce, 0xe6, 0x1d, 0xd3, 0x01, 0x30, 0x40, 0xbb, 0x03,
+	0x19, 0x6b, 0x32, 0x3c, 0x30, 0xc7, 0xb3, 0xa1, 0x7d, 0x32, 0x3d, 0x06, 0x29, 0xbf, 0x0b, 0xf2,
+	0x13, 0x7b, 0x38, 0x1a, 0x98, 0x27, 0x8d, 0x13, 0xd7, 0xb4, 0x9c, 0xbe, 0x6d, 0x61, 0x3d, 0x1f,
+	0xf5, 0xc7, 0x24, 0x8a, 0x60, 0x28, 0x7d, 0xc1, 0x5f, 0xb5, 0x63, 0x1e, 0xda, 0x56, 0x97, 0x05,
+	0x4c, 0xf6, 0x86, 0xd1, 0xee, 0x71, 0x7f, 0x8c, 0x03, 0x08, 0x8e, 0xf3, 0xf4, 0xa5, 0xbc, 0x01,
+	0x25, 0x56, 0x63, 0x38, 0x6c, 0xe0, 0xf2, 0x6d, 0x28, 0x7a, 0x4d, 0xe4, 0xe2, 0x3c, 0x07, 0xa9,
+	0xf7, 0x1b, 0x46, 0x4b, 0xbe, 0x80, 0x97, 0xb5, 0xb5, 0xd3, 0x90, 0x05, 0xfc, 0xb0, 0xff, 0x5e,
+	0x2b, 0xb4, 0x94, 0x57, 0xa1, 0xe8, 0xfb, 0xbe, 0x67, 0xba, 0xa4, 0x07, 0x27, 0x84, 0x6c, 0x5d,
+	0xcc, 0x09, 0xe5, 0x2c, 0xa4, 0x1b, 0xc3, 0x91, 0x7b, 0x5a, 0xfe, 0x05, 0x28, 0x30, 0xd0, 0x56,
+	0xdf, 0x71, 0xd1, 0x7d, 0xc8, 0x0e, 0xd9, 0x7c, 0x05, 0x55, 0x8a, 0x68, 0x2a, 0xc0, 0x79, 0xcf,
+	0x86, 0x87, 0x5e, 0xaa, 0x42, 0x96, 0x8b, 0xa5, 0xec, 0x53, 0x17, 0xf9, 0x4f, 0x9d, 0x06, 0x05,
+	0x89, 0x0b, 0x0a, 0xe5, 0x6d, 0xc8, 0xd2, 0x0c, 0xe8, 0x90, 0xac, 0x4e, 0x1e, 0x99, 0x98, 0xe8,
+	0xce, 0x17, 0x68, 0x1b, 0x3d, 0xa8, 0xdc, 0x80, 0x02, 0x11, 0x2c, 0x43, 0xd0, 0xd0, 0x09, 0xa4,
+	0x89, 0xca, 0xed, 0xf7, 0xd3, 0x90, 0xf3, 0x56, 0x0a, 0x5d, 0x81, 0x0c, 0xad, 0xcf, 0x14, 0x81,
+	0xbb, 0x3f, 0x48, 0x93, 0x8a, 0x0c, 0x5d, 0x81, 0x2c, 0xab, 0xc1, 0x14, 0xd1, 0xbf, 0x2c, 0xc8,
+	0xd0, 0x9a, 0xcb, 0xef, 0xac, 0xe9, 0x8a, 0xe4, 0xdf, 0x0c, 0x64, 0x68, 0x55, 0x85, 0x54, 0xc8,
+	0xfb, 0x75, 0x94, 0x92, 0x0a, 0xae, 0x01, 0x72, 0x5e, 0xe1, 0xc4, 0x21, 0x6a, 0xba, 0x92, 0x0e,
+	0x6a, 0xfe, 0x5c, 0x33, 0x38, 0x9e, 0xe4, 0xbc, 0x6a, 0x48, 0xc9, 0x70, 0x05, 0x7e, 0x96, 0xd5,
+	0x3f, 0x01, 0xa0, 0xa6, 0x2b, 0x59, 0xae, 0x9a, 0xcf, 0xb2, 0x1a, 0x07, 0xdd, 0xc0, 0x2e, 0x92,
+	0x9a, 0x45, 0xc9, 0x85, 0x4a, 0xf7, 0x0c, 0xad, 0x64, 0xd0, 0x4d, 0x6c, 0x81, 0x16, 0x26, 0x4a,
+	0x3e, 0x54, 0xa7, 0x67, 0x59, 0xbd, 0x82, 0xee, 0x60, 0x08, 0x5d, 0x7e, 0x05, 0x62, 0x8a, 0xf2,
+	0x2c, 0x2b, 0xca, 0x91, 0x8a, 0x07, 0x24, 0xe1, 0x41, 0x29, 0x84, 0x0b, 0xf0, 0x0c, 0x2d, 0xc0,
+	0xd1, 0x75, 0x62, 0x8e, 0x4e, 0xaa, 0x18, 0x14, 0xdb, 0x59, 0x56, 0xe0, 0x04, 0xfd, 0xe4, 0xc8,
+	0xe6, 0x17, 0xd6, 0x59, 0x56, 0xc2, 0xa0, 0x1a, 0xde, 0x2f, 0xac, 0x6f, 0x65, 0x9e, 0x04, 0x41,
+	0x25, 0x10, 0x9e, 0xb7, 0xa7, 0x34, 0x06, 0xd6, 0x69, 0x04, 0x31, 0xd2, 0x4d, 0xf2, 0x35, 0x2c,
+	0x61, 0xde, 0x6e, 0xdf, 0x3a, 0x52, 0x4a, 0x64, 0x25, 0xa4, 0xbe, 0x75, 0x64, 0xa4, 0x9b, 0xb8,
+	0x85, 0x6a, 0x60, 0x07, 0xf7, 0xc9, 0xa4, 0x2f, 0x75, 0x8f, 0x76, 0xe2, 0x26, 0xa4, 0x40, 0xba,
+	0xd9, 0xde, 0xe9, 0x58, 0xca, 0x02, 0xe5, 0x59, 0x1d, 0xcb, 0x48, 0x35, 0x77, 0x3a, 0x16, 0x7a,
+	0x03, 0x24, 0x67, 0x72, 0xa0, 0xa0, 0xe8, 0x2f, 0x2b, 0x7b, 0x93, 0x03, 0xcf, 0x15, 0x03, 0x23,
+	0xd0, 0x15, 0xc8, 0x39, 0xee, 0xb8, 0xfd, 0x15, 0x73, 0x6c, 0x2b, 0x17, 0xc9, 0x12, 0x5e, 0x30,
+	0xb2, 0x8e, 0x3b, 0x7e, 0xdf, 0x1c, 0xdb, 0xe7, 0x0c, 0x7e, 0xe5, 0xeb, 0x50, 0xe0, 0xec, 0xa2,
+	0x12, 0x08, 0x16, 0x3d, 0x29, 0xd4, 0x85, 0xfb, 0x86, 0x60, 0x95, 0xf7, 0xa1, 0xe8, 0xd5, 0x30,
+	0x64, 0xbe, 0x1a, 0xfe, 0x92, 0x06, 0xf6, 0x98, 0x7c, 0x9f, 0xf3, 0xda, 0x55, 0x3e, 0x45, 0x05,
+	0x30, 0x96, 0x2e, 0x28, 0xb4, 0x2c, 0x47, 0x5c, 0x11, 0xca, 0x3f, 0x14, 0xa0, 0xb8, 0x6d, 0x8f,
+	0x83, 0x0b, 0xe6, 0x45, 0x48, 0x1f, 0xd8, 0xf6, 0xc0, 0x21, 0x66, 0x73, 0x06, 0x7d, 0x41, 0xaf,
+	0x41, 0x91, 0x3c, 0x78, 0xb5, 0xa7, 0xe8, 0x5f, 0x6d, 0x14, 0x48, 0x3b, 0x2b, 0x38, 0x11, 0xa4,
+	0xfa, 0x96, 0xeb, 0xb0, 0x48, 0x46, 0x9e, 0xd1, 0x17, 0xa0, 0x80, 0xff, 0x7a, 0xcc, 0x94, 0x7f,
+	0x60, 0x05, 0xdc, 0xcc, 0x88, 0x6f, 0xc0, 0x1c, 0xd9, 0x7d, 0x1f, 0x96, 0xf5, 0xaf, 0x31, 0x8a,
+	0xb4, 0x83, 0x01, 0x15, 0xc8, 0xd2, 0x50, 0xe0, 0x90, 0x5f, 0xcb, 0xf2, 0x86, 0xf7, 0x8a, 0xc3,
+	0x2b, 0xa9, 0x04, 0x68, 0xba, 0xcf, 0x1a, 0xec, 0xad, 0xfc, 0x18, 0x72, 0x24, 0x4b, 0xb5, 0x06,
+	0x5d, 0x54, 0x06, 0xa1, 0xa7, 0x98, 0x24, 0x47, 0x2e, 0x72, 0xc7, 0x7c, 0xd6, 0xbd, 0xbc, 0x61,
+	0x08, 0xbd, 0xa5, 0x05, 0x10, 0x36, 0xf0, 0xb9, 0xfb, 0x84, 0x85, 0x69, 0xe1, 0xa4, 0xdc, 0x62,
+	0x26, 0x76, 0xcc, 0x97, 0x49, 0x26, 0x76, 0xcc, 0x97, 0xd4, 0xc4, 0x8d, 0x29, 0x13, 0xf8, 0xed,
+	0x94, 0xfd, 0x74, 0x28, 0x9c, 0x96, 0xab, 0x30, 0x47, 0x3e, 0xcf, 0xbe, 0xd5, 0xdb, 0xb5, 0xfb,
+	0x16, 0x39, 0xe7, 0x1f, 0x91, 0x73, 0x92, 0x60, 0x08, 0x47, 0x78, 0x0f, 0xcc, 0x93, 0xce, 0x21,
+	0x3d, 0x71, 0xe6, 0x0c, 0xfa, 0x52, 0xfe, 0x34, 0x05, 0xf3, 0x2c, 0xb4, 0xbe, 0xd7, 0x77, 0x8f,
+	0xb7, 0x3b, 0x23, 0xb4, 0x05, 0x45, 0x1c, 0x55, 0xdb, 0xc3, 0xce, 0x68, 0x84, 0x3f, 0x5f, 0x81,
+	0x1c, 0x35, 0x6e, 0x4d, 0x85, 0x6a, 0x86, 0x5f, 0xde, 0xe9, 0x0c, 0xcd, 0x6d, 0x8a, 0x6d, 0x58,
+	0xee, 0xf8, 0xd4, 0x28, 0x58, 0x41, 0x0b, 0xda, 0x84, 0xc2, 0xd0, 0xe9, 0xf9, 0xc6, 0x44, 0x62,
+	0xac, 0x12, 0x6b, 0x6c, 0xdb, 0xe9, 0x85, 0x6c, 0xc1, 0xd0, 0x6f, 0xc0, 0x8e, 0xe1, 0x78, 0xec,
+	0xdb, 0x92, 0xce, 0x70, 0x0c, 0x87, 0x8e, 0xb0, 0x63, 0x07, 0x41, 0x0b, 0x7a, 0x0a, 0x80, 0x3f,
+	0x2f, 0xd7, 0xc6, 0xa5, 0x13, 0x51, 0x50, 0x41, 0x7b, 0x3d, 0xd6, 0xd6, 0x9e, 0x3b, 0xde, 0xb7,
+	0xf7, 0xdc, 0x31, 0x35, 0x94, 0x73, 0xd8, 0xeb, 0xd2, 0xdb, 0x20, 0x47, 0xe7, 0xcf, 0x9f, 0xc8,
+	0xd3, 0x33, 0x4e, 0xe4, 0x79, 0x76, 0x22, 0xaf, 0x8b, 0x0f, 0x84, 0xa5, 0x77, 0xa1, 0x14, 0x99,
+	0x32, 0x4f, 0x47, 0x94, 0x7e, 0x8f, 0xa7, 0x17, 0xb4, 0x57, 0x03, 0x2f, 0x43, 0x1b, 0xce, 0xdb,
+	0x7d, 0x1b, 0xe4, 0xe8, 0xf4, 0x79, 0xc3, 0xb9, 0x84, 0x4a, 0x81, 0xf0, 0x1f, 0xc1, 0x5c, 0x68,
+	0xca, 0x3c, 0x39, 0x7f, 0xc6, 0xa4, 0xca, 0xbf, 0x98, 0x86, 0x74, 0xcb, 0x32, 0xed, 0x23, 0xf4,
+	0x6a, 0x38, 0x4f, 0x3e, 0xbb, 0xe0, 0xe5, 0xc8, 0xcb, 0x91, 0x1c, 0xf9, 0xec, 0x82, 0x9f, 0x21,
+	0x2f, 0x47, 0x32, 0xa4, 0xd7, 0x55, 0xd3, 0xd1, 0xb5, 0xa9, 0xfc, 0xf8, 0xec, 0x02, 0x97, 0x1c,
+	0xaf, 0x4d, 0x25, 0xc7, 0xa0, 0xbb, 0xa6, 0xa3, 0x2b, 0x5e, 0xe2, 0xf3, 0x32, 0xe3, 0xb3, 0x0b,
+	0x41, 0x56, 0xbc, 0x12, 0xcd, 0x8a, 0x7e, 0x67, 0x4d, 0xa7, 0x2e, 0x71, 0x19, 0x91, 0xb8, 0x44,
+	0xde, 0x29, 0x8f, 0xcf, 0x85, 0x84, 0x47, 0x1b, 0x68, 0x27, 0x9f, 0x05, 0x49, 0x27, 0x6d, 0xa0,
+	0x46, 0xb9, 0xac, 0x47, 0x8c, 0x92, 0x77, 0xc6, 0xe3, 0xd2, 0x1d, 0xe5, 0x71, 0x9e, 0xf2, 0xb9,
+	0xce, 0xef, 0xac, 0xe9, 0x48, 0x8b, 0x24, 0xba, 0xf8, 0xd3, 0x3e, 0xd9, 0x0b, 0x8c, 0x44, 0x3a,
+	0x5e, 0x36, 0xd6, 0xa7, 0x94, 0xa2, 0x79, 0x89, 0xfb, 0xc5, 0x9f, 0xac, 0x26, 0x03, 0x22, 0x0d,
+	0xb2, 0x47, 0xac, 0x00, 0x96, 0x49, 0xe4, 0xe2, 0x64, 0x49, 0x36, 0x7f, 0xb9, 0xd9, 0x26, 0x11,
+	0x0c, 0xcf, 0xeb, 0x88, 0x3c, 0xa1, 0x0a, 0xcc, 0x35, 0xdb, 0x5b, 0x9d, 0x71, 0xcf, 0x74, 0xdc,
+	0xf6, 0x7e, 0xa7, 0xe7, 0x5f, 0x22, 0xe0, 0xfd, 0x2f, 0x34, 0x59, 0x0f, 0xbe, 0x6b, 0xb8, 0xe4,
+	0x89, 0xab, 0x4b, 0x7a, 0x05, 0x26, 0xaf, 0xa5, 0x57, 0xf1, 0xa2, 0x51, 0x63, 0x24, 0x16, 0x2e,
+	0xb0, 0x58, 0xb8, 0x9e, 0x85, 0xf4, 0xc4, 0xea, 0xdb, 0xd6, 0x7a, 0x1e, 0xb2, 0xae, 0x3d, 0x1e,
+	0x76, 0x5c, 0xbb, 0xfc, 0x23, 0x01, 0xe0, 0x89, 0x3d, 0x1c, 0x4e, 0xac, 0xfe, 0x8b, 0x89, 0x89,
+	0xae, 0x43, 0x61, 0xd8, 0x79, 0x6e, 0xb6, 0x87, 0x66, 0xfb, 0x70, 0xec, 0x7d, 0x07, 0x79, 0xdc,
+	0xb4, 0x6d, 0x3e, 0x19, 0x9f, 0x22, 0xc5, 0x3b, 0xa2, 0x2b, 0x69, 0xe6, 0x12, 0x7b, 0x47, 0x8b,
+	0xec, 0xd0, 0x99, 0x61, 0x7b, 0xe8, 0x1d, 0x3b, 0x69, 0x1d, 0x91, 0x65, 0xbb, 0x47, 0xde, 0xb0,
+	0xe4, 0x5d, 0x73, 0x38, 0x6a, 0x1f, 0x2a, 0x39, 0x26, 0x87, 0x34, 0x7e, 0x7f, 0x82, 0xee, 0x81,
+	0x74, 0x68, 0x0f, 0x94, 0xfc, 0xd9, 0xfb, 0x82, 0x71, 0xe8, 0x35, 0x90, 0x86, 0x0e, 0x95, 0x4d,
+	0x41, 0x5b, 0x08, 0xe0, 0xec, 0x4c, 0x8b, 0x61, 0x43, 0xa7, 0xe7, 0xcf, 0xbb, 0xfc, 0x7b, 0x02,
+	0x14, 0xb6, 0x3a, 0x5f, 0xf1, 0x8c, 0xa1, 0x79, 0x10, 0xfb, 0x5d, 0x16, 0x84, 0xc4, 0x3e, 0xfe,
+	0xdd, 0x28, 0x3b, 0xea, 0x9c, 0x0e, 0xec, 0x4e, 0x57, 0x11, 0x13, 0xf6, 0x78, 0x1d, 0xa7, 0x0b,
+	0x0f, 0x89, 0xde, 0x84, 0xf4, 0xe1, 0x71, 0x7f, 0x40, 0xef, 0x21, 0x42, 0x14, 0x6e, 0x28, 0x42,
+	0xa1, 0x38, 0x74, 0x07, 0xd2, 0x66, 0xa7, 0xe7, 0xdf, 0x26, 0xcc, 0x1e, 0xc3, 0xa0, 0x98, 0xdb,
+	0x25, 0x90, 0x9a, 0xad, 0x16, 0x3e, 0xae, 0x34, 0x5b, 0xad, 0x55, 0x59, 0xa8, 0xbf, 0x09, 0xb9,
+	0xde, 0xd8, 0x34, 0x71, 0x44, 0x9b, 0x5d, 0x26, 0x7d, 0x40, 0xd2, 0xb3, 0x0f, 0xaa, 0x6f, 0x43,
+	0xf6, 0x90, 0x16, 0x4a, 0x28, 0xa6, 0x12, 0x57, 0xfe, 0x90, 0xde, 0x03, 0x2d, 0x05, 0xdd, 0xd1,
+	0xd2, 0xca, 0xf0, 0x6c, 0xd4, 0x77, 0x21, 0x3f, 0x6e, 0x9f, 0x65, 0xf0, 0x23, 0x9a, 0x10, 0x93,
+	0x0c, 0xe6, 0xc6, 0xac, 0xa9, 0xde, 0x80, 0x05, 0xcb, 0xf6, 0x7e, 0xf6, 0x69, 0x77, 0x69, 0x58,
+	0xb8, 0x3c, 0x7d, 0xfa, 0xf4, 0x8c, 0x9b, 0xf4, 0xa7, 0x56, 0xcb, 0x66, 0x1d, 0x34, 0x90, 0xd4,
+	0x9f, 0x80, 0xcc, 0x99, 0x21, 0xd5, 0x72, 0x92, 0x95, 0x23, 0xfa, 0xdb, 0xae, 0x6f, 0x85, 0x84,
+	0xaa, 0x88, 0x11, 0x1a, 0x4c, 0x12, 0x8c, 0xf4, 0xe8, 0x0f, 0xe5, 0xbe, 0x11, 0x12, 0x9d, 0xa7,
+	0x8d, 0xd4, 0xf4, 0x24, 0x23, 0xc7, 0xf4, 0x37, 0x74, 0xde, 0x48, 0x4d, 0x8f, 0xac, 0xca, 0xe4,
+	0x4c, 0x57, 0xfa, 0xf4, 0x27, 0x70, 0xdf, 0x0a, 0x8d, 0xd9, 0x33, 0xcc, 0x24, 0x3b, 0xf3, 0x01,
+	0xfd, 0x75, 0x3c, 0x64, 0x66, 0xca, 0x1b, 0xe7, 0x4c, 0x6f, 0x9e, 0xd3, 0x9f, 0xa2, 0x7d, 0x33,
+	0x7b, 0xb3, 0xbc, 0x71, 0xce, 0xf4, 0x66, 0x40, 0x7f, 0xa4, 0x0e, 0x99, 0xa9, 0xe9, 0xf5, 0x0d,
+	0x40, 0xfc, 0x56, 0xb3, 0xd4, 0x96, 0x60, 0x67, 0x48, 0xff, 0xf5, 0x20, 0xd8, 0x6c, 0x4a, 0x99,
+	0x65, 0x28, 0xd9, 0x21, 0x8b, 0xfe, 0x57, 0x42, 0xd8, 0x50, 0x4d, 0xaf, 0x6f, 0xc2, 0x45, 0x7e,
+	0x62, 0xe7, 0x70, 0xc9, 0x56, 0x85, 0x4a, 0xc9, 0x58, 0x08, 0xa6, 0xc6, 0x38, 0x33, 0x4d, 0x25,
+	0x3b, 0x35, 0x52, 0x85, 0x8a, 0x3c, 0x65, 0xaa, 0xa6, 0xd7, 0x1f, 0x43, 0x89, 0x33, 0x75, 0x40,
+	0x0e, 0x15, 0xf1, 0x66, 0x5e, 0xd0, 0x7f, 0x0f, 0xf1, 0xcd, 0xe0, 0x43, 0x48, 0x74, 0xc7, 0x58,
+	0x5a, 0x8e, 0x37, 0x32, 0xa6, 0xff, 0xdb, 0x10, 0xf8, 0x42, 0x18, 0x91, 0x4f, 0x82, 0x5c, 0x19,
+	0x24, 0x59, 0x71, 0xe8, 0x7f, 0x3d, 0x04, 0xae, 0x60, 0x42, 0xbd, 0x1f, 0x9a, 0x8e, 0x89, 0xf3,
+	0x72, 0x82, 0x0d, 0x97, 0x24, 0x91, 0xd7, 0x63, 0x01, 0xcb, 0xfc, 0x9d, 0x0e, 0x37, 0x6d, 0xfc,
+	0x5a, 0xdf, 0x84, 0xf9, 0xf3, 0x07, 0xa4, 0x8f, 0x04, 0x5a, 0xe0, 0x57, 0x97, 0xf1, 0x1d, 0x80,
+	0x31, 0xd7, 0x0d, 0xc5, 0xa5, 0x06, 0xcc, 0x9d, 0x3b, 0x28, 0x7d, 0x2c, 0xd0, 0x32, 0x19, 0x5b,
+	0x32, 0x8a, 0xdd, 0x70, 0x64, 0x9a, 0x3b, 0x77, 0x58, 0xfa, 0x44, 0xa0, 0x77, 0x2a, 0xba, 0xe6,
+	0x1b, 0xf1, 0x22, 0xd3, 0xdc, 0xb9, 0xc3, 0xd2, 0x57, 0x69, 0x11, 0x2c, 0xea, 0x55, 0xde, 0x08,
+	0x89, 0x05, 0xf3, 0xe7, 0x0f, 0x4b, 0x5f, 0x13, 0xc8, 0xfd, 0x8a, 0xa8, 0xeb, 0xfe, 0xba, 0xf8,
+	0x91, 0x69, 0xfe, 0xfc, 0x61, 0xe9, 0xeb, 0x02, 0xb9, 0x85, 0x11, 0xf5, 0xb5, 0x90, 0x99, 0xb0,
+	0x37, 0x67, 0x87, 0xa5, 0x6f, 0x08, 0xe4, 0x62, 0x44, 0xd4, 0x6b, 0xbe, 0x99, 0xbd, 0x29, 0x6f,
+	0xce, 0x0e, 0x4b, 0xdf, 0x14, 0xc8, 0xfd, 0x89, 0xa8, 0xdf, 0x0f, 0x99, 0x21, 0x91, 0xa9, 0xf4,
+	0x39, 0xc2, 0xd2, 0xb7, 0x04, 0x72, 0x7f, 0x25, 0xea, 0x0f, 0x8c, 0xf9, 0x2e, 0x17, 0x50, 0xaa,
+	0xda, 0x94, 0xa1, 0x64, 0x87, 0x3e, 0x15, 0xc8, 0x35, 0x97, 0xa8, 0x3f, 0x0c, 0x1b, 0x22, 0x91,
+	0x49, 0xfe, 0x3c, 0x61, 0xe9, 0xdb, 0xd8, 0x52, 0xa9, 0x2e, 0xae, 0xad, 0x18, 0xa5, 0x2e, 0x1f,
+	0x4e, 0xaa, 0xda, 0xb4, 0xa9, 0x64, 0xa7, 0xbe, 0x83, 0x4d, 0xc9, 0x75, 0x71, 0x6d, 0x35, 0x62,
+	0xaa, 0xa6, 0xd7, 0x9f, 0x40, 0xf1, 0xbc, 0x61, 0xe9, 0xbb, 0xfc, 0xf5, 0x61, 0xa1, 0xcb, 0xc5,
+	0xa6, 0x5d, 0x6e, 0xcf, 0xce, 0x0c, 0x4c, 0xdf, 0x23, 0x65, 0x59, 0x7d, 0xee, 0x19, 0xbd, 0x62,
+	0xa3, 0x84, 0x60, 0xfb, 0xc8, 0x6b, 0x7d, 0x1b, 0xe6, 0xce, 0x1d, 0xa3, 0xbe, 0x2f, 0x90, 0x7b,
+	0xb8, 0x22, 0x33, 0x48, 0xf0, 0xfe, 0x97, 0x42, 0x03, 0xd6, 0x07, 0x50, 0x3c, 0x6f, 0xb4, 0xfa,
+	0x81, 0xf0, 0x79, 0xc2, 0x55, 0x1d, 0x5f, 0x3b, 0xfb, 0x8b, 0x41, 0x5a, 0xde, 0x82, 0xd4, 0x89,
+	0xb6, 0xb2, 0x8a, 0x2e, 0xcd, 0x38, 0x13, 0xee, 0x99, 0x2e, 0x0d, 0x52, 0x05, 0xad, 0x14, 0x74,
+	0x93, 0xfb, 0x67, 0x83, 0xb0, 0x18, 0x5b, 0x8b, 0x65, 0x7f, 0x9c, 0xc0, 0xd6, 0x18, 0xbb, 0x1a,
+	0xcb, 0xfe, 0x24, 0x81, 0x5d, 0x65, 0x6c, 0x3d, 0x96, 0xfd, 0xd5, 0x04, 0xb6, 0xce, 0xd8, 0x6b,
+	0xb1, 0xec, 0xaf, 0x25, 0xb0, 0xd7, 0x18, 0xbb, 0x16, 0xcb, 0xfe, 0x7a, 0x02, 0xbb, 0xc6, 0xd8,
+	0xf7, 0x63, 0xd9, 0xdf, 0x48, 0x60, 0xdf, 0x67, 0xec, 0x07, 0xb1, 0xec, 0x6f, 0x26, 0xb0, 0x1f,
+	0x30, 0xf6, 0xc3, 0x58, 0xf6, 0xb7, 0x12, 0xd8, 0x0f, 0x29, 0x7b, 0x75, 0x25, 0x96, 0xfd, 0x69,
+	0x3c, 0x7b, 0x75, 0x85, 0xb1, 0xe3, 0xb5, 0xf6, 0xed, 0x04, 0x36, 0xd3, 0xda, 0x6a, 0xbc, 0xd6,
+	0xbe, 0x93, 0xc0, 0x66, 0x5a, 0x5b, 0x8d, 0xd7, 0xda, 0x77, 0x13, 0xd8, 0x4c, 0x6b, 0xab, 0xf1,
+	0x5a, 0xfb, 0x5e, 0x02, 0x9b, 0x69, 0x6d, 0x35, 0x5e, 0x6b, 0xdf, 0x4f, 0x60, 0x33, 0xad, 0xad,
+	0xc6, 0x6b, 0xed, 0x07, 0x09, 0x6c, 0xa6, 0xb5, 0xd5, 0x78, 0xad, 0xfd, 0x51, 0x02, 0x9b, 0x69,
+	0x6d, 0x35, 0x5e, 0x6b, 0x7f, 0x9c, 0xc0, 0x66, 0x5a, 0x5b, 0x8d, 0xd7, 0xda, 0x9f, 0x24, 0xb0,
+	0x99, 0xd6, 0xb4, 0x78, 0xad, 0xfd, 0x69, 0x3c, 0x5b, 0x63, 0x5a, 0xd3, 0xe2, 0xb5, 0xf6, 0x67,
+	0x09, 0x6c, 0xa6, 0x35, 0x2d, 0x5e, 0x6b, 0x7f, 0x9e, 0xc0, 0x66, 0x5a, 0xd3, 0xe2, 0xb5, 0xf6,
+	0xc3, 0x04, 0x36, 0xd3, 0x9a, 0x16, 0xaf, 0xb5, 0xbf, 0x48, 0x60, 0x33, 0xad, 0x69, 0xf1, 0x5a,
+	0xfb, 0xcb, 0x04, 0x36, 0xd3, 0x9a, 0x16, 0xaf, 0xb5, 0xbf, 0x4a, 0x60, 0x33, 0xad, 0x69, 0xf1,
+	0x5a, 0xfb, 0xeb, 0x04, 0x36, 0xd3, 0x9a, 0x16, 0xaf, 0xb5, 0xbf, 0x49, 0x60, 0x33, 0xad, 0x69,
+	0xf1, 0x5a, 0xfb, 0xdb, 0x04, 0x36, 0xd3, 0x5a, 0x35, 0x5e, 0x6b, 0x7f, 0x17, 0xcf, 0xae, 0x32,
+	0xad, 0x55, 0xe3, 0xb5, 0xf6, 0xf7, 0x09, 0x6c, 0xa6, 0xb5, 0x6a, 0xbc, 0xd6, 0xfe, 0x21, 0x81,
+	0xcd, 0xb4, 0x56, 0x8d, 0xd7, 0xda, 0x3f, 0x26, 0xb0, 0x99, 0xd6, 0xaa, 0xf1, 0x5a, 0xfb, 0x51,
+	0x02, 0x9b, 0x69, 0xad, 0x1a, 0xaf, 0xb5, 0x7f, 0x4a, 0x60, 0x33, 0xad, 0x55, 0xe3, 0xb5, 0xf6,
+	0xcf, 0x09, 0x6c, 0xa6, 0xb5, 0x6a, 0xbc, 0xd6, 0xfe, 0x25, 0x81, 0xcd, 0xb4, 0x56, 0x8d, 0xd7,
+	0xda, 0xbf, 0x26, 0xb0, 0x99, 0xd6, 0xaa, 0xf1, 0x5a, 0xfb, 0xb7, 0x04, 0x36, 0xd3, 0x9a, 0x1e,
+	0xaf, 0xb5, 0x7f, 0x8f, 0x67, 0xeb, 0x4c, 0x6b, 0x7a, 0xbc, 0xd6, 0xfe, 0x23, 0x81, 0xcd, 0xb4,
+	0xa6, 0xc7, 0x6b, 0xed, 0x3f, 0x13, 0xd8, 0x4c, 0x6b, 0x7a, 0xbc, 0xd6, 0xfe, 0x2b, 0x81, 0xcd,
+	0xb4, 0xa6, 0xc7, 0x6b, 0xed, 0xbf, 0x13, 0xd8, 0x4c, 0x6b, 0x7a, 0xbc, 0xd6, 0xfe, 0x27, 0x81,
+	0xcd, 0xb4, 0xa6, 0xc7, 0x6b, 0xed, 0xc7, 0x09, 0x6c, 0xa6, 0x35, 0x3d, 0x5e, 0x6b, 0x3f, 0x49,
+	0x60, 0x33, 0xad, 0xe9, 0xf1, 0x5a, 0xfb, 0xdf, 0x04, 0x36, 0xd3, 0x9a, 0x1e, 0xaf, 0xb5, 0xff,
+	0x4b, 0x60, 0x33, 0xad, 0xad, 0xc5, 0x6b, 0xed, 0xff, 0xe3, 0xd9, 0x6b, 0x2b, 0x3f, 0x1d, 0x00,
+	0x31, 0x92, 0x2d, 0x52, 0x0a, 0x3a, 0x00, 0x00,
 }
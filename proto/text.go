@@ -45,21 +45,21 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 var (
-	newline         = []byte("\n")
-	spaces          = []byte("                                        ")
-	gtNewline       = []byte(">\n")
-	endBraceNewline = []byte("}\n")
-	backslashN      = []byte{'\\', 'n'}
-	backslashR      = []byte{'\\', 'r'}
-	backslashT      = []byte{'\\', 't'}
-	backslashDQ     = []byte{'\\', '"'}
-	backslashBS     = []byte{'\\', '\\'}
-	posInf          = []byte("inf")
-	negInf          = []byte("-inf")
-	nan             = []byte("nan")
+	newline     = []byte("\n")
+	spaces      = []byte("                                        ")
+	gtNewline   = []byte(">\n")
+	backslashN  = []byte{'\\', 'n'}
+	backslashR  = []byte{'\\', 'r'}
+	backslashT  = []byte{'\\', 't'}
+	backslashDQ = []byte{'\\', '"'}
+	backslashBS = []byte{'\\', '\\'}
+	posInf      = []byte("inf")
+	negInf      = []byte("-inf")
+	nan         = []byte("nan")
 )
 
 type writer interface {
@@ -72,6 +72,7 @@ type textWriter struct {
 	ind      int
 	complete bool // if the current position is a complete line
 	compact  bool // whether to write out as a one-liner
+	depth    int  // number of nested messages being written
 	w        writer
 }
 
@@ -160,14 +161,26 @@ func (w *textWriter) unindent() {
 	w.ind--
 }
 
-func writeName(w *textWriter, props *Properties) error {
+// writeName writes the name of a field and, unless the field is a group or
+// block is set and tm.OmitMessageColon asks for it, the following colon.
+// block reports whether the value is written as a <...> block.
+func (tm *TextMarshaler) writeName(w *textWriter, props *Properties, block bool) error {
 	if _, err := w.WriteString(props.OrigName); err != nil {
 		return err
 	}
-	if props.Wire != "group" {
-		return w.WriteByte(':')
+	if props.Wire == "group" || block && tm.OmitMessageColon {
+		return nil
 	}
-	return nil
+	return w.WriteByte(':')
+}
+
+// isBlock reports whether v is written as a <...> block, that is, whether it
+// is a message or a raw message.
+func isBlock(v reflect.Value) bool {
+	if _, ok := v.Interface().(raw); ok {
+		return true
+	}
+	return reflect.Indirect(v).Kind() == reflect.Struct
 }
 
 // raw is the interface satisfied by RawMessage.
@@ -223,7 +236,7 @@ func (tm *TextMarshaler) writeProto3Any(w *textWriter, sv reflect.Value) (bool,
 	}
 
 	parts := strings.Split(turl.String(), "/")
-	mt := MessageType(parts[len(parts)-1])
+	mt := tm.Registry.MessageType(parts[len(parts)-1])
 	if mt == nil {
 		return false, nil
 	}
@@ -238,10 +251,14 @@ func (tm *TextMarshaler) writeProto3Any(w *textWriter, sv reflect.Value) (bool,
 	} else {
 		w.Write([]byte(u))
 	}
+	w.Write([]byte("]"))
+	if !tm.OmitMessageColon {
+		w.Write([]byte(":"))
+	}
 	if w.compact {
-		w.Write([]byte("]:<"))
+		w.Write([]byte("<"))
 	} else {
-		w.Write([]byte("]: <\n"))
+		w.Write([]byte(" <\n"))
 		w.ind++
 	}
 	if err := tm.writeStruct(w, m.Elem()); err != nil {
@@ -257,6 +274,7 @@ func (tm *TextMarshaler) writeProto3Any(w *textWriter, sv reflect.Value) (bool,
 }
 
 func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
+	resolveLazy(sv)
 	if tm.ExpandAny && isAny(sv) {
 		if canExpand, err := tm.writeProto3Any(w, sv); canExpand {
 			return err
@@ -264,7 +282,15 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 	}
 	st := sv.Type()
 	sprops := GetProperties(st)
-	for i := 0; i < sv.NumField(); i++ {
+	var order []int
+	if tm.FieldNumberOrder {
+		order = fieldNumberOrder(sv, sprops)
+	}
+	for k := 0; k < sv.NumField(); k++ {
+		i := k
+		if order != nil {
+			i = order[k]
+		}
 		fv := sv.Field(i)
 		props := sprops.Prop[i]
 		name := st.Field(i).Name
@@ -294,9 +320,16 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 		}
 
 		if props.Repeated && fv.Kind() == reflect.Slice {
+			if tm.ShortRepeated && fv.Len() > 0 && fv.Type().Elem().Kind() != reflect.Ptr {
+				// Repeated scalar field, written as a list.
+				if err := tm.writeList(w, props.OrigName, fv, props); err != nil {
+					return err
+				}
+				continue
+			}
 			// Repeated field.
 			for j := 0; j < fv.Len(); j++ {
-				if err := writeName(w, props); err != nil {
+				if err := tm.writeName(w, props, isBlock(fv.Index(j))); err != nil {
 					return err
 				}
 				if !w.compact {
@@ -328,7 +361,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			sort.Sort(mapKeys(keys))
 			for _, key := range keys {
 				val := fv.MapIndex(key)
-				if err := writeName(w, props); err != nil {
+				if err := tm.writeName(w, props, true); err != nil {
 					return err
 				}
 				if !w.compact {
@@ -364,9 +397,14 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 				// nil values aren't legal, but we can avoid panicking because of them.
 				if val.Kind() != reflect.Ptr || !val.IsNil() {
 					// value
-					if _, err := w.WriteString("value:"); err != nil {
+					if _, err := w.WriteString("value"); err != nil {
 						return err
 					}
+					if !isBlock(val) || !tm.OmitMessageColon {
+						if err := w.WriteByte(':'); err != nil {
+							return err
+						}
+					}
 					if !w.compact {
 						if err := w.WriteByte(' '); err != nil {
 							return err
@@ -400,6 +438,10 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 				continue
 			}
 		}
+		if tm.ElideDefaults && !props.Repeated && isDefaultValue(fv, props) {
+			// Scalar field set to its default value.
+			continue
+		}
 
 		if fv.Kind() == reflect.Interface {
 			// Check if it is a oneof.
@@ -428,7 +470,7 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 			}
 		}
 
-		if err := writeName(w, props); err != nil {
+		if err := tm.writeName(w, props, isBlock(fv)); err != nil {
 			return err
 		}
 		if !w.compact {
@@ -464,6 +506,137 @@ func (tm *TextMarshaler) writeStruct(w *textWriter, sv reflect.Value) error {
 	return nil
 }
 
+// fieldNumberOrder returns the indexes of the fields of sv sorted by field
+// number. A oneof sorts by the number of the field that is set in it, and the
+// XXX_ fields go last, in declaration order.
+func fieldNumberOrder(sv reflect.Value, sprops *StructProperties) []int {
+	fs := fieldsByNumber{
+		order: make([]int, sv.NumField()),
+		tags:  make([]int, sv.NumField()),
+	}
+	for i := range fs.order {
+		fs.order[i] = i
+		fs.tags[i] = sprops.Prop[i].Tag
+		if strings.HasPrefix(sv.Type().Field(i).Name, "XXX_") {
+			fs.tags[i] = maxFieldNumber + 1
+			continue
+		}
+		if fv := sv.Field(i); fv.Kind() == reflect.Interface && !fv.IsNil() {
+			for _, oop := range sprops.OneofTypes {
+				if oop.Type == fv.Elem().Type() {
+					fs.tags[i] = oop.Prop.Tag
+					break
+				}
+			}
+		}
+	}
+	sort.Stable(fs)
+	return fs.order
+}
+
+// fieldsByNumber sorts struct field indexes by field number.
+type fieldsByNumber struct {
+	order []int // struct field indexes
+	tags  []int // field number of each struct field
+}
+
+func (s fieldsByNumber) Len() int           { return len(s.order) }
+func (s fieldsByNumber) Less(i, j int) bool { return s.tags[s.order[i]] < s.tags[s.order[j]] }
+func (s fieldsByNumber) Swap(i, j int)      { s.order[i], s.order[j] = s.order[j], s.order[i] }
+
+// isDefaultValue reports whether the singular scalar field fv holds its
+// default value: the one declared in the .proto file, or else the zero value.
+// Messages, oneofs and maps never do.
+func isDefaultValue(fv reflect.Value, props *Properties) bool {
+	sf, _, err := fieldDefault(fv.Type(), props)
+	if err != nil || sf == nil {
+		return false
+	}
+	if fv.Kind() == reflect.Slice {
+		// bytes
+		def, _ := sf.value.([]byte)
+		return bytes.Equal(fv.Bytes(), def)
+	}
+	v := fv.Elem()
+	if sf.value == nil {
+		return isProto3Zero(v)
+	}
+	def := reflect.ValueOf(sf.value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool() == def.Bool()
+	case reflect.Int32, reflect.Int64:
+		return v.Int() == def.Int()
+	case reflect.Uint32, reflect.Uint64:
+		return v.Uint() == def.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == def.Float()
+	case reflect.String:
+		return v.String() == def.String()
+	}
+	return false
+}
+
+// writeList writes the elements of the repeated scalar field v in list
+// notation, as in "name: [1, 2, 3]". A list that would run past
+// tm.LineWidth is broken after the "[", with as many elements on each of
+// the following lines as fit.
+func (tm *TextMarshaler) writeList(w *textWriter, name string, v reflect.Value, props *Properties) error {
+	elems := make([]string, v.Len())
+	width := w.ind*2 + len(name) + len(": []")
+	for i := range elems {
+		var b bytes.Buffer
+		if err := tm.writeAny(&textWriter{compact: true, w: &b}, v.Index(i), props); err != nil {
+			return err
+		}
+		elems[i] = b.String()
+		width += len(elems[i])
+		if i > 0 {
+			width += len(", ")
+		}
+	}
+	if w.compact {
+		_, err := fmt.Fprintf(w, "%s:[%s]\n", name, strings.Join(elems, ","))
+		return err
+	}
+	if tm.LineWidth <= 0 || width <= tm.LineWidth {
+		_, err := fmt.Fprintf(w, "%s: [%s]\n", name, strings.Join(elems, ", "))
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "%s: [\n", name); err != nil {
+		return err
+	}
+	w.indent()
+	avail := tm.LineWidth - w.ind*2
+	n := 0 // length of the current line
+	for i, e := range elems {
+		if i+1 < len(elems) {
+			e += ","
+		}
+		var sep byte
+		switch {
+		case n == 0:
+		case n+1+len(e) > avail:
+			sep, n = '\n', 0
+		default:
+			sep, n = ' ', n+1
+		}
+		if sep != 0 {
+			if err := w.WriteByte(sep); err != nil {
+				return err
+			}
+		}
+		if _, err := w.WriteString(e); err != nil {
+			return err
+		}
+		n += len(e)
+	}
+	w.unindent()
+	_, err := w.Write([]byte("\n]\n"))
+	return err
+}
+
 // writeRaw writes an uninterpreted raw message.
 func writeRaw(w *textWriter, b []byte) error {
 	if err := w.WriteByte('<'); err != nil {
@@ -529,12 +702,20 @@ func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Propert
 		if err := w.WriteByte(bra); err != nil {
 			return err
 		}
+		if tm.MaxDepth > 0 && w.depth >= tm.MaxDepth {
+			// Too deeply nested; mark the message as cut off.
+			if _, err := w.WriteString("..."); err != nil {
+				return err
+			}
+			return w.WriteByte(ket)
+		}
 		if !w.compact {
 			if err := w.WriteByte('\n'); err != nil {
 				return err
 			}
 		}
 		w.indent()
+		w.depth++
 		if etm, ok := v.Interface().(encoding.TextMarshaler); ok {
 			text, err := etm.MarshalText()
 			if err != nil {
@@ -546,6 +727,7 @@ func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Propert
 		} else if err := tm.writeStruct(w, v); err != nil {
 			return err
 		}
+		w.depth--
 		w.unindent()
 		if err := w.WriteByte(ket); err != nil {
 			return err
@@ -609,6 +791,15 @@ func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 			return err
 		}
 	}
+	return writeUnknownFields(w, data)
+}
+
+// writeUnknownFields writes wire-format data field by field, in the style of
+// protoc --decode_raw: each field is named by its number, and length-delimited
+// values that hold a well-formed message are written as a nested {...} block.
+// So that the text parser can restore the wire types, groups are written as
+// a <...> block, and fixed-width values in hexadecimal with all their digits.
+func writeUnknownFields(w *textWriter, data []byte) (err error) {
 	b := NewBuffer(data)
 	for b.index < len(b.buf) {
 		x, err := b.DecodeVarint()
@@ -619,40 +810,47 @@ func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 		wire, tag := x&7, x>>3
 		if wire == WireEndGroup {
 			w.unindent()
-			if _, err := w.Write(endBraceNewline); err != nil {
+			if _, err := w.Write(gtNewline); err != nil {
 				return err
 			}
 			continue
 		}
+		var buf []byte
+		var bufErr error
+		if wire == WireBytes {
+			buf, bufErr = b.DecodeRawBytes(false)
+		}
+		block := wire == WireStartGroup || wire == WireBytes && bufErr == nil && isUnknownMessage(buf)
 		if _, err := fmt.Fprint(w, tag); err != nil {
 			return err
 		}
-		if wire != WireStartGroup {
+		if !block {
 			if err := w.WriteByte(':'); err != nil {
 				return err
 			}
 		}
-		if !w.compact || wire == WireStartGroup {
+		if !w.compact || block {
 			if err := w.WriteByte(' '); err != nil {
 				return err
 			}
 		}
 		switch wire {
 		case WireBytes:
-			buf, e := b.DecodeRawBytes(false)
-			if e == nil {
-				_, err = fmt.Fprintf(w, "%q", buf)
+			if bufErr != nil {
+				_, err = fmt.Fprintf(w, "/* %v */", bufErr)
+			} else if block {
+				err = writeUnknownMessage(w, buf)
 			} else {
-				_, err = fmt.Fprintf(w, "/* %v */", e)
+				_, err = fmt.Fprintf(w, "%q", buf)
 			}
 		case WireFixed32:
 			x, err = b.DecodeFixed32()
-			err = writeUnknownInt(w, x, err)
+			err = writeUnknownFixed(w, "0x%08x", x, err)
 		case WireFixed64:
 			x, err = b.DecodeFixed64()
-			err = writeUnknownInt(w, x, err)
+			err = writeUnknownFixed(w, "0x%016x", x, err)
 		case WireStartGroup:
-			err = w.WriteByte('{')
+			err = w.WriteByte('<')
 			w.indent()
 		case WireVarint:
 			x, err = b.DecodeVarint()
@@ -670,6 +868,102 @@ func writeUnknownStruct(w *textWriter, data []byte) (err error) {
 	return nil
 }
 
+// writeUnknownMessage writes the unknown fields of an embedded message
+// as a {...} block.
+func writeUnknownMessage(w *textWriter, data []byte) error {
+	if _, err := w.Write([]byte("{\n")); err != nil {
+		return err
+	}
+	w.indent()
+	if err := writeUnknownFields(w, data); err != nil {
+		return err
+	}
+	w.unindent()
+	return w.WriteByte('}')
+}
+
+// isUnknownMessage reports whether the length-delimited value b is better
+// shown as an embedded message than as a string. Text is always shown as a
+// string; otherwise b must be a sequence of well-formed fields, encoded
+// exactly as the text parser would re-encode them.
+func isUnknownMessage(b []byte) bool {
+	if len(b) == 0 || isUnknownText(b) {
+		return false
+	}
+	var groups []uint64
+	buf := NewBuffer(b)
+	for buf.index < len(buf.buf) {
+		tag, ok := decodeCanonicalVarint(buf)
+		if !ok {
+			return false
+		}
+		wire, num := tag&7, tag>>3
+		if num == 0 || num > maxFieldNumber {
+			return false
+		}
+		switch wire {
+		case WireVarint:
+			if _, ok := decodeCanonicalVarint(buf); !ok {
+				return false
+			}
+		case WireFixed64:
+			if _, err := buf.DecodeFixed64(); err != nil {
+				return false
+			}
+		case WireBytes:
+			n, ok := decodeCanonicalVarint(buf)
+			if !ok || n > uint64(len(buf.buf)-buf.index) {
+				return false
+			}
+			buf.index += int(n)
+		case WireStartGroup:
+			groups = append(groups, num)
+		case WireEndGroup:
+			if len(groups) == 0 || groups[len(groups)-1] != num {
+				return false
+			}
+			groups = groups[:len(groups)-1]
+		case WireFixed32:
+			if _, err := buf.DecodeFixed32(); err != nil {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return len(groups) == 0
+}
+
+// isUnknownText reports whether b is printable UTF-8 text.
+func isUnknownText(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, c := range b {
+		if (c < 0x20 && c != '\n' && c != '\r' && c != '\t') || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeCanonicalVarint decodes a varint, rejecting one that is padded
+// with redundant bytes.
+func decodeCanonicalVarint(b *Buffer) (uint64, bool) {
+	start := b.index
+	x, err := b.DecodeVarint()
+	return x, err == nil && b.index-start == SizeVarint(x)
+}
+
+func writeUnknownFixed(w *textWriter, format string, x uint64, err error) error {
+	if err == nil {
+		_, err = fmt.Fprintf(w, format, x)
+	} else {
+		_, err = fmt.Fprintf(w, "/* %v */", err)
+	}
+	return err
+}
+
 func writeUnknownInt(w *textWriter, x uint64, err error) error {
 	if err == nil {
 		_, err = fmt.Fprint(w, x)
@@ -688,7 +982,7 @@ func (s int32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 // writeExtensions writes all the extensions in pv.
 // pv is assumed to be a pointer to a protocol message struct that is extendable.
 func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error {
-	emap := extensionMaps[pv.Type().Elem()]
+	emap := tm.Registry.extensions(pv.Type().Elem())
 	ep, _ := extendable(pv.Interface())
 
 	// Order the extensions by ID.
@@ -730,8 +1024,11 @@ func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error
 			if err := tm.writeExtension(w, desc.Name, pb); err != nil {
 				return err
 			}
+		} else if v := reflect.ValueOf(pb); tm.ShortRepeated && v.Len() > 0 && v.Type().Elem().Kind() != reflect.Ptr {
+			if err := tm.writeList(w, "["+desc.Name+"]", v, nil); err != nil {
+				return err
+			}
 		} else {
-			v := reflect.ValueOf(pb)
 			for i := 0; i < v.Len(); i++ {
 				if err := tm.writeExtension(w, desc.Name, v.Index(i).Interface()); err != nil {
 					return err
@@ -743,9 +1040,14 @@ func (tm *TextMarshaler) writeExtensions(w *textWriter, pv reflect.Value) error
 }
 
 func (tm *TextMarshaler) writeExtension(w *textWriter, name string, pb interface{}) error {
-	if _, err := fmt.Fprintf(w, "[%s]:", name); err != nil {
+	if _, err := fmt.Fprintf(w, "[%s]", name); err != nil {
 		return err
 	}
+	if !isBlock(reflect.ValueOf(pb)) || !tm.OmitMessageColon {
+		if err := w.WriteByte(':'); err != nil {
+			return err
+		}
+	}
 	if !w.compact {
 		if err := w.WriteByte(' '); err != nil {
 			return err
@@ -780,6 +1082,37 @@ func (w *textWriter) writeIndent() {
 type TextMarshaler struct {
 	Compact   bool // use compact text format (one line).
 	ExpandAny bool // expand google.protobuf.Any messages of known types
+
+	// FieldNumberOrder writes fields in field number order rather than
+	// in the order they are declared in. Extensions always come last.
+	FieldNumberOrder bool
+
+	// ShortRepeated writes repeated scalar fields in list notation,
+	// as in "name: [1, 2, 3]", rather than one line per element.
+	ShortRepeated bool
+
+	// LineWidth, if positive, is the width at which lists written because
+	// of ShortRepeated are wrapped onto several lines. It has no effect
+	// on compact output.
+	LineWidth int
+
+	// ElideDefaults leaves out singular scalar fields that hold their
+	// default value, even though they are set. Fields in a oneof are
+	// always written.
+	ElideDefaults bool
+
+	// MaxDepth, if positive, is the number of levels of nested messages
+	// that are written. Messages nested more deeply are written as <...>,
+	// which the text parser does not accept.
+	MaxDepth int
+
+	// OmitMessageColon leaves out the colon between the name of a message
+	// field and its <...> block, as in "name <" rather than "name: <".
+	OmitMessageColon bool
+
+	// Registry resolves the message types of expanded Any values and
+	// the names of extensions. If nil, GlobalRegistry is used.
+	Registry *Registry
 }
 
 // Marshal writes a given protocol buffer in text format.
@@ -833,6 +1166,31 @@ func (tm *TextMarshaler) Text(pb Message) string {
 	return buf.String()
 }
 
+// MarshalUnknown writes the wire-format data b field by field, in the form
+// Marshal uses for unknown fields, so that other message implementations
+// can print theirs the same way. Only Compact applies; lines are not
+// indented beyond the nesting within b.
+func (tm *TextMarshaler) MarshalUnknown(w io.Writer, b []byte) error {
+	var bw *bufio.Writer
+	ww, ok := w.(writer)
+	if !ok {
+		bw = bufio.NewWriter(w)
+		ww = bw
+	}
+	aw := &textWriter{
+		w:        ww,
+		complete: true,
+		compact:  tm.Compact,
+	}
+	if err := writeUnknownFields(aw, b); err != nil {
+		return err
+	}
+	if bw != nil {
+		return bw.Flush()
+	}
+	return nil
+}
+
 var (
 	defaultTextMarshaler = TextMarshaler{}
 	compactTextMarshaler = TextMarshaler{Compact: true}
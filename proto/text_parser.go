@@ -35,10 +35,12 @@ package proto
 // TODO: message sets.
 
 import (
+	"bytes"
 	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -47,10 +49,18 @@ import (
 // Error string emitted when deserializing Any and fields are already set
 const anyRepeatedlyUnpacked = "Any message unpacked multiple times, or %q already set"
 
+// A ParseError reports malformed text format input. Error gives a one-line
+// summary; Detail also shows the offending line, the path of the field
+// being parsed and any suggestions.
 type ParseError struct {
 	Message string
 	Line    int // 1-based line number
 	Offset  int // 0-based byte offset from start of input
+
+	Column      int      // 1-based byte (not rune) offset from start of line
+	Source      string   // the line of input the error is on, without its newline
+	Path        string   // the field being parsed, like "inner.host" or "others[1].key"
+	Suggestions []string // known names close to an unknown one, best first
 }
 
 func (p *ParseError) Error() string {
@@ -61,6 +71,53 @@ func (p *ParseError) Error() string {
 	return fmt.Sprintf("line %d: %v", p.Line, p.Message)
 }
 
+// Detail returns a description of the error over several lines, like
+//
+//	line 2:3: unknown field name "hots" in testdata.InnerMessage
+//	  hots: "footrest.syd"
+//	  ^
+//	in field inner.hots
+//	did you mean "host"?
+func (p *ParseError) Detail() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "line %d:%d: %v\n", p.Line, p.Column, p.Message)
+	if p.Source != "" {
+		fmt.Fprintf(&b, "\t%s\n\t", p.Source)
+		// Pad with one blank per rune, not per byte, so that the caret
+		// lines up under multi-byte characters too.
+		prefix := p.Source
+		if p.Column-1 < len(prefix) {
+			prefix = prefix[:p.Column-1]
+		}
+		for _, r := range prefix {
+			if r == '\t' {
+				b.WriteByte('\t')
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString("^\n")
+	}
+	if p.Path != "" {
+		fmt.Fprintf(&b, "in field %s\n", p.Path)
+	}
+	if n := len(p.Suggestions); n > 0 {
+		b.WriteString("did you mean ")
+		for i, s := range p.Suggestions {
+			switch {
+			case i == 0:
+			case i == n-1:
+				b.WriteString(" or ")
+			default:
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%q", s)
+		}
+		b.WriteString("?\n")
+	}
+	return b.String()
+}
+
 type token struct {
 	value    string
 	err      *ParseError
@@ -77,15 +134,24 @@ func (t *token) String() string {
 }
 
 type textParser struct {
+	src          string // the whole input, for error messages
 	s            string // remaining input
 	done         bool   // whether the parsing is finished (success or error)
 	backed       bool   // whether back() was called
 	offset, line int
 	cur          token
+	reg          *Registry // resolves extensions, enums and Any types; nil means GlobalRegistry
+
+	discardUnknown bool // skip unknown fields, extensions and Any types
+	skippedAny     bool // an Any of an unresolvable type was skipped
+	allowPartial   bool // don't check that required fields are set
+
+	path []string // names of the fields being parsed, outermost first
 }
 
 func newTextParser(s string) *textParser {
 	p := new(textParser)
+	p.src = s
 	p.s = s
 	p.line = 1
 	p.cur.line = 1
@@ -93,12 +159,125 @@ func newTextParser(s string) *textParser {
 }
 
 func (p *textParser) errorf(format string, a ...interface{}) *ParseError {
-	pe := &ParseError{fmt.Sprintf(format, a...), p.cur.line, p.cur.offset}
+	pe := &ParseError{Message: fmt.Sprintf(format, a...), Path: p.fieldPath()}
+	p.locate(pe, p.cur.line, p.cur.offset)
 	p.cur.err = pe
 	p.done = true
 	return pe
 }
 
+// locate sets the position of pe to the given line and byte offset.
+func (p *textParser) locate(pe *ParseError, line, offset int) {
+	start := strings.LastIndex(p.src[:offset], "\n") + 1
+	end := strings.IndexByte(p.src[start:], '\n')
+	if end < 0 {
+		end = len(p.src) - start
+	}
+	pe.Line, pe.Offset = line, offset
+	pe.Column = offset - start + 1
+	pe.Source = strings.TrimSuffix(p.src[start:start+end], "\r")
+}
+
+// fieldPath returns the path of the field being parsed.
+func (p *textParser) fieldPath() string {
+	var path []string
+	for _, name := range p.path {
+		if name != "" {
+			path = append(path, name)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// setField records name as the field of the current message being parsed.
+func (p *textParser) setField(name string) {
+	p.path[len(p.path)-1] = name
+}
+
+// suggest returns the names, out of candidates, that are close enough to
+// name to be what was meant, closest first.
+func suggest(name string, candidates []string) []string {
+	const max = 3
+	limit := 1 + len(name)/4
+	var ss []suggestion
+	for _, c := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(c)); d <= limit && c != name {
+			ss = append(ss, suggestion{c, d})
+		}
+	}
+	sort.Sort(suggestions(ss))
+	var names []string
+	for i := 0; i < len(ss) && i < max; i++ {
+		names = append(names, ss[i].name)
+	}
+	return names
+}
+
+type suggestion struct {
+	name string
+	dist int
+}
+
+type suggestions []suggestion
+
+func (s suggestions) Len() int { return len(s) }
+func (s suggestions) Less(i, j int) bool {
+	if s[i].dist != s[j].dist {
+		return s[i].dist < s[j].dist
+	}
+	return s[i].name < s[j].name
+}
+func (s suggestions) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+// editDistance returns the number of single-byte insertions, deletions,
+// substitutions and transpositions of adjacent bytes needed to turn a into b.
+func editDistance(a, b string) int {
+	// d[i][j] is the distance between a[:i] and b[:j].
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, minInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// fieldNames returns the names that can be written for the fields of a
+// message with properties sprops.
+func fieldNames(sprops *StructProperties) []string {
+	var names []string
+	for _, prop := range sprops.Prop {
+		if prop.OrigName != "" {
+			names = append(names, prop.OrigName)
+		}
+	}
+	for name := range sprops.OneofTypes {
+		names = append(names, name)
+	}
+	return names
+}
+
 // Numbers and identifiers are matched by [-+._A-Za-z0-9]
 func isIdentOrNumberChar(c byte) bool {
 	switch {
@@ -132,7 +311,7 @@ func isQuote(c byte) bool {
 
 func (p *textParser) skipWhitespace() {
 	i := 0
-	for i < len(p.s) && (isWhitespace(p.s[i]) || p.s[i] == '#') {
+	for i < len(p.s) && (isWhitespace(p.s[i]) || p.s[i] == '#' || strings.HasPrefix(p.s[i:], "/*")) {
 		if p.s[i] == '#' {
 			// comment; skip to end of line or input
 			for i < len(p.s) && p.s[i] != '\n' {
@@ -142,6 +321,18 @@ func (p *textParser) skipWhitespace() {
 				break
 			}
 		}
+		if p.s[i] == '/' {
+			// block comment, as written before unknown fields
+			n := strings.Index(p.s[i+2:], "*/")
+			if n < 0 {
+				p.cur.offset, p.cur.line = p.offset+i, p.line
+				p.errorf("unterminated comment")
+				return
+			}
+			p.line += strings.Count(p.s[i:i+n+4], "\n")
+			i += n + 4
+			continue
+		}
 		if p.s[i] == '\n' {
 			p.line++
 		}
@@ -350,6 +541,9 @@ func (p *textParser) next() *token {
 		cat := p.cur
 		for {
 			p.skipWhitespace()
+			if p.cur.err != nil {
+				return &p.cur
+			}
 			if p.done || !isQuote(p.s[0]) {
 				break
 			}
@@ -389,10 +583,10 @@ func (p *textParser) missingRequiredFieldError(sv reflect.Value) *RequiredNotSet
 
 		props := sprops.Prop[i]
 		if props.Required {
-			return &RequiredNotSetError{fmt.Sprintf("%v.%v", st, props.OrigName)}
+			return &RequiredNotSetError{field: fmt.Sprintf("%v.%v", st, props.OrigName)}
 		}
 	}
-	return &RequiredNotSetError{fmt.Sprintf("%v.<unknown field name>", st)} // should not happen
+	return &RequiredNotSetError{field: fmt.Sprintf("%v.<unknown field name>", st)} // should not happen
 }
 
 // Returns the index in the struct for the named field, as well as the parsed tag properties.
@@ -452,6 +646,8 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 	reqCount := sprops.reqCount
 	var reqFieldErr error
 	fieldSet := make(map[string]bool)
+	p.path = append(p.path, "")
+	defer func() { p.path = p.path[:len(p.path)-1] }()
 	// A struct is a sequence of "name: value", terminated by one of
 	// '>' or '}', or the end of the input.  A name may also be
 	// "[extension]" or "[type/url]".
@@ -459,6 +655,7 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 	// The whole struct can also be an expanded Any message, like:
 	// [type/url] < ... struct contents ... >
 	for {
+		p.setField("")
 		tok := p.next()
 		if tok.err != nil {
 			return tok.err
@@ -471,16 +668,25 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			//
 			// TODO: Check whether we need to handle
 			// namespace rooted names (e.g. ".something.Foo").
+			line, offset := tok.line, tok.offset
 			extName, err := p.consumeExtName()
 			if err != nil {
 				return err
 			}
+			p.setField("[" + extName + "]")
 
 			if s := strings.LastIndex(extName, "/"); s >= 0 {
 				// If it contains a slash, it's an Any type URL.
 				messageName := extName[s+1:]
-				mt := MessageType(messageName)
+				mt := p.reg.MessageType(messageName)
 				if mt == nil {
+					if p.discardUnknown {
+						if err := p.skipField(); err != nil {
+							return err
+						}
+						p.skippedAny = true
+						continue
+					}
 					return p.errorf("unrecognized message %q in google.protobuf.Any", messageName)
 				}
 				tok = p.next()
@@ -508,6 +714,9 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 					return pe
 				}
 				b, err := Marshal(v.Interface().(Message))
+				if _, ok := err.(*RequiredNotSetError); ok && p.allowPartial {
+					err = nil
+				}
 				if err != nil {
 					return p.errorf("failed to marshal message of type %q: %v", messageName, err)
 				}
@@ -527,14 +736,27 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			var desc *ExtensionDesc
 			// This could be faster, but it's functional.
 			// TODO: Do something smarter than a linear scan.
-			for _, d := range RegisteredExtensions(reflect.New(st).Interface().(Message)) {
+			for _, d := range p.reg.extensions(st) {
 				if d.Name == extName {
 					desc = d
 					break
 				}
 			}
 			if desc == nil {
-				return p.errorf("unrecognized extension %q", extName)
+				if p.discardUnknown {
+					if err := p.skipField(); err != nil {
+						return err
+					}
+					continue
+				}
+				pe := p.errorf("unrecognized extension %q", extName)
+				p.locate(pe, line, offset)
+				var names []string
+				for _, d := range p.reg.extensions(st) {
+					names = append(names, d.Name)
+				}
+				pe.Suggestions = suggest(extName, names)
+				return pe
 			}
 
 			props := &Properties{}
@@ -545,15 +767,16 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				return err
 			}
 
-			rep := desc.repeated()
-
 			// Read the extension structure, and set it in
 			// the value we're constructing.
-			var ext reflect.Value
-			if !rep {
-				ext = reflect.New(typ).Elem()
-			} else {
-				ext = reflect.New(typ.Elem()).Elem()
+			ep := sv.Addr().Interface().(Message)
+			ext := reflect.New(typ).Elem()
+			if desc.repeated() {
+				// readAny appends to the existing slice, so that both
+				// one element at a time and list notation work.
+				if old, err := GetExtension(ep, desc); err == nil {
+					ext.Set(reflect.ValueOf(old))
+				}
 			}
 			if err := p.readAny(ext, props); err != nil {
 				if _, ok := err.(*RequiredNotSetError); !ok {
@@ -561,19 +784,19 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				}
 				reqFieldErr = err
 			}
-			ep := sv.Addr().Interface().(Message)
-			if !rep {
-				SetExtension(ep, desc, ext.Interface())
-			} else {
-				old, err := GetExtension(ep, desc)
-				var sl reflect.Value
-				if err == nil {
-					sl = reflect.ValueOf(old) // existing slice
-				} else {
-					sl = reflect.MakeSlice(typ, 0, 1)
-				}
-				sl = reflect.Append(sl, ext)
-				SetExtension(ep, desc, sl.Interface())
+			SetExtension(ep, desc, ext.Interface())
+			if err := p.consumeOptionalSeparator(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// A field number in place of a name is an unknown field,
+		// as written by the text marshaler.
+		if v := tok.value; v != "" && '0' <= v[0] && v[0] <= '9' {
+			p.setField(v)
+			if err := p.readUnknownField(sv, tok.value); err != nil {
+				return err
 			}
 			if err := p.consumeOptionalSeparator(); err != nil {
 				return err
@@ -583,6 +806,7 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 
 		// This is a normal, non-extension field.
 		name := tok.value
+		p.setField(name)
 		var dst reflect.Value
 		fi, props, ok := structFieldByName(sprops, name)
 		if ok {
@@ -599,7 +823,15 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			field.Set(nv)
 		}
 		if !dst.IsValid() {
-			return p.errorf("unknown field name %q in %v", name, st)
+			if p.discardUnknown {
+				if err := p.skipField(); err != nil {
+					return err
+				}
+				continue
+			}
+			pe := p.errorf("unknown field name %q in %v", name, st)
+			pe.Suggestions = suggest(name, fieldNames(sprops))
+			return pe
 		}
 
 		if dst.Kind() == reflect.Map {
@@ -647,6 +879,7 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 					if err := p.readAny(key, props.mkeyprop); err != nil {
 						return err
 					}
+					p.setField(fmt.Sprintf("%s[%v]", name, key.Interface()))
 					if err := p.consumeOptionalSeparator(); err != nil {
 						return err
 					}
@@ -678,6 +911,9 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 		if err := p.checkForColon(props, dst.Type()); err != nil {
 			return err
 		}
+		if props.Repeated && dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Ptr {
+			p.setField(fmt.Sprintf("%s[%d]", name, dst.Len()))
+		}
 
 		// Parse into the field.
 		fieldSet[name] = true
@@ -697,12 +933,187 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 
 	}
 
-	if reqCount > 0 {
+	if reqCount > 0 && !p.allowPartial {
 		return p.missingRequiredFieldError(sv)
 	}
 	return reqFieldErr
 }
 
+// readUnknownField reads the value of the unknown field numbered num and
+// appends its wire encoding to the unknown fields of sv; numbers in an
+// extension range are kept with the message's other raw extensions.
+func (p *textParser) readUnknownField(sv reflect.Value, num string) error {
+	tag, err := strconv.ParseUint(num, 10, 32)
+	if err != nil || tag == 0 || tag > maxFieldNumber {
+		return p.errorf("invalid field number %q", num)
+	}
+	b := NewBuffer(nil)
+	if err := p.readUnknownValue(b, tag); err != nil {
+		return err
+	}
+	if p.discardUnknown {
+		return nil
+	}
+	if ep, ok := extendable(sv.Addr().Interface()); ok && isExtensionField(ep, int32(tag)) {
+		em := ep.extensionsWrite()
+		ext := em[int32(tag)]
+		if ext.value != nil {
+			return p.errorf("field %d would overwrite already parsed extension %q", tag, ext.desc.Name)
+		}
+		ext.enc = append(ext.enc, b.buf...)
+		em[int32(tag)] = ext
+		return nil
+	}
+	u := sv.FieldByName("XXX_unrecognized")
+	if !u.IsValid() {
+		return p.errorf("unknown field %d in %v", tag, sv.Type())
+	}
+	u.SetBytes(append(u.Bytes(), b.buf...))
+	return nil
+}
+
+// readUnknownValue reads the value of an unknown field and encodes it, with
+// its tag, into b. The wire type follows from the value as written by
+// writeUnknownFields: a {...} block is an embedded message, a <...> block a
+// group, a string a length-delimited value, 0x followed by 8 or 16 digits a
+// fixed32 or fixed64 value, and any other number a varint.
+func (p *textParser) readUnknownValue(b *Buffer, tag uint64) error {
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	colon := tok.value == ":"
+	if colon {
+		if tok = p.next(); tok.err != nil {
+			return tok.err
+		}
+	}
+	switch {
+	case tok.value == "{":
+		nb := NewBuffer(nil)
+		if err := p.readUnknownFields(nb, "}"); err != nil {
+			return err
+		}
+		b.EncodeVarint(tag<<3 | WireBytes)
+		return b.EncodeRawBytes(nb.buf)
+	case tok.value == "<":
+		b.EncodeVarint(tag<<3 | WireStartGroup)
+		if err := p.readUnknownFields(b, ">"); err != nil {
+			return err
+		}
+		return b.EncodeVarint(tag<<3 | WireEndGroup)
+	case !colon:
+		return p.errorf("expected ':', found %q", tok.value)
+	case tok.value == "":
+		return p.errorf("unexpected EOF")
+	case isQuote(tok.value[0]):
+		b.EncodeVarint(tag<<3 | WireBytes)
+		return b.EncodeStringBytes(tok.unquoted)
+	}
+	s := tok.value
+	if strings.HasPrefix(s, "0x") && (len(s) == 2+8 || len(s) == 2+16) {
+		x, err := strconv.ParseUint(s[2:], 16, 64)
+		if err != nil {
+			return p.errorf("invalid fixed-width value %q for field %d", s, tag)
+		}
+		if len(s) == 2+8 {
+			b.EncodeVarint(tag<<3 | WireFixed32)
+			return b.EncodeFixed32(x)
+		}
+		b.EncodeVarint(tag<<3 | WireFixed64)
+		return b.EncodeFixed64(x)
+	}
+	x, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		// Negative values are encoded as two's complement.
+		n, nerr := strconv.ParseInt(s, 0, 64)
+		if nerr != nil {
+			return p.errorf("invalid value %q for field %d", s, tag)
+		}
+		x = uint64(n)
+	}
+	b.EncodeVarint(tag<<3 | WireVarint)
+	return b.EncodeVarint(x)
+}
+
+// readUnknownFields reads the numbered fields of an unknown message or
+// group up to terminator and encodes them into b.
+func (p *textParser) readUnknownFields(b *Buffer, terminator string) error {
+	for {
+		tok := p.next()
+		if tok.err != nil {
+			return tok.err
+		}
+		if tok.value == terminator {
+			return nil
+		}
+		if tok.value == "" {
+			return p.errorf("unexpected EOF")
+		}
+		tag, err := strconv.ParseUint(tok.value, 10, 32)
+		if err != nil || tag == 0 || tag > maxFieldNumber {
+			return p.errorf("expected field number or %q, found %q", terminator, tok.value)
+		}
+		if err := p.readUnknownValue(b, tag); err != nil {
+			return err
+		}
+		if err := p.consumeOptionalSeparator(); err != nil {
+			return err
+		}
+	}
+}
+
+// isEmptyAny reports whether sv is a google.protobuf.Any with neither a
+// type URL nor a value.
+func isEmptyAny(sv reflect.Value) bool {
+	return sv.Kind() == reflect.Struct && isAny(sv) &&
+		sv.FieldByName("TypeUrl").String() == "" && sv.FieldByName("Value").Len() == 0
+}
+
+// dropNil removes the last element of the slice fv if it is a nil pointer,
+// as left behind by a discarded Any.
+func dropNil(fv reflect.Value) {
+	if e := fv.Index(fv.Len() - 1); e.Kind() == reflect.Ptr && e.IsNil() {
+		fv.Set(fv.Slice(0, fv.Len()-1))
+	}
+}
+
+// skipField skips the value of a field that cannot be resolved, along with
+// the optional colon before it and separator after it. A message or list
+// value is skipped up to its matching closing delimiter; adjacent string
+// literals need no special care, as next joins them into a single token.
+func (p *textParser) skipField() error {
+	tok := p.next()
+	if tok.err != nil {
+		return tok.err
+	}
+	if tok.value == ":" {
+		if tok = p.next(); tok.err != nil {
+			return tok.err
+		}
+	}
+	for depth := 0; ; {
+		switch tok.value {
+		case "":
+			return p.errorf("unexpected EOF")
+		case "{", "<", "[":
+			depth++
+		case "}", ">", "]":
+			depth--
+		}
+		if depth < 0 {
+			return p.errorf("unexpected %q", tok.value)
+		}
+		if depth == 0 {
+			break
+		}
+		if tok = p.next(); tok.err != nil {
+			return tok.err
+		}
+	}
+	return p.consumeOptionalSeparator()
+}
+
 // consumeExtName consumes extension name or expanded Any type URL and the
 // following ']'. It returns the name or URL consumed.
 func (p *textParser) consumeExtName() (string, error) {
@@ -778,6 +1189,7 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 				if err != nil {
 					return err
 				}
+				dropNil(fv)
 				tok := p.next()
 				if tok.err != nil {
 					return tok.err
@@ -794,7 +1206,11 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		// One value of the repeated field.
 		p.back()
 		fv.Set(reflect.Append(fv, reflect.New(at.Elem()).Elem()))
-		return p.readAny(fv.Index(fv.Len()-1), props)
+		if err := p.readAny(fv.Index(fv.Len()-1), props); err != nil {
+			return err
+		}
+		dropNil(fv)
+		return nil
 	case reflect.Bool:
 		// true/1/t/True or false/f/0/False.
 		switch tok.value {
@@ -825,13 +1241,19 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		if len(props.Enum) == 0 {
 			break
 		}
-		m, ok := enumValueMaps[props.Enum]
-		if !ok {
+		m := p.reg.EnumValueMap(props.Enum)
+		if m == nil {
 			break
 		}
 		x, ok := m[tok.value]
 		if !ok {
-			break
+			pe := p.errorf("invalid %v: %v", v.Type(), tok.value)
+			var names []string
+			for name := range m {
+				names = append(names, name)
+			}
+			pe.Suggestions = suggest(tok.value, names)
+			return pe
 		}
 		fv.SetInt(int64(x))
 		return nil
@@ -845,7 +1267,16 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		// A basic field (indirected through pointer), or a repeated message/group
 		p.back()
 		fv.Set(reflect.New(fv.Type().Elem()))
-		return p.readAny(fv.Elem(), props)
+		skipped := p.skippedAny
+		p.skippedAny = false
+		err := p.readAny(fv.Elem(), props)
+		if err == nil && p.skippedAny && isEmptyAny(fv.Elem()) {
+			// The Any held only a value of an unknown type, discarded
+			// under DiscardUnknown; leave the field unset.
+			fv.Set(reflect.Zero(fv.Type()))
+		}
+		p.skippedAny = skipped
+		return err
 	case reflect.String:
 		if tok.value[0] == '"' || tok.value[0] == '\'' {
 			fv.SetString(tok.unquoted)
@@ -882,14 +1313,109 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 // If a required field is not set and no other error occurs,
 // UnmarshalText returns *RequiredNotSetError.
 func UnmarshalText(s string, pb Message) error {
+	return defaultTextUnmarshaler.Unmarshal(s, pb)
+}
+
+// TextUnmarshaler is a configurable text format unmarshaler.
+type TextUnmarshaler struct {
+	// DiscardUnknown skips fields, extensions and expanded Any values
+	// whose names cannot be resolved, instead of failing. Fields written
+	// by number are dropped rather than kept as unknown fields, and an Any
+	// field holding only a skipped value is left unset.
+	DiscardUnknown bool
+
+	// AllowPartial skips the check that required fields are set, so
+	// that no *RequiredNotSetError is returned.
+	AllowPartial bool
+
+	// Registry resolves extension names, enum value names and the
+	// message types of expanded google.protobuf.Any values.
+	// If nil, GlobalRegistry is used.
+	Registry *Registry
+}
+
+var defaultTextUnmarshaler = TextUnmarshaler{}
+
+// Unmarshal reads a protocol buffer in Text format, as UnmarshalText does,
+// with the options set in tu.
+func (tu *TextUnmarshaler) Unmarshal(s string, pb Message) error {
 	if um, ok := pb.(encoding.TextUnmarshaler); ok {
 		err := um.UnmarshalText([]byte(s))
 		return err
 	}
 	pb.Reset()
 	v := reflect.ValueOf(pb)
-	if pe := newTextParser(s).readStruct(v.Elem(), ""); pe != nil {
+	p := newTextParser(s)
+	p.reg = tu.Registry
+	p.discardUnknown = tu.DiscardUnknown
+	p.allowPartial = tu.AllowPartial
+	if pe := p.readStruct(v.Elem(), ""); pe != nil {
 		return pe
 	}
 	return nil
 }
+
+// A TextToken is a token of text format input, as returned by TextScanner.
+type TextToken struct {
+	Value    string // the token as written; "" at the end of input
+	Unquoted string // the contents of a quoted string, with escapes interpreted
+	Line     int    // 1-based line number
+	Offset   int    // 0-based byte offset from start of input
+}
+
+// A TextScanner splits text format input into tokens by the rules that
+// UnmarshalText follows, for parsers of the text format outside this
+// package. Whitespace, # comments and /* */ comments between tokens are
+// skipped. Unlike UnmarshalText, the scanner returns adjacent quoted
+// strings as separate tokens.
+type TextScanner struct {
+	p *textParser
+}
+
+// NewTextScanner returns a TextScanner that reads from s.
+func NewTextScanner(s string) *TextScanner {
+	return &TextScanner{p: newTextParser(s)}
+}
+
+// Scan returns the next token. Malformed input, such as an unmatched quote
+// or an unterminated comment, is reported as a *ParseError, which is
+// returned again by every later call.
+func (s *TextScanner) Scan() (TextToken, error) {
+	p := s.p
+	if p.cur.err == nil {
+		p.advance()
+	}
+	if p.cur.err != nil {
+		return TextToken{Line: p.cur.line, Offset: p.cur.offset}, p.cur.err
+	}
+	if p.done {
+		return TextToken{Line: p.line, Offset: p.offset}, nil
+	}
+	return TextToken{Value: p.cur.value, Unquoted: p.cur.unquoted, Line: p.cur.line, Offset: p.cur.offset}, nil
+}
+
+// ScanUnknown reads the value of the field whose number is in tok, the
+// token last returned by Scan, written as TextMarshaler.MarshalUnknown
+// writes it. It returns the wire format encoding of the field.
+func (s *TextScanner) ScanUnknown(tok TextToken) ([]byte, error) {
+	p := s.p
+	if p.cur.err != nil {
+		return nil, p.cur.err
+	}
+	tag, err := strconv.ParseUint(tok.Value, 10, 32)
+	if err != nil || tag == 0 || tag > maxFieldNumber {
+		return nil, s.Errorf(tok, "invalid field number %q", tok.Value)
+	}
+	b := NewBuffer(nil)
+	if err := p.readUnknownValue(b, tag); err != nil {
+		return nil, err
+	}
+	return b.buf, nil
+}
+
+// Errorf returns a *ParseError with the given message, located at tok.
+func (s *TextScanner) Errorf(tok TextToken, format string, a ...interface{}) *ParseError {
+	pe := &ParseError{Message: fmt.Sprintf(format, a...)}
+	s.p.locate(pe, tok.Line, tok.Offset)
+	return pe
+}
@@ -327,6 +327,37 @@ var unMarshalTextTests = []UnmarshalTextTest{
 		err: `line 1.6: expected ':', found "42"`,
 	},
 
+	// Unknown fields by number
+	{
+		in: `count: 42 99: "x" 98 { 1: -1 } 96 < 1: 2 > /* comment */ 97: 0x0000000a`,
+		out: &MyMessage{
+			Count:            Int32(42),
+			XXX_unrecognized: []byte("\x9a\x06\x01x\x92\x06\x0b\x08\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01\x83\x06\x08\x02\x84\x06\x8d\x06\x0a\x00\x00\x00"),
+		},
+	},
+
+	// Unterminated comment
+	{
+		in:  `count: 42 /* 3 unknown bytes`,
+		err: `line 1.10: unterminated comment`,
+	},
+	{
+		in:  `name: "a" /* no end "b"`,
+		err: `line 1.10: unterminated comment`,
+	},
+
+	// Bad field number
+	{
+		in:  `count: 42 0: 1`,
+		err: `line 1.10: invalid field number "0"`,
+	},
+
+	// Missing colon for unknown scalar field
+	{
+		in:  `count: 42 99 1`,
+		err: `line 1.13: expected ':', found "1"`,
+	},
+
 	// Missing required field
 	{
 		in:  `name: "Pawel"`,
@@ -648,6 +679,241 @@ func TestOneofParsing(t *testing.T) {
 
 }
 
+func TestTextUnmarshalerDiscardUnknown(t *testing.T) {
+	const in = `count: 42
+newer_field: "x"
+newer_message { a: 1 b: [1, 2] c < d: "}" > }
+[testdata.no_such_extension]: < e: 1 >,
+[testdata.greeting]: "hi"
+99: 5
+name: "Dave"`
+	want := &MyMessage{Count: Int32(42), Name: String("Dave")}
+	if err := SetExtension(want, E_Greeting, []string{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := UnmarshalText(in, new(MyMessage)); err == nil {
+		t.Error("UnmarshalText accepted unknown fields")
+	}
+	tu := TextUnmarshaler{DiscardUnknown: true}
+	got := new(MyMessage)
+	if err := tu.Unmarshal(in, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !Equal(got, want) || got.XXX_unrecognized != nil {
+		t.Errorf("Unmarshal = %v, want %v", got, want)
+	}
+
+	m := new(proto3pb.Message)
+	const anyIn = `anything: < [type.googleapis.com/no.Such]: < x: 1 > > name: "n"
+many_things: < [type.googleapis.com/no.Such]: < > >
+many_things: [< [type.googleapis.com/no.Such]: < > >, <>]`
+	if err := tu.Unmarshal(anyIn, m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if m.Name != "n" || m.Anything != nil || len(m.ManyThings) != 1 || m.ManyThings[0] == nil {
+		t.Errorf("Unmarshal = %v, want only name and one empty Any", m)
+	}
+
+	for _, in := range []string{`foo: "a" "b" count: 1`, "foo: 'a' # c\n \"b\" count: 1"} {
+		got := new(MyMessage)
+		if err := tu.Unmarshal(in, got); err != nil || got.GetCount() != 1 {
+			t.Errorf("Unmarshal(%q) = %v, %v; want count 1", in, got, err)
+		}
+	}
+
+	for _, in := range []string{`count: 1 newer { a: 1`, `count: 1 newer: }`} {
+		if err := tu.Unmarshal(in, new(MyMessage)); err == nil {
+			t.Errorf("Unmarshal(%q) succeeded", in)
+		}
+	}
+}
+
+func TestTextUnmarshalerAllowPartial(t *testing.T) {
+	const in = `name: "Dave" inner: < port: 1 >`
+	err := UnmarshalText(in, new(MyMessage))
+	if _, ok := err.(*RequiredNotSetError); !ok {
+		t.Errorf("UnmarshalText error = %v, want *RequiredNotSetError", err)
+	}
+	tu := TextUnmarshaler{AllowPartial: true}
+	got := new(MyMessage)
+	if err := tu.Unmarshal(in, got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := &MyMessage{Name: String("Dave"), Inner: &InnerMessage{Port: Int32(1)}}
+	if !Equal(got, want) {
+		t.Errorf("Unmarshal = %v, want %v", got, want)
+	}
+}
+
+func TestParseErrorDetail(t *testing.T) {
+	tests := []struct {
+		in   string
+		pb   Message
+		want ParseError
+	}{
+		{
+			in: "count: 1\ninner <\n  hots: \"h\"\n>\n",
+			pb: new(MyMessage),
+			want: ParseError{
+				Message:     `unknown field name "hots" in testdata.InnerMessage`,
+				Line:        3,
+				Column:      3,
+				Source:      `  hots: "h"`,
+				Path:        "inner.hots",
+				Suggestions: []string{"host"},
+			},
+		},
+		{
+			in: "cuont: 1",
+			pb: new(MyMessage),
+			want: ParseError{
+				Message:     `unknown field name "cuont" in testdata.MyMessage`,
+				Line:        1,
+				Column:      1,
+				Source:      "cuont: 1",
+				Path:        "cuont",
+				Suggestions: []string{"count"},
+			},
+		},
+		{
+			in: "count: 1 others < key: 1 >\nothers <\n\tinner < host: \"h\" prot: 1 >\n>",
+			pb: new(MyMessage),
+			want: ParseError{
+				Message:     `unknown field name "prot" in testdata.InnerMessage`,
+				Line:        3,
+				Column:      20,
+				Source:      "\tinner < host: \"h\" prot: 1 >",
+				Path:        "others[1].inner.prot",
+				Suggestions: []string{"port"},
+			},
+		},
+		{
+			in: "count: 1 bikeshed: BLU",
+			pb: new(MyMessage),
+			want: ParseError{
+				Message:     "invalid testdata.MyMessage_Color: BLU",
+				Line:        1,
+				Column:      20,
+				Source:      "count: 1 bikeshed: BLU",
+				Path:        "bikeshed",
+				Suggestions: []string{"BLUE"},
+			},
+		},
+		{
+			in: `count: 1 [testdata.greting]: "hi"`,
+			pb: new(MyMessage),
+			want: ParseError{
+				Message:     `unrecognized extension "testdata.greting"`,
+				Line:        1,
+				Column:      10,
+				Source:      `count: 1 [testdata.greting]: "hi"`,
+				Path:        "[testdata.greting]",
+				Suggestions: []string{"testdata.greeting"},
+			},
+		},
+		{
+			in: "msg_mapping <\n  key: 7\n  value < f: 1 exatc: true >\n>",
+			pb: new(MessageWithMap),
+			want: ParseError{
+				Message:     `unknown field name "exatc" in testdata.FloatingPoint`,
+				Line:        3,
+				Column:      16,
+				Source:      "  value < f: 1 exatc: true >",
+				Path:        "msg_mapping[7].exatc",
+				Suggestions: []string{"exact"},
+			},
+		},
+		{
+			in: "count: 1\r\nname: 2\r\n",
+			pb: new(MyMessage),
+			want: ParseError{
+				Message: "invalid string: 2",
+				Line:    2,
+				Column:  7,
+				Source:  "name: 2",
+				Path:    "name",
+			},
+		},
+	}
+	for _, test := range tests {
+		err := UnmarshalText(test.in, test.pb)
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("UnmarshalText(%q) error = %v, want a *ParseError", test.in, err)
+			continue
+		}
+		test.want.Offset = pe.Offset
+		if !reflect.DeepEqual(*pe, test.want) {
+			t.Errorf("UnmarshalText(%q) error = %+v, want %+v", test.in, *pe, test.want)
+		}
+	}
+
+	err := UnmarshalText("count: 1\ninner <\n  hots: \"h\"\n>\n", new(MyMessage))
+	want := `line 3:3: unknown field name "hots" in testdata.InnerMessage
+	  hots: "h"
+	  ^
+in field inner.hots
+did you mean "host"?
+`
+	if got := err.(*ParseError).Detail(); got != want {
+		t.Errorf("Detail() = %q, want %q", got, want)
+	}
+	if got, want := err.Error(), `line 3: unknown field name "hots" in testdata.InnerMessage`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestParseErrorDetailMultiByte(t *testing.T) {
+	// Column counts bytes, but the caret is padded by runes.
+	err := UnmarshalText(`name: "héllo" cuont: 1`, new(MyMessage))
+	pe := err.(*ParseError)
+	if pe.Column != 16 {
+		t.Errorf("Column = %d, want 16", pe.Column)
+	}
+	want := `line 1:16: unknown field name "cuont" in testdata.MyMessage
+	name: "héllo" cuont: 1
+	              ^
+in field cuont
+did you mean "count"?
+`
+	if got := pe.Detail(); got != want {
+		t.Errorf("Detail() = %q, want %q", got, want)
+	}
+}
+
+func TestTextScanner(t *testing.T) {
+	const in = "a: 'x' \"y\" # c\n/* 3 unknown bytes */ 5: \"z\" b"
+	sc := NewTextScanner(in)
+	want := []TextToken{
+		{Value: "a", Line: 1, Offset: 0},
+		{Value: ":", Line: 1, Offset: 1},
+		{Value: "'x'", Unquoted: "x", Line: 1, Offset: 3},
+		{Value: `"y"`, Unquoted: "y", Line: 1, Offset: 7},
+		{Value: "5", Line: 2, Offset: 37},
+	}
+	for _, w := range want {
+		if got, err := sc.Scan(); err != nil || got != w {
+			t.Fatalf("Scan() = %+v, %v; want %+v", got, err, w)
+		}
+	}
+	b, err := sc.ScanUnknown(want[len(want)-1])
+	if err != nil || string(b) != "\x2a\x01z" {
+		t.Errorf("ScanUnknown() = %q, %v; want %q", b, err, "\x2a\x01z")
+	}
+	if got, err := sc.Scan(); err != nil || got.Value != "b" {
+		t.Errorf("Scan() = %+v, %v; want b", got, err)
+	}
+	if got, err := sc.Scan(); err != nil || got.Value != "" || got.Offset != len(in) {
+		t.Errorf("Scan() at end = %+v, %v; want offset %d", got, err, len(in))
+	}
+
+	sc = NewTextScanner("a /* b")
+	sc.Scan()
+	if _, err := sc.Scan(); err == nil || err.Error() != "line 1.2: unterminated comment" {
+		t.Errorf("Scan() of unterminated comment: got error %v", err)
+	}
+}
+
 var benchInput string
 
 func init() {
@@ -303,6 +303,234 @@ func TestCompactText(t *testing.T) {
 	}
 }
 
+func TestTextMarshalerOptions(t *testing.T) {
+	deep := &pb.MyMessage{
+		Count:    proto.Int32(1),
+		Pet:      []string{"bunny", "kitty", "horsey"},
+		RepInner: []*pb.InnerMessage{{Host: proto.String("a")}},
+		WeMustGoDeeper: &pb.RequiredInnerMessage{
+			LeoFinallyWonAnOscar: &pb.InnerMessage{Host: proto.String("b")},
+		},
+		Bikeshed:  pb.MyMessage_RED.Enum(),
+		Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(8)},
+	}
+	if err := proto.SetExtension(deep, pb.E_Greeting, []string{"adg", "easy"}); err != nil {
+		t.Fatal(err)
+	}
+	defaults := &pb.OtherMessage{
+		Key:   proto.Int64(0),
+		Value: []byte{},
+		Inner: &pb.InnerMessage{
+			Host:      proto.String("h"),
+			Port:      proto.Int32(4000),
+			Connected: proto.Bool(false),
+		},
+	}
+	oneof := &pb.Communique{
+		MakeMeCry: proto.Bool(false),
+		Union:     &pb.Communique_Number{Number: 0},
+	}
+
+	tests := []struct {
+		desc string
+		tm   proto.TextMarshaler
+		in   proto.Message
+		want string
+	}{
+		{
+			desc: "field number order",
+			tm:   proto.TextMarshaler{Compact: true, FieldNumberOrder: true},
+			in:   deep,
+			want: `count:1 pet:"bunny" pet:"kitty" pet:"horsey" bikeshed:RED SomeGroup{group_field:8 } ` +
+				`rep_inner:<host:"a" > we_must_go_deeper:<leo_finally_won_an_oscar:<host:"b" > > ` +
+				`[testdata.greeting]:"adg" [testdata.greeting]:"easy" `,
+		},
+		{
+			desc: "short repeated",
+			tm:   proto.TextMarshaler{Compact: true, ShortRepeated: true},
+			in:   &pb.MyMessage{Count: proto.Int32(1), Pet: []string{"bunny", "kitty"}, RepBytes: [][]byte{{1}, {}}},
+			want: `count:1 pet:["bunny","kitty"] rep_bytes:["\001",""] `,
+		},
+		{
+			desc: "short repeated fits line",
+			tm:   proto.TextMarshaler{ShortRepeated: true, LineWidth: 40},
+			in:   deep,
+			want: `count: 1
+pet: ["bunny", "kitty", "horsey"]
+we_must_go_deeper: <
+  leo_finally_won_an_oscar: <
+    host: "b"
+  >
+>
+rep_inner: <
+  host: "a"
+>
+bikeshed: RED
+SomeGroup {
+  group_field: 8
+}
+[testdata.greeting]: ["adg", "easy"]
+`,
+		},
+		{
+			desc: "short repeated wrapped",
+			tm:   proto.TextMarshaler{ShortRepeated: true, LineWidth: 20, MaxDepth: 1},
+			in:   deep,
+			want: `count: 1
+pet: [
+  "bunny", "kitty",
+  "horsey"
+]
+we_must_go_deeper: <
+  leo_finally_won_an_oscar: <...>
+>
+rep_inner: <
+  host: "a"
+>
+bikeshed: RED
+SomeGroup {
+  group_field: 8
+}
+[testdata.greeting]: [
+  "adg", "easy"
+]
+`,
+		},
+		{
+			desc: "elide defaults",
+			tm:   proto.TextMarshaler{Compact: true, ElideDefaults: true},
+			in:   defaults,
+			want: `inner:<host:"h" > `,
+		},
+		{
+			desc: "elide defaults keeps oneof",
+			tm:   proto.TextMarshaler{Compact: true, ElideDefaults: true, FieldNumberOrder: true},
+			in:   oneof,
+			want: `number:0 `,
+		},
+		{
+			desc: "max depth",
+			tm:   proto.TextMarshaler{Compact: true, MaxDepth: 1},
+			in:   deep,
+			want: `count:1 pet:"bunny" pet:"kitty" pet:"horsey" ` +
+				`we_must_go_deeper:<leo_finally_won_an_oscar:<...> > rep_inner:<host:"a" > bikeshed:RED SomeGroup{group_field:8 } ` +
+				`[testdata.greeting]:"adg" [testdata.greeting]:"easy" `,
+		},
+		{
+			desc: "omit message colon",
+			tm:   proto.TextMarshaler{OmitMessageColon: true, MaxDepth: 2},
+			in:   &pb.MyMessage{Count: proto.Int32(1), Inner: &pb.InnerMessage{Host: proto.String("h")}},
+			want: `count: 1
+inner <
+  host: "h"
+>
+`,
+		},
+	}
+	for _, test := range tests {
+		got := test.tm.Text(test.in)
+		if got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.desc, got, test.want)
+			continue
+		}
+		if test.tm.MaxDepth == 1 {
+			// The <...> marker can't be parsed back.
+			continue
+		}
+		out := proto.Clone(test.in)
+		out.Reset()
+		if err := proto.UnmarshalText(got, out); err != nil {
+			t.Errorf("%s: UnmarshalText: %v", test.desc, err)
+			continue
+		}
+		if !test.tm.ElideDefaults && !proto.Equal(out, test.in) {
+			t.Errorf("%s: round trip got %v, want %v", test.desc, out, test.in)
+		}
+	}
+}
+
+func unknownFields() []byte {
+	b := proto.NewBuffer(nil)
+	b.EncodeVarint(100<<3 | proto.WireVarint)
+	b.EncodeVarint(150)
+	b.EncodeVarint(101<<3 | proto.WireFixed32)
+	b.EncodeFixed32(42)
+	b.EncodeVarint(102<<3 | proto.WireFixed64)
+	b.EncodeFixed64(1)
+	b.EncodeVarint(103<<3 | proto.WireBytes)
+	b.EncodeStringBytes("hello")
+	b.EncodeVarint(104<<3 | proto.WireBytes)
+	b.EncodeRawBytes([]byte("\x08\x07\x12\x01x"))
+	b.EncodeVarint(105<<3 | proto.WireStartGroup)
+	b.EncodeVarint(1<<3 | proto.WireVarint)
+	b.EncodeVarint(2)
+	b.EncodeVarint(105<<3 | proto.WireEndGroup)
+	b.EncodeVarint(106<<3 | proto.WireVarint)
+	b.EncodeVarint(uint64(1<<64 - 1))
+	return b.Bytes()
+}
+
+const unknownText = `host: "h"
+/* 54 unknown bytes */
+100: 150
+101: 0x0000002a
+102: 0x0000000000000001
+103: "hello"
+104 {
+  1: 7
+  2: "x"
+}
+105 <
+  1: 2
+>
+106: 18446744073709551615
+`
+
+func TestUnknownFieldsText(t *testing.T) {
+	m := &pb.InnerMessage{Host: proto.String("h"), XXX_unrecognized: unknownFields()}
+	if got := proto.MarshalTextString(m); got != unknownText {
+		t.Errorf("Got:\n===\n%v===\nExpected:\n===\n%v===\n", got, unknownText)
+	}
+	for _, in := range []string{unknownText, proto.CompactTextString(m)} {
+		got := new(pb.InnerMessage)
+		if err := proto.UnmarshalText(in, got); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", in, err)
+			continue
+		}
+		if !proto.Equal(got, m) {
+			t.Errorf("UnmarshalText(%q) = %v, want %v", in, got, m)
+		}
+	}
+
+	// A value that is not printable text but also not a well-formed
+	// message, like this padded varint, stays a string.
+	m = &pb.InnerMessage{XXX_unrecognized: []byte("\xa2\x06\x03\x08\x80\x00")}
+	if got, want := proto.CompactTextString(m), `100:"\b\x80\x00" `; got != want {
+		t.Errorf("CompactTextString = %q, want %q", got, want)
+	}
+}
+
+func TestUnknownExtensionsText(t *testing.T) {
+	m := newTestMessage()
+	got := new(pb.MyMessage)
+	if err := proto.UnmarshalText(proto.MarshalTextString(m), got); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	// proto.Equal cannot compare extensions it has no descriptor for,
+	// so compare the wire encodings instead.
+	b1, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := proto.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b1, b2) {
+		t.Errorf("round trip = %v, want %v", got, m)
+	}
+}
+
 func TestStringEscaping(t *testing.T) {
 	testCases := []struct {
 		in  *pb.Strings
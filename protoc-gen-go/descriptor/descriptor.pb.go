@@ -653,9 +653,20 @@ type FieldDescriptorProto struct {
 	// user has set a "json_name" option on this field, that option's value
 	// will be used. Otherwise, it's deduced from the field's name by converting
 	// it to camelCase.
-	JsonName         *string       `protobuf:"bytes,10,opt,name=json_name,json=jsonName" json:"json_name,omitempty"`
-	Options          *FieldOptions `protobuf:"bytes,8,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte        `json:"-"`
+	JsonName *string      `protobuf:"bytes,10,opt,name=json_name,json=jsonName" json:"json_name,omitempty"`
+	Options  *FieldOptions `protobuf:"bytes,8,opt,name=options" json:"options,omitempty"`
+	// If true, this is a proto3 "optional". When a proto3 field is optional, it
+	// tracks presence regardless of field type.
+	//
+	// When proto3_optional is true, this field must be belong to a oneof to
+	// signal to old proto3 clients that presence is tracked for this field. This
+	// oneof is known as a "synthetic" oneof, and this field must be its sole
+	// member.
+	//
+	// Proto2 optional fields do not set this flag, because they already indicate
+	// optional with `LABEL_OPTIONAL`.
+	Proto3Optional   *bool  `protobuf:"varint,17,opt,name=proto3_optional,json=proto3Optional" json:"proto3_optional,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *FieldDescriptorProto) Reset()                    { *m = FieldDescriptorProto{} }
@@ -733,6 +744,13 @@ func (m *FieldDescriptorProto) GetOptions() *FieldOptions {
 	return nil
 }
 
+func (m *FieldDescriptorProto) GetProto3Optional() bool {
+	if m != nil && m.Proto3Optional != nil {
+		return *m.Proto3Optional
+	}
+	return false
+}
+
 // Describes a oneof.
 type OneofDescriptorProto struct {
 	Name             *string       `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -1994,159 +2012,160 @@ func init() {
 func init() { proto.RegisterFile("google/protobuf/descriptor.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5b, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5e, 0x5d, 0x2d, 0x1d, 0xc9, 0xf2, 0x78, 0xec, 0x4d, 0x18, 0xef, 0x25, 0x8e, 0xf6, 0x12,
-	0x6f, 0xd2, 0xc8, 0x0b, 0xe7, 0xb2, 0x59, 0xa7, 0x48, 0x21, 0x4b, 0x8c, 0x57, 0xa9, 0x2c, 0xa9,
-	0x94, 0xdc, 0x4d, 0xf6, 0x85, 0x18, 0x93, 0x23, 0x99, 0x09, 0x45, 0x72, 0x49, 0x2a, 0x89, 0xf7,
-	0x29, 0x40, 0x9f, 0x0a, 0xf4, 0x07, 0x14, 0x45, 0xd1, 0x87, 0x7d, 0x59, 0xa0, 0x3f, 0xa0, 0xcf,
-	0xfd, 0x05, 0x05, 0xf6, 0xb9, 0x2f, 0x45, 0x51, 0xa0, 0xfd, 0x07, 0x7d, 0x2d, 0x66, 0x86, 0xa4,
-	0x48, 0x5d, 0x12, 0x77, 0x81, 0xec, 0x3e, 0xd9, 0x73, 0xce, 0x77, 0x0e, 0xcf, 0x9c, 0xf9, 0x66,
-	0xce, 0x99, 0x11, 0x6c, 0x8f, 0x6c, 0x7b, 0x64, 0xd2, 0x5d, 0xc7, 0xb5, 0x7d, 0xfb, 0x64, 0x32,
-	0xdc, 0xd5, 0xa9, 0xa7, 0xb9, 0x86, 0xe3, 0xdb, 0x6e, 0x8d, 0xcb, 0xf0, 0x9a, 0x40, 0xd4, 0x42,
-	0x44, 0xf5, 0x08, 0xd6, 0x1f, 0x18, 0x26, 0x6d, 0x46, 0xc0, 0x3e, 0xf5, 0xf1, 0x5d, 0xc8, 0x0e,
-	0x0d, 0x93, 0x4a, 0xa9, 0xed, 0xcc, 0x4e, 0x69, 0xef, 0xc3, 0xda, 0x8c, 0x51, 0x2d, 0x69, 0xd1,
-	0x63, 0x62, 0x85, 0x5b, 0x54, 0xff, 0x95, 0x85, 0x8d, 0x05, 0x5a, 0x8c, 0x21, 0x6b, 0x91, 0x31,
-	0xf3, 0x98, 0xda, 0x29, 0x2a, 0xfc, 0x7f, 0x2c, 0xc1, 0x8a, 0x43, 0xb4, 0xa7, 0x64, 0x44, 0xa5,
-	0x34, 0x17, 0x87, 0x43, 0xfc, 0x3e, 0x80, 0x4e, 0x1d, 0x6a, 0xe9, 0xd4, 0xd2, 0xce, 0xa4, 0xcc,
-	0x76, 0x66, 0xa7, 0xa8, 0xc4, 0x24, 0xf8, 0x3a, 0xac, 0x3b, 0x93, 0x13, 0xd3, 0xd0, 0xd4, 0x18,
-	0x0c, 0xb6, 0x33, 0x3b, 0x39, 0x05, 0x09, 0x45, 0x73, 0x0a, 0xbe, 0x0a, 0x6b, 0xcf, 0x29, 0x79,
-	0x1a, 0x87, 0x96, 0x38, 0xb4, 0xc2, 0xc4, 0x31, 0x60, 0x03, 0xca, 0x63, 0xea, 0x79, 0x64, 0x44,
-	0x55, 0xff, 0xcc, 0xa1, 0x52, 0x96, 0xcf, 0x7e, 0x7b, 0x6e, 0xf6, 0xb3, 0x33, 0x2f, 0x05, 0x56,
-	0x83, 0x33, 0x87, 0xe2, 0x3a, 0x14, 0xa9, 0x35, 0x19, 0x0b, 0x0f, 0xb9, 0x25, 0xf9, 0x93, 0xad,
-	0xc9, 0x78, 0xd6, 0x4b, 0x81, 0x99, 0x05, 0x2e, 0x56, 0x3c, 0xea, 0x3e, 0x33, 0x34, 0x2a, 0xe5,
-	0xb9, 0x83, 0xab, 0x73, 0x0e, 0xfa, 0x42, 0x3f, 0xeb, 0x23, 0xb4, 0xc3, 0x0d, 0x28, 0xd2, 0x17,
-	0x3e, 0xb5, 0x3c, 0xc3, 0xb6, 0xa4, 0x15, 0xee, 0xe4, 0xa3, 0x05, 0xab, 0x48, 0x4d, 0x7d, 0xd6,
-	0xc5, 0xd4, 0x0e, 0xdf, 0x81, 0x15, 0xdb, 0xf1, 0x0d, 0xdb, 0xf2, 0xa4, 0xc2, 0x76, 0x6a, 0xa7,
-	0xb4, 0xf7, 0xee, 0x42, 0x22, 0x74, 0x05, 0x46, 0x09, 0xc1, 0xb8, 0x05, 0xc8, 0xb3, 0x27, 0xae,
-	0x46, 0x55, 0xcd, 0xd6, 0xa9, 0x6a, 0x58, 0x43, 0x5b, 0x2a, 0x72, 0x07, 0x97, 0xe7, 0x27, 0xc2,
-	0x81, 0x0d, 0x5b, 0xa7, 0x2d, 0x6b, 0x68, 0x2b, 0x15, 0x2f, 0x31, 0xc6, 0x17, 0x20, 0xef, 0x9d,
-	0x59, 0x3e, 0x79, 0x21, 0x95, 0x39, 0x43, 0x82, 0x51, 0xf5, 0xbf, 0x39, 0x58, 0x3b, 0x0f, 0xc5,
-	0xee, 0x41, 0x6e, 0xc8, 0x66, 0x29, 0xa5, 0xff, 0x9f, 0x1c, 0x08, 0x9b, 0x64, 0x12, 0xf3, 0x3f,
-	0x30, 0x89, 0x75, 0x28, 0x59, 0xd4, 0xf3, 0xa9, 0x2e, 0x18, 0x91, 0x39, 0x27, 0xa7, 0x40, 0x18,
-	0xcd, 0x53, 0x2a, 0xfb, 0x83, 0x28, 0xf5, 0x08, 0xd6, 0xa2, 0x90, 0x54, 0x97, 0x58, 0xa3, 0x90,
-	0x9b, 0xbb, 0xaf, 0x8b, 0xa4, 0x26, 0x87, 0x76, 0x0a, 0x33, 0x53, 0x2a, 0x34, 0x31, 0xc6, 0x4d,
-	0x00, 0xdb, 0xa2, 0xf6, 0x50, 0xd5, 0xa9, 0x66, 0x4a, 0x85, 0x25, 0x59, 0xea, 0x32, 0xc8, 0x5c,
-	0x96, 0x6c, 0x21, 0xd5, 0x4c, 0xfc, 0xf9, 0x94, 0x6a, 0x2b, 0x4b, 0x98, 0x72, 0x24, 0x36, 0xd9,
-	0x1c, 0xdb, 0x8e, 0xa1, 0xe2, 0x52, 0xc6, 0x7b, 0xaa, 0x07, 0x33, 0x2b, 0xf2, 0x20, 0x6a, 0xaf,
-	0x9d, 0x99, 0x12, 0x98, 0x89, 0x89, 0xad, 0xba, 0xf1, 0x21, 0xfe, 0x00, 0x22, 0x81, 0xca, 0x69,
-	0x05, 0xfc, 0x14, 0x2a, 0x87, 0xc2, 0x0e, 0x19, 0xd3, 0xad, 0xbb, 0x50, 0x49, 0xa6, 0x07, 0x6f,
-	0x42, 0xce, 0xf3, 0x89, 0xeb, 0x73, 0x16, 0xe6, 0x14, 0x31, 0xc0, 0x08, 0x32, 0xd4, 0xd2, 0xf9,
-	0x29, 0x97, 0x53, 0xd8, 0xbf, 0x5b, 0x9f, 0xc1, 0x6a, 0xe2, 0xf3, 0xe7, 0x35, 0xac, 0xfe, 0x3e,
-	0x0f, 0x9b, 0x8b, 0x38, 0xb7, 0x90, 0xfe, 0x17, 0x20, 0x6f, 0x4d, 0xc6, 0x27, 0xd4, 0x95, 0x32,
-	0xdc, 0x43, 0x30, 0xc2, 0x75, 0xc8, 0x99, 0xe4, 0x84, 0x9a, 0x52, 0x76, 0x3b, 0xb5, 0x53, 0xd9,
-	0xbb, 0x7e, 0x2e, 0x56, 0xd7, 0xda, 0xcc, 0x44, 0x11, 0x96, 0xf8, 0x3e, 0x64, 0x83, 0x23, 0x8e,
-	0x79, 0xb8, 0x76, 0x3e, 0x0f, 0x8c, 0x8b, 0x0a, 0xb7, 0xc3, 0xef, 0x40, 0x91, 0xfd, 0x15, 0xb9,
-	0xcd, 0xf3, 0x98, 0x0b, 0x4c, 0xc0, 0xf2, 0x8a, 0xb7, 0xa0, 0xc0, 0x69, 0xa6, 0xd3, 0xb0, 0x34,
-	0x44, 0x63, 0xb6, 0x30, 0x3a, 0x1d, 0x92, 0x89, 0xe9, 0xab, 0xcf, 0x88, 0x39, 0xa1, 0x9c, 0x30,
-	0x45, 0xa5, 0x1c, 0x08, 0x7f, 0xcd, 0x64, 0xf8, 0x32, 0x94, 0x04, 0x2b, 0x0d, 0x4b, 0xa7, 0x2f,
-	0xf8, 0xe9, 0x93, 0x53, 0x04, 0x51, 0x5b, 0x4c, 0xc2, 0x3e, 0xff, 0xc4, 0xb3, 0xad, 0x70, 0x69,
-	0xf9, 0x27, 0x98, 0x80, 0x7f, 0xfe, 0xb3, 0xd9, 0x83, 0xef, 0xbd, 0xc5, 0xd3, 0x9b, 0xe5, 0x62,
-	0xf5, 0x2f, 0x69, 0xc8, 0xf2, 0xfd, 0xb6, 0x06, 0xa5, 0xc1, 0xe3, 0x9e, 0xac, 0x36, 0xbb, 0xc7,
-	0x07, 0x6d, 0x19, 0xa5, 0x70, 0x05, 0x80, 0x0b, 0x1e, 0xb4, 0xbb, 0xf5, 0x01, 0x4a, 0x47, 0xe3,
-	0x56, 0x67, 0x70, 0xe7, 0x16, 0xca, 0x44, 0x06, 0xc7, 0x42, 0x90, 0x8d, 0x03, 0x6e, 0xee, 0xa1,
-	0x1c, 0x46, 0x50, 0x16, 0x0e, 0x5a, 0x8f, 0xe4, 0xe6, 0x9d, 0x5b, 0x28, 0x9f, 0x94, 0xdc, 0xdc,
-	0x43, 0x2b, 0x78, 0x15, 0x8a, 0x5c, 0x72, 0xd0, 0xed, 0xb6, 0x51, 0x21, 0xf2, 0xd9, 0x1f, 0x28,
-	0xad, 0xce, 0x21, 0x2a, 0x46, 0x3e, 0x0f, 0x95, 0xee, 0x71, 0x0f, 0x41, 0xe4, 0xe1, 0x48, 0xee,
-	0xf7, 0xeb, 0x87, 0x32, 0x2a, 0x45, 0x88, 0x83, 0xc7, 0x03, 0xb9, 0x8f, 0xca, 0x89, 0xb0, 0x6e,
-	0xee, 0xa1, 0xd5, 0xe8, 0x13, 0x72, 0xe7, 0xf8, 0x08, 0x55, 0xf0, 0x3a, 0xac, 0x8a, 0x4f, 0x84,
-	0x41, 0xac, 0xcd, 0x88, 0xee, 0xdc, 0x42, 0x68, 0x1a, 0x88, 0xf0, 0xb2, 0x9e, 0x10, 0xdc, 0xb9,
-	0x85, 0x70, 0xb5, 0x01, 0x39, 0xce, 0x2e, 0x8c, 0xa1, 0xd2, 0xae, 0x1f, 0xc8, 0x6d, 0xb5, 0xdb,
-	0x1b, 0xb4, 0xba, 0x9d, 0x7a, 0x1b, 0xa5, 0xa6, 0x32, 0x45, 0xfe, 0xd5, 0x71, 0x4b, 0x91, 0x9b,
-	0x28, 0x1d, 0x97, 0xf5, 0xe4, 0xfa, 0x40, 0x6e, 0xa2, 0x4c, 0x55, 0x83, 0xcd, 0x45, 0xe7, 0xcc,
-	0xc2, 0x9d, 0x11, 0x5b, 0xe2, 0xf4, 0x92, 0x25, 0xe6, 0xbe, 0xe6, 0x96, 0xf8, 0xdb, 0x14, 0x6c,
-	0x2c, 0x38, 0x6b, 0x17, 0x7e, 0xe4, 0x17, 0x90, 0x13, 0x14, 0x15, 0xd5, 0xe7, 0x93, 0x85, 0x87,
-	0x36, 0x27, 0xec, 0x5c, 0x05, 0xe2, 0x76, 0xf1, 0x0a, 0x9c, 0x59, 0x52, 0x81, 0x99, 0x8b, 0xb9,
-	0x20, 0x7f, 0x93, 0x02, 0x69, 0x99, 0xef, 0xd7, 0x1c, 0x14, 0xe9, 0xc4, 0x41, 0x71, 0x6f, 0x36,
-	0x80, 0x2b, 0xcb, 0xe7, 0x30, 0x17, 0xc5, 0x77, 0x29, 0xb8, 0xb0, 0xb8, 0x51, 0x59, 0x18, 0xc3,
-	0x7d, 0xc8, 0x8f, 0xa9, 0x7f, 0x6a, 0x87, 0xc5, 0xfa, 0xe3, 0x05, 0x25, 0x80, 0xa9, 0x67, 0x73,
-	0x15, 0x58, 0xc5, 0x6b, 0x48, 0x66, 0x59, 0xb7, 0x21, 0xa2, 0x99, 0x8b, 0xf4, 0xb7, 0x69, 0x78,
-	0x7b, 0xa1, 0xf3, 0x85, 0x81, 0xbe, 0x07, 0x60, 0x58, 0xce, 0xc4, 0x17, 0x05, 0x59, 0x9c, 0x4f,
-	0x45, 0x2e, 0xe1, 0x7b, 0x9f, 0x9d, 0x3d, 0x13, 0x3f, 0xd2, 0x67, 0xb8, 0x1e, 0x84, 0x88, 0x03,
-	0xee, 0x4e, 0x03, 0xcd, 0xf2, 0x40, 0xdf, 0x5f, 0x32, 0xd3, 0xb9, 0x5a, 0xf7, 0x29, 0x20, 0xcd,
-	0x34, 0xa8, 0xe5, 0xab, 0x9e, 0xef, 0x52, 0x32, 0x36, 0xac, 0x11, 0x3f, 0x80, 0x0b, 0xfb, 0xb9,
-	0x21, 0x31, 0x3d, 0xaa, 0xac, 0x09, 0x75, 0x3f, 0xd4, 0x32, 0x0b, 0x5e, 0x65, 0xdc, 0x98, 0x45,
-	0x3e, 0x61, 0x21, 0xd4, 0x91, 0x45, 0xf5, 0xef, 0x2b, 0x50, 0x8a, 0xb5, 0x75, 0xf8, 0x0a, 0x94,
-	0x9f, 0x90, 0x67, 0x44, 0x0d, 0x5b, 0x75, 0x91, 0x89, 0x12, 0x93, 0xf5, 0x82, 0x76, 0xfd, 0x53,
-	0xd8, 0xe4, 0x10, 0x7b, 0xe2, 0x53, 0x57, 0xd5, 0x4c, 0xe2, 0x79, 0x3c, 0x69, 0x05, 0x0e, 0xc5,
-	0x4c, 0xd7, 0x65, 0xaa, 0x46, 0xa8, 0xc1, 0xb7, 0x61, 0x83, 0x5b, 0x8c, 0x27, 0xa6, 0x6f, 0x38,
-	0x26, 0x55, 0xd9, 0xe5, 0xc1, 0xe3, 0x07, 0x71, 0x14, 0xd9, 0x3a, 0x43, 0x1c, 0x05, 0x00, 0x16,
-	0x91, 0x87, 0x9b, 0xf0, 0x1e, 0x37, 0x1b, 0x51, 0x8b, 0xba, 0xc4, 0xa7, 0x2a, 0xfd, 0x7a, 0x42,
-	0x4c, 0x4f, 0x25, 0x96, 0xae, 0x9e, 0x12, 0xef, 0x54, 0xda, 0x64, 0x0e, 0x0e, 0xd2, 0x52, 0x4a,
-	0xb9, 0xc4, 0x80, 0x87, 0x01, 0x4e, 0xe6, 0xb0, 0xba, 0xa5, 0x7f, 0x41, 0xbc, 0x53, 0xbc, 0x0f,
-	0x17, 0xb8, 0x17, 0xcf, 0x77, 0x0d, 0x6b, 0xa4, 0x6a, 0xa7, 0x54, 0x7b, 0xaa, 0x4e, 0xfc, 0xe1,
-	0x5d, 0xe9, 0x9d, 0xf8, 0xf7, 0x79, 0x84, 0x7d, 0x8e, 0x69, 0x30, 0xc8, 0xb1, 0x3f, 0xbc, 0x8b,
-	0xfb, 0x50, 0x66, 0x8b, 0x31, 0x36, 0xbe, 0xa1, 0xea, 0xd0, 0x76, 0x79, 0x65, 0xa9, 0x2c, 0xd8,
-	0xd9, 0xb1, 0x0c, 0xd6, 0xba, 0x81, 0xc1, 0x91, 0xad, 0xd3, 0xfd, 0x5c, 0xbf, 0x27, 0xcb, 0x4d,
-	0xa5, 0x14, 0x7a, 0x79, 0x60, 0xbb, 0x8c, 0x50, 0x23, 0x3b, 0x4a, 0x70, 0x49, 0x10, 0x6a, 0x64,
-	0x87, 0xe9, 0xbd, 0x0d, 0x1b, 0x9a, 0x26, 0xe6, 0x6c, 0x68, 0x6a, 0xd0, 0xe2, 0x7b, 0x12, 0x4a,
-	0x24, 0x4b, 0xd3, 0x0e, 0x05, 0x20, 0xe0, 0xb8, 0x87, 0x3f, 0x87, 0xb7, 0xa7, 0xc9, 0x8a, 0x1b,
-	0xae, 0xcf, 0xcd, 0x72, 0xd6, 0xf4, 0x36, 0x6c, 0x38, 0x67, 0xf3, 0x86, 0x38, 0xf1, 0x45, 0xe7,
-	0x6c, 0xd6, 0xec, 0x23, 0x7e, 0x6d, 0x73, 0xa9, 0x46, 0x7c, 0xaa, 0x4b, 0x17, 0xe3, 0xe8, 0x98,
-	0x02, 0xef, 0x02, 0xd2, 0x34, 0x95, 0x5a, 0xe4, 0xc4, 0xa4, 0x2a, 0x71, 0xa9, 0x45, 0x3c, 0xe9,
-	0x72, 0x1c, 0x5c, 0xd1, 0x34, 0x99, 0x6b, 0xeb, 0x5c, 0x89, 0xaf, 0xc1, 0xba, 0x7d, 0xf2, 0x44,
-	0x13, 0xcc, 0x52, 0x1d, 0x97, 0x0e, 0x8d, 0x17, 0xd2, 0x87, 0x3c, 0x4d, 0x6b, 0x4c, 0xc1, 0x79,
-	0xd5, 0xe3, 0x62, 0xfc, 0x09, 0x20, 0xcd, 0x3b, 0x25, 0xae, 0xc3, 0x4b, 0xbb, 0xe7, 0x10, 0x8d,
-	0x4a, 0x1f, 0x09, 0xa8, 0x90, 0x77, 0x42, 0x31, 0x63, 0xb6, 0xf7, 0xdc, 0x18, 0xfa, 0xa1, 0xc7,
-	0xab, 0x82, 0xd9, 0x5c, 0x16, 0x78, 0xdb, 0x01, 0xe4, 0x9c, 0x3a, 0xc9, 0x0f, 0xef, 0x70, 0x58,
-	0xc5, 0x39, 0x75, 0xe2, 0xdf, 0x7d, 0x04, 0x9b, 0x13, 0xcb, 0xb0, 0x7c, 0xea, 0x3a, 0x2e, 0x65,
-	0xed, 0xbe, 0xd8, 0xb3, 0xd2, 0xbf, 0x57, 0x96, 0x34, 0xec, 0xc7, 0x71, 0xb4, 0xa0, 0x8a, 0xb2,
-	0x31, 0x99, 0x17, 0x56, 0xf7, 0xa1, 0x1c, 0x67, 0x10, 0x2e, 0x82, 0xe0, 0x10, 0x4a, 0xb1, 0x6a,
-	0xdc, 0xe8, 0x36, 0x59, 0x1d, 0xfd, 0x4a, 0x46, 0x69, 0x56, 0xcf, 0xdb, 0xad, 0x81, 0xac, 0x2a,
-	0xc7, 0x9d, 0x41, 0xeb, 0x48, 0x46, 0x99, 0x6b, 0xc5, 0xc2, 0x7f, 0x56, 0xd0, 0xcb, 0x97, 0x2f,
-	0x5f, 0xa6, 0x1f, 0x66, 0x0b, 0x1f, 0xa3, 0xab, 0xd5, 0xef, 0xd3, 0x50, 0x49, 0x76, 0xd2, 0xf8,
-	0xe7, 0x70, 0x31, 0xbc, 0xf6, 0x7a, 0xd4, 0x57, 0x9f, 0x1b, 0x2e, 0xa7, 0xf6, 0x98, 0x88, 0x5e,
-	0x34, 0x5a, 0x95, 0xcd, 0x00, 0xd5, 0xa7, 0xfe, 0x97, 0x86, 0xcb, 0x88, 0x3b, 0x26, 0x3e, 0x6e,
-	0xc3, 0x65, 0xcb, 0x56, 0x3d, 0x9f, 0x58, 0x3a, 0x71, 0x75, 0x75, 0xfa, 0xe0, 0xa0, 0x12, 0x4d,
-	0xa3, 0x9e, 0x67, 0x8b, 0x92, 0x12, 0x79, 0x79, 0xd7, 0xb2, 0xfb, 0x01, 0x78, 0x7a, 0xd6, 0xd6,
-	0x03, 0xe8, 0x0c, 0x83, 0x32, 0xcb, 0x18, 0xf4, 0x0e, 0x14, 0xc7, 0xc4, 0x51, 0xa9, 0xe5, 0xbb,
-	0x67, 0xbc, 0xff, 0x2b, 0x28, 0x85, 0x31, 0x71, 0x64, 0x36, 0x7e, 0x73, 0x2b, 0x91, 0xcc, 0x66,
-	0x01, 0x15, 0x1f, 0x66, 0x0b, 0x45, 0x04, 0xd5, 0x7f, 0x66, 0xa0, 0x1c, 0xef, 0x07, 0x59, 0x7b,
-	0xad, 0xf1, 0xb3, 0x3f, 0xc5, 0x4f, 0x87, 0x0f, 0x5e, 0xd9, 0x3d, 0xd6, 0x1a, 0xac, 0x28, 0xec,
-	0xe7, 0x45, 0x97, 0xa6, 0x08, 0x4b, 0x56, 0x90, 0xd9, 0x79, 0x40, 0x45, 0xef, 0x5f, 0x50, 0x82,
-	0x11, 0x3e, 0x84, 0xfc, 0x13, 0x8f, 0xfb, 0xce, 0x73, 0xdf, 0x1f, 0xbe, 0xda, 0xf7, 0xc3, 0x3e,
-	0x77, 0x5e, 0x7c, 0xd8, 0x57, 0x3b, 0x5d, 0xe5, 0xa8, 0xde, 0x56, 0x02, 0x73, 0x7c, 0x09, 0xb2,
-	0x26, 0xf9, 0xe6, 0x2c, 0x59, 0x3e, 0xb8, 0xe8, 0xbc, 0x8b, 0x70, 0x09, 0xb2, 0xcf, 0x29, 0x79,
-	0x9a, 0x3c, 0xb4, 0xb9, 0xe8, 0x0d, 0x6e, 0x86, 0x5d, 0xc8, 0xf1, 0x7c, 0x61, 0x80, 0x20, 0x63,
-	0xe8, 0x2d, 0x5c, 0x80, 0x6c, 0xa3, 0xab, 0xb0, 0x0d, 0x81, 0xa0, 0x2c, 0xa4, 0x6a, 0xaf, 0x25,
-	0x37, 0x64, 0x94, 0xae, 0xde, 0x86, 0xbc, 0x48, 0x02, 0xdb, 0x2c, 0x51, 0x1a, 0xd0, 0x5b, 0xc1,
-	0x30, 0xf0, 0x91, 0x0a, 0xb5, 0xc7, 0x47, 0x07, 0xb2, 0x82, 0xd2, 0xc9, 0xa5, 0xce, 0xa2, 0x5c,
-	0xd5, 0x83, 0x72, 0xbc, 0x21, 0xfc, 0x51, 0x58, 0x56, 0xfd, 0x6b, 0x0a, 0x4a, 0xb1, 0x06, 0x8f,
-	0xb5, 0x16, 0xc4, 0x34, 0xed, 0xe7, 0x2a, 0x31, 0x0d, 0xe2, 0x05, 0xd4, 0x00, 0x2e, 0xaa, 0x33,
-	0xc9, 0x79, 0x97, 0xee, 0x47, 0xda, 0x22, 0x39, 0x94, 0xaf, 0xfe, 0x29, 0x05, 0x68, 0xb6, 0x45,
-	0x9c, 0x09, 0x33, 0xf5, 0x53, 0x86, 0x59, 0xfd, 0x63, 0x0a, 0x2a, 0xc9, 0xbe, 0x70, 0x26, 0xbc,
-	0x2b, 0x3f, 0x69, 0x78, 0xff, 0x48, 0xc3, 0x6a, 0xa2, 0x1b, 0x3c, 0x6f, 0x74, 0x5f, 0xc3, 0xba,
-	0xa1, 0xd3, 0xb1, 0x63, 0xfb, 0xd4, 0xd2, 0xce, 0x54, 0x93, 0x3e, 0xa3, 0xa6, 0x54, 0xe5, 0x87,
-	0xc6, 0xee, 0xab, 0xfb, 0xcd, 0x5a, 0x6b, 0x6a, 0xd7, 0x66, 0x66, 0xfb, 0x1b, 0xad, 0xa6, 0x7c,
-	0xd4, 0xeb, 0x0e, 0xe4, 0x4e, 0xe3, 0xb1, 0x7a, 0xdc, 0xf9, 0x65, 0xa7, 0xfb, 0x65, 0x47, 0x41,
-	0xc6, 0x0c, 0xec, 0x0d, 0x6e, 0xfb, 0x1e, 0xa0, 0xd9, 0xa0, 0xf0, 0x45, 0x58, 0x14, 0x16, 0x7a,
-	0x0b, 0x6f, 0xc0, 0x5a, 0xa7, 0xab, 0xf6, 0x5b, 0x4d, 0x59, 0x95, 0x1f, 0x3c, 0x90, 0x1b, 0x83,
-	0xbe, 0xb8, 0x80, 0x47, 0xe8, 0x41, 0x62, 0x83, 0x57, 0xff, 0x90, 0x81, 0x8d, 0x05, 0x91, 0xe0,
-	0x7a, 0xd0, 0xfb, 0x8b, 0xeb, 0xc8, 0x8d, 0xf3, 0x44, 0x5f, 0x63, 0xdd, 0x45, 0x8f, 0xb8, 0x7e,
-	0x70, 0x55, 0xf8, 0x04, 0x58, 0x96, 0x2c, 0xdf, 0x18, 0x1a, 0xd4, 0x0d, 0xde, 0x2b, 0xc4, 0x85,
-	0x60, 0x6d, 0x2a, 0x17, 0x4f, 0x16, 0x3f, 0x03, 0xec, 0xd8, 0x9e, 0xe1, 0x1b, 0xcf, 0xa8, 0x6a,
-	0x58, 0xe1, 0xe3, 0x06, 0xbb, 0x20, 0x64, 0x15, 0x14, 0x6a, 0x5a, 0x96, 0x1f, 0xa1, 0x2d, 0x3a,
-	0x22, 0x33, 0x68, 0x76, 0x98, 0x67, 0x14, 0x14, 0x6a, 0x22, 0xf4, 0x15, 0x28, 0xeb, 0xf6, 0x84,
-	0xb5, 0x5b, 0x02, 0xc7, 0x6a, 0x47, 0x4a, 0x29, 0x09, 0x59, 0x04, 0x09, 0xfa, 0xe1, 0xe9, 0xab,
-	0x4a, 0x59, 0x29, 0x09, 0x99, 0x80, 0x5c, 0x85, 0x35, 0x32, 0x1a, 0xb9, 0xcc, 0x79, 0xe8, 0x48,
-	0x74, 0xf8, 0x95, 0x48, 0xcc, 0x81, 0x5b, 0x0f, 0xa1, 0x10, 0xe6, 0x81, 0x95, 0x6a, 0x96, 0x09,
-	0xd5, 0x11, 0x6f, 0x5b, 0xe9, 0x9d, 0xa2, 0x52, 0xb0, 0x42, 0xe5, 0x15, 0x28, 0x1b, 0x9e, 0x3a,
-	0x7d, 0x64, 0x4d, 0x6f, 0xa7, 0x77, 0x0a, 0x4a, 0xc9, 0xf0, 0xa2, 0x57, 0xb5, 0xea, 0x77, 0x69,
-	0xa8, 0x24, 0x1f, 0x89, 0x71, 0x13, 0x0a, 0xa6, 0xad, 0x11, 0x4e, 0x2d, 0xf1, 0x0b, 0xc5, 0xce,
-	0x6b, 0xde, 0x95, 0x6b, 0xed, 0x00, 0xaf, 0x44, 0x96, 0x5b, 0x7f, 0x4b, 0x41, 0x21, 0x14, 0xe3,
-	0x0b, 0x90, 0x75, 0x88, 0x7f, 0xca, 0xdd, 0xe5, 0x0e, 0xd2, 0x28, 0xa5, 0xf0, 0x31, 0x93, 0x7b,
-	0x0e, 0xb1, 0x38, 0x05, 0x02, 0x39, 0x1b, 0xb3, 0x75, 0x35, 0x29, 0xd1, 0xf9, 0xf5, 0xc1, 0x1e,
-	0x8f, 0xa9, 0xe5, 0x7b, 0xe1, 0xba, 0x06, 0xf2, 0x46, 0x20, 0xc6, 0xd7, 0x61, 0xdd, 0x77, 0x89,
-	0x61, 0x26, 0xb0, 0x59, 0x8e, 0x45, 0xa1, 0x22, 0x02, 0xef, 0xc3, 0xa5, 0xd0, 0xaf, 0x4e, 0x7d,
-	0xa2, 0x9d, 0x52, 0x7d, 0x6a, 0x94, 0xe7, 0x2f, 0x90, 0x17, 0x03, 0x40, 0x33, 0xd0, 0x87, 0xb6,
-	0xd5, 0xef, 0x53, 0xb0, 0x1e, 0x5e, 0x78, 0xf4, 0x28, 0x59, 0x47, 0x00, 0xc4, 0xb2, 0x6c, 0x3f,
-	0x9e, 0xae, 0x79, 0x2a, 0xcf, 0xd9, 0xd5, 0xea, 0x91, 0x91, 0x12, 0x73, 0xb0, 0x35, 0x06, 0x98,
-	0x6a, 0x96, 0xa6, 0xed, 0x32, 0x94, 0x82, 0x5f, 0x00, 0xf8, 0xcf, 0x48, 0xe2, 0x8a, 0x0c, 0x42,
-	0xc4, 0x6e, 0x46, 0x78, 0x13, 0x72, 0x27, 0x74, 0x64, 0x58, 0xc1, 0xbb, 0xa4, 0x18, 0x84, 0xaf,
-	0x9d, 0xd9, 0xe8, 0xb5, 0xf3, 0xe0, 0x77, 0x29, 0xd8, 0xd0, 0xec, 0xf1, 0x6c, 0xbc, 0x07, 0x68,
-	0xe6, 0x9e, 0xee, 0x7d, 0x91, 0xfa, 0xea, 0xfe, 0xc8, 0xf0, 0x4f, 0x27, 0x27, 0x35, 0xcd, 0x1e,
-	0xef, 0x8e, 0x6c, 0x93, 0x58, 0xa3, 0xe9, 0xef, 0x60, 0xfc, 0x1f, 0xed, 0xc6, 0x88, 0x5a, 0x37,
-	0x46, 0x76, 0xec, 0x57, 0xb1, 0x7b, 0xd3, 0x7f, 0xbf, 0x4d, 0x67, 0x0e, 0x7b, 0x07, 0x7f, 0x4e,
-	0x6f, 0x1d, 0x8a, 0x6f, 0xf5, 0xc2, 0xdc, 0x28, 0x74, 0x68, 0x52, 0x8d, 0xcd, 0xf7, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x8e, 0x54, 0xe7, 0xef, 0x60, 0x1b, 0x00, 0x00,
+	// 2471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5b, 0x6f, 0xe3, 0xc6,
+	0x15, 0x0e, 0x75, 0xb3, 0x74, 0xa4, 0x95, 0xc6, 0x63, 0x67, 0x97, 0xeb, 0x5c, 0xd6, 0xab, 0x5c,
+	0xd6, 0x49, 0x1a, 0x39, 0xf0, 0x5e, 0xb2, 0x71, 0x8a, 0x14, 0xb2, 0xc4, 0x75, 0xb4, 0x95, 0x25,
+	0x95, 0x92, 0x9b, 0xcb, 0x0b, 0x31, 0x26, 0x47, 0x32, 0x37, 0x14, 0xc9, 0x90, 0xd4, 0xee, 0x3a,
+	0x4f, 0x0b, 0xf4, 0xa9, 0x40, 0x7f, 0x41, 0x51, 0xf4, 0x21, 0x2f, 0x01, 0xfa, 0x03, 0xfa, 0xdc,
+	0xfe, 0x81, 0x02, 0x79, 0xee, 0x4b, 0x51, 0x14, 0x68, 0xff, 0x41, 0x5f, 0x8b, 0x99, 0x21, 0x29,
+	0x52, 0x97, 0xac, 0x1b, 0x20, 0xc9, 0x93, 0x3d, 0xdf, 0x7c, 0xe7, 0xcc, 0x99, 0xc3, 0x6f, 0x66,
+	0xce, 0x8c, 0x60, 0x77, 0xe2, 0x38, 0x13, 0x8b, 0xee, 0xbb, 0x9e, 0x13, 0x38, 0x67, 0xb3, 0xf1,
+	0xbe, 0x41, 0x7d, 0xdd, 0x33, 0xdd, 0xc0, 0xf1, 0x1a, 0x1c, 0xc3, 0x35, 0xc1, 0x68, 0x44, 0x8c,
+	0xfa, 0x09, 0x6c, 0x3e, 0x30, 0x2d, 0xda, 0x8e, 0x89, 0x43, 0x1a, 0xe0, 0xfb, 0x90, 0x1b, 0x9b,
+	0x16, 0x95, 0xa5, 0xdd, 0xec, 0x5e, 0xf9, 0xe0, 0xf5, 0xc6, 0x82, 0x51, 0x23, 0x6d, 0x31, 0x60,
+	0xb0, 0xca, 0x2d, 0xea, 0xff, 0xca, 0xc1, 0xd6, 0x8a, 0x5e, 0x8c, 0x21, 0x67, 0x93, 0x29, 0xf3,
+	0x28, 0xed, 0x95, 0x54, 0xfe, 0x3f, 0x96, 0x61, 0xc3, 0x25, 0xfa, 0x17, 0x64, 0x42, 0xe5, 0x0c,
+	0x87, 0xa3, 0x26, 0x7e, 0x15, 0xc0, 0xa0, 0x2e, 0xb5, 0x0d, 0x6a, 0xeb, 0x17, 0x72, 0x76, 0x37,
+	0xbb, 0x57, 0x52, 0x13, 0x08, 0x7e, 0x07, 0x36, 0xdd, 0xd9, 0x99, 0x65, 0xea, 0x5a, 0x82, 0x06,
+	0xbb, 0xd9, 0xbd, 0xbc, 0x8a, 0x44, 0x47, 0x7b, 0x4e, 0xbe, 0x05, 0xb5, 0x27, 0x94, 0x7c, 0x91,
+	0xa4, 0x96, 0x39, 0xb5, 0xca, 0xe0, 0x04, 0xb1, 0x05, 0x95, 0x29, 0xf5, 0x7d, 0x32, 0xa1, 0x5a,
+	0x70, 0xe1, 0x52, 0x39, 0xc7, 0x67, 0xbf, 0xbb, 0x34, 0xfb, 0xc5, 0x99, 0x97, 0x43, 0xab, 0xd1,
+	0x85, 0x4b, 0x71, 0x13, 0x4a, 0xd4, 0x9e, 0x4d, 0x85, 0x87, 0xfc, 0x9a, 0xfc, 0x29, 0xf6, 0x6c,
+	0xba, 0xe8, 0xa5, 0xc8, 0xcc, 0x42, 0x17, 0x1b, 0x3e, 0xf5, 0x1e, 0x9b, 0x3a, 0x95, 0x0b, 0xdc,
+	0xc1, 0xad, 0x25, 0x07, 0x43, 0xd1, 0xbf, 0xe8, 0x23, 0xb2, 0xc3, 0x2d, 0x28, 0xd1, 0xa7, 0x01,
+	0xb5, 0x7d, 0xd3, 0xb1, 0xe5, 0x0d, 0xee, 0xe4, 0x8d, 0x15, 0x5f, 0x91, 0x5a, 0xc6, 0xa2, 0x8b,
+	0xb9, 0x1d, 0xbe, 0x07, 0x1b, 0x8e, 0x1b, 0x98, 0x8e, 0xed, 0xcb, 0xc5, 0x5d, 0x69, 0xaf, 0x7c,
+	0xf0, 0xf2, 0x4a, 0x21, 0xf4, 0x05, 0x47, 0x8d, 0xc8, 0xb8, 0x03, 0xc8, 0x77, 0x66, 0x9e, 0x4e,
+	0x35, 0xdd, 0x31, 0xa8, 0x66, 0xda, 0x63, 0x47, 0x2e, 0x71, 0x07, 0x37, 0x96, 0x27, 0xc2, 0x89,
+	0x2d, 0xc7, 0xa0, 0x1d, 0x7b, 0xec, 0xa8, 0x55, 0x3f, 0xd5, 0xc6, 0x57, 0xa1, 0xe0, 0x5f, 0xd8,
+	0x01, 0x79, 0x2a, 0x57, 0xb8, 0x42, 0xc2, 0x56, 0xfd, 0xbf, 0x79, 0xa8, 0x5d, 0x46, 0x62, 0x1f,
+	0x42, 0x7e, 0xcc, 0x66, 0x29, 0x67, 0xfe, 0x9f, 0x1c, 0x08, 0x9b, 0x74, 0x12, 0x0b, 0xdf, 0x33,
+	0x89, 0x4d, 0x28, 0xdb, 0xd4, 0x0f, 0xa8, 0x21, 0x14, 0x91, 0xbd, 0xa4, 0xa6, 0x40, 0x18, 0x2d,
+	0x4b, 0x2a, 0xf7, 0xbd, 0x24, 0xf5, 0x29, 0xd4, 0xe2, 0x90, 0x34, 0x8f, 0xd8, 0x93, 0x48, 0x9b,
+	0xfb, 0xcf, 0x8b, 0xa4, 0xa1, 0x44, 0x76, 0x2a, 0x33, 0x53, 0xab, 0x34, 0xd5, 0xc6, 0x6d, 0x00,
+	0xc7, 0xa6, 0xce, 0x58, 0x33, 0xa8, 0x6e, 0xc9, 0xc5, 0x35, 0x59, 0xea, 0x33, 0xca, 0x52, 0x96,
+	0x1c, 0x81, 0xea, 0x16, 0xfe, 0x60, 0x2e, 0xb5, 0x8d, 0x35, 0x4a, 0x39, 0x11, 0x8b, 0x6c, 0x49,
+	0x6d, 0xa7, 0x50, 0xf5, 0x28, 0xd3, 0x3d, 0x35, 0xc2, 0x99, 0x95, 0x78, 0x10, 0x8d, 0xe7, 0xce,
+	0x4c, 0x0d, 0xcd, 0xc4, 0xc4, 0xae, 0x78, 0xc9, 0x26, 0x7e, 0x0d, 0x62, 0x40, 0xe3, 0xb2, 0x02,
+	0xbe, 0x0b, 0x55, 0x22, 0xb0, 0x47, 0xa6, 0x74, 0xe7, 0x3e, 0x54, 0xd3, 0xe9, 0xc1, 0xdb, 0x90,
+	0xf7, 0x03, 0xe2, 0x05, 0x5c, 0x85, 0x79, 0x55, 0x34, 0x30, 0x82, 0x2c, 0xb5, 0x0d, 0xbe, 0xcb,
+	0xe5, 0x55, 0xf6, 0xef, 0xce, 0xfb, 0x70, 0x25, 0x35, 0xfc, 0x65, 0x0d, 0xeb, 0x7f, 0x2d, 0xc0,
+	0xf6, 0x2a, 0xcd, 0xad, 0x94, 0xff, 0x55, 0x28, 0xd8, 0xb3, 0xe9, 0x19, 0xf5, 0xe4, 0x2c, 0xf7,
+	0x10, 0xb6, 0x70, 0x13, 0xf2, 0x16, 0x39, 0xa3, 0x96, 0x9c, 0xdb, 0x95, 0xf6, 0xaa, 0x07, 0xef,
+	0x5c, 0x4a, 0xd5, 0x8d, 0x2e, 0x33, 0x51, 0x85, 0x25, 0xfe, 0x08, 0x72, 0xe1, 0x16, 0xc7, 0x3c,
+	0xbc, 0x7d, 0x39, 0x0f, 0x4c, 0x8b, 0x2a, 0xb7, 0xc3, 0x2f, 0x41, 0x89, 0xfd, 0x15, 0xb9, 0x2d,
+	0xf0, 0x98, 0x8b, 0x0c, 0x60, 0x79, 0xc5, 0x3b, 0x50, 0xe4, 0x32, 0x33, 0x68, 0x74, 0x34, 0xc4,
+	0x6d, 0xf6, 0x61, 0x0c, 0x3a, 0x26, 0x33, 0x2b, 0xd0, 0x1e, 0x13, 0x6b, 0x46, 0xb9, 0x60, 0x4a,
+	0x6a, 0x25, 0x04, 0x7f, 0xcd, 0x30, 0x7c, 0x03, 0xca, 0x42, 0x95, 0xa6, 0x6d, 0xd0, 0xa7, 0x7c,
+	0xf7, 0xc9, 0xab, 0x42, 0xa8, 0x1d, 0x86, 0xb0, 0xe1, 0x1f, 0xf9, 0x8e, 0x1d, 0x7d, 0x5a, 0x3e,
+	0x04, 0x03, 0xf8, 0xf0, 0xef, 0x2f, 0x6e, 0x7c, 0xaf, 0xac, 0x9e, 0xde, 0x92, 0x16, 0x6f, 0x41,
+	0x8d, 0x33, 0x6e, 0x6b, 0x02, 0x21, 0x96, 0xbc, 0xb9, 0x2b, 0xed, 0x15, 0xd5, 0xaa, 0x80, 0xfb,
+	0x21, 0x5a, 0xff, 0x73, 0x06, 0x72, 0x7c, 0x61, 0xd6, 0xa0, 0x3c, 0xfa, 0x6c, 0xa0, 0x68, 0xed,
+	0xfe, 0xe9, 0x51, 0x57, 0x41, 0x12, 0xae, 0x02, 0x70, 0xe0, 0x41, 0xb7, 0xdf, 0x1c, 0xa1, 0x4c,
+	0xdc, 0xee, 0xf4, 0x46, 0xf7, 0xee, 0xa0, 0x6c, 0x6c, 0x70, 0x2a, 0x80, 0x5c, 0x92, 0x70, 0xfb,
+	0x00, 0xe5, 0x31, 0x82, 0x8a, 0x70, 0xd0, 0xf9, 0x54, 0x69, 0xdf, 0xbb, 0x83, 0x0a, 0x69, 0xe4,
+	0xf6, 0x01, 0xda, 0xc0, 0x57, 0xa0, 0xc4, 0x91, 0xa3, 0x7e, 0xbf, 0x8b, 0x8a, 0xb1, 0xcf, 0xe1,
+	0x48, 0xed, 0xf4, 0x8e, 0x51, 0x29, 0xf6, 0x79, 0xac, 0xf6, 0x4f, 0x07, 0x08, 0x62, 0x0f, 0x27,
+	0xca, 0x70, 0xd8, 0x3c, 0x56, 0x50, 0x39, 0x66, 0x1c, 0x7d, 0x36, 0x52, 0x86, 0xa8, 0x92, 0x0a,
+	0xeb, 0xf6, 0x01, 0xba, 0x12, 0x0f, 0xa1, 0xf4, 0x4e, 0x4f, 0x50, 0x15, 0x6f, 0xc2, 0x15, 0x31,
+	0x44, 0x14, 0x44, 0x6d, 0x01, 0xba, 0x77, 0x07, 0xa1, 0x79, 0x20, 0xc2, 0xcb, 0x66, 0x0a, 0xb8,
+	0x77, 0x07, 0xe1, 0x7a, 0x0b, 0xf2, 0x5c, 0x86, 0x18, 0x43, 0xb5, 0xdb, 0x3c, 0x52, 0xba, 0x5a,
+	0x7f, 0x30, 0xea, 0xf4, 0x7b, 0xcd, 0x2e, 0x92, 0xe6, 0x98, 0xaa, 0xfc, 0xea, 0xb4, 0xa3, 0x2a,
+	0x6d, 0x94, 0x49, 0x62, 0x03, 0xa5, 0x39, 0x52, 0xda, 0x28, 0x5b, 0xd7, 0x61, 0x7b, 0xd5, 0x86,
+	0xb4, 0x72, 0x09, 0x25, 0xb4, 0x90, 0x59, 0xa3, 0x05, 0xee, 0x6b, 0x51, 0x0b, 0xf5, 0xaf, 0x25,
+	0xd8, 0x5a, 0xb1, 0x29, 0xaf, 0x1c, 0xe4, 0x17, 0x90, 0x17, 0x5a, 0x16, 0xc7, 0xd4, 0x5b, 0x2b,
+	0x77, 0x77, 0xae, 0xec, 0xa5, 0xa3, 0x8a, 0xdb, 0x25, 0x8f, 0xea, 0xec, 0x9a, 0xa3, 0x9a, 0xb9,
+	0x58, 0x0a, 0xf2, 0x37, 0x12, 0xc8, 0xeb, 0x7c, 0x3f, 0x67, 0x47, 0xc9, 0xa4, 0x76, 0x94, 0x0f,
+	0x17, 0x03, 0xb8, 0xb9, 0x7e, 0x0e, 0x4b, 0x51, 0x7c, 0x23, 0xc1, 0xd5, 0xd5, 0x15, 0xcd, 0xca,
+	0x18, 0x3e, 0x82, 0xc2, 0x94, 0x06, 0xe7, 0x4e, 0x74, 0xaa, 0xbf, 0xb9, 0xe2, 0xac, 0x60, 0xdd,
+	0x8b, 0xb9, 0x0a, 0xad, 0xf0, 0x07, 0x8b, 0xb1, 0xde, 0x58, 0x57, 0x5f, 0x2d, 0x45, 0xfa, 0xdb,
+	0x0c, 0xbc, 0xb8, 0xd2, 0xf9, 0xca, 0x40, 0x5f, 0x01, 0x30, 0x6d, 0x77, 0x16, 0x88, 0x93, 0x5b,
+	0x6c, 0x64, 0x25, 0x8e, 0xf0, 0xb5, 0xcf, 0x36, 0xa9, 0x59, 0x10, 0xf7, 0x67, 0x79, 0x3f, 0x08,
+	0x88, 0x13, 0xee, 0xcf, 0x03, 0xcd, 0xf1, 0x40, 0x5f, 0x5d, 0x33, 0xd3, 0xa5, 0x8d, 0xe8, 0x3d,
+	0x40, 0xba, 0x65, 0x52, 0x3b, 0xd0, 0xfc, 0xc0, 0xa3, 0x64, 0x6a, 0xda, 0x13, 0xbe, 0x53, 0x17,
+	0x0f, 0xf3, 0x63, 0x62, 0xf9, 0x54, 0xad, 0x89, 0xee, 0x61, 0xd4, 0xcb, 0x2c, 0xf8, 0x71, 0xe4,
+	0x25, 0x2c, 0x0a, 0x29, 0x0b, 0xd1, 0x1d, 0x5b, 0xd4, 0xff, 0xbe, 0x01, 0xe5, 0x44, 0xfd, 0x87,
+	0x6f, 0x42, 0xe5, 0x11, 0x79, 0x4c, 0xb4, 0xa8, 0xa6, 0x17, 0x99, 0x28, 0x33, 0x6c, 0x20, 0x20,
+	0xfc, 0x1e, 0x6c, 0x73, 0x8a, 0x33, 0x0b, 0xa8, 0xa7, 0xe9, 0x16, 0xf1, 0x7d, 0x9e, 0xb4, 0x22,
+	0xa7, 0x62, 0xd6, 0xd7, 0x67, 0x5d, 0xad, 0xa8, 0x07, 0xdf, 0x85, 0x2d, 0x6e, 0x31, 0x9d, 0x59,
+	0x81, 0xe9, 0x5a, 0x54, 0x63, 0xb7, 0x0c, 0x5f, 0x86, 0x64, 0x64, 0x9b, 0x8c, 0x71, 0x12, 0x12,
+	0x58, 0x44, 0x3e, 0x6e, 0xc3, 0x2b, 0xdc, 0x6c, 0x42, 0x6d, 0xea, 0x91, 0x80, 0x6a, 0xf4, 0xcb,
+	0x19, 0xb1, 0x7c, 0x8d, 0xd8, 0x86, 0x76, 0x4e, 0xfc, 0x73, 0x79, 0x9b, 0x39, 0x38, 0xca, 0xc8,
+	0x92, 0x7a, 0x9d, 0x11, 0x8f, 0x43, 0x9e, 0xc2, 0x69, 0x4d, 0xdb, 0xf8, 0x98, 0xf8, 0xe7, 0xf8,
+	0x10, 0xae, 0x72, 0x2f, 0x7e, 0xe0, 0x99, 0xf6, 0x44, 0xd3, 0xcf, 0xa9, 0xfe, 0x85, 0x36, 0x0b,
+	0xc6, 0xf7, 0xe5, 0x97, 0x92, 0xe3, 0xf3, 0x08, 0x87, 0x9c, 0xd3, 0x62, 0x94, 0xd3, 0x60, 0x7c,
+	0x1f, 0x0f, 0xa1, 0xc2, 0x3e, 0xc6, 0xd4, 0xfc, 0x8a, 0x6a, 0x63, 0xc7, 0xe3, 0x47, 0x50, 0x75,
+	0xc5, 0xca, 0x4e, 0x64, 0xb0, 0xd1, 0x0f, 0x0d, 0x4e, 0x1c, 0x83, 0x1e, 0xe6, 0x87, 0x03, 0x45,
+	0x69, 0xab, 0xe5, 0xc8, 0xcb, 0x03, 0xc7, 0x63, 0x82, 0x9a, 0x38, 0x71, 0x82, 0xcb, 0x42, 0x50,
+	0x13, 0x27, 0x4a, 0xef, 0x5d, 0xd8, 0xd2, 0x75, 0x31, 0x67, 0x53, 0xd7, 0xc2, 0xbb, 0x80, 0x2f,
+	0xa3, 0x54, 0xb2, 0x74, 0xfd, 0x58, 0x10, 0x42, 0x8d, 0xfb, 0xf8, 0x03, 0x78, 0x71, 0x9e, 0xac,
+	0xa4, 0xe1, 0xe6, 0xd2, 0x2c, 0x17, 0x4d, 0xef, 0xc2, 0x96, 0x7b, 0xb1, 0x6c, 0x88, 0x53, 0x23,
+	0xba, 0x17, 0x8b, 0x66, 0x6f, 0xf0, 0xfb, 0x9d, 0x47, 0x75, 0x12, 0x50, 0x43, 0xbe, 0x96, 0x64,
+	0x27, 0x3a, 0xf0, 0x3e, 0x20, 0x5d, 0xd7, 0xa8, 0x4d, 0xce, 0x2c, 0xaa, 0x11, 0x8f, 0xda, 0xc4,
+	0x97, 0x6f, 0x24, 0xc9, 0x55, 0x5d, 0x57, 0x78, 0x6f, 0x93, 0x77, 0xe2, 0xb7, 0x61, 0xd3, 0x39,
+	0x7b, 0xa4, 0x0b, 0x65, 0x69, 0xae, 0x47, 0xc7, 0xe6, 0x53, 0xf9, 0x75, 0x9e, 0xa6, 0x1a, 0xeb,
+	0xe0, 0xba, 0x1a, 0x70, 0x18, 0xbf, 0x05, 0x48, 0xf7, 0xcf, 0x89, 0xe7, 0xf2, 0x1a, 0xc0, 0x77,
+	0x89, 0x4e, 0xe5, 0x37, 0x04, 0x55, 0xe0, 0xbd, 0x08, 0x66, 0xca, 0xf6, 0x9f, 0x98, 0xe3, 0x20,
+	0xf2, 0x78, 0x4b, 0x28, 0x9b, 0x63, 0xa1, 0xb7, 0x3d, 0x40, 0xee, 0xb9, 0x9b, 0x1e, 0x78, 0x8f,
+	0xd3, 0xaa, 0xee, 0xb9, 0x9b, 0x1c, 0xf7, 0x53, 0xd8, 0x9e, 0xd9, 0xa6, 0x1d, 0x50, 0xcf, 0xf5,
+	0x28, 0xbb, 0x17, 0x88, 0x35, 0x2b, 0xff, 0x7b, 0x63, 0x4d, 0x65, 0x7f, 0x9a, 0x64, 0x0b, 0xa9,
+	0xa8, 0x5b, 0xb3, 0x65, 0xb0, 0x7e, 0x08, 0x95, 0xa4, 0x82, 0x70, 0x09, 0x84, 0x86, 0x90, 0xc4,
+	0x4e, 0xe3, 0x56, 0xbf, 0xcd, 0xce, 0xd1, 0xcf, 0x15, 0x94, 0x61, 0xe7, 0x79, 0xb7, 0x33, 0x52,
+	0x34, 0xf5, 0xb4, 0x37, 0xea, 0x9c, 0x28, 0x28, 0xfb, 0x76, 0xa9, 0xf8, 0x9f, 0x0d, 0xf4, 0xec,
+	0xd9, 0xb3, 0x67, 0x99, 0x87, 0xb9, 0xe2, 0x9b, 0xe8, 0x56, 0xfd, 0xdb, 0x0c, 0x54, 0xd3, 0x25,
+	0x37, 0xfe, 0x39, 0x5c, 0x8b, 0xee, 0xc7, 0x3e, 0x0d, 0xb4, 0x27, 0xa6, 0xc7, 0xa5, 0x3d, 0x25,
+	0xa2, 0x68, 0x8d, 0xbf, 0xca, 0x76, 0xc8, 0x1a, 0xd2, 0xe0, 0x13, 0xd3, 0x63, 0xc2, 0x9d, 0x92,
+	0x00, 0x77, 0xe1, 0x86, 0xed, 0x68, 0x7e, 0x40, 0x6c, 0x83, 0x78, 0x86, 0x36, 0x7f, 0x99, 0xd0,
+	0x88, 0xae, 0x53, 0xdf, 0x77, 0xc4, 0x91, 0x12, 0x7b, 0x79, 0xd9, 0x76, 0x86, 0x21, 0x79, 0xbe,
+	0xd7, 0x36, 0x43, 0xea, 0x82, 0x82, 0xb2, 0xeb, 0x14, 0xf4, 0x12, 0x94, 0xa6, 0xc4, 0xd5, 0xa8,
+	0x1d, 0x78, 0x17, 0xbc, 0x50, 0x2c, 0xaa, 0xc5, 0x29, 0x71, 0x15, 0xd6, 0xfe, 0xe1, 0xbe, 0x44,
+	0x3a, 0x9b, 0x45, 0x54, 0x7a, 0x98, 0x2b, 0x96, 0x10, 0xd4, 0xff, 0x99, 0x85, 0x4a, 0xb2, 0x70,
+	0x64, 0x75, 0xb8, 0xce, 0xf7, 0x7e, 0x89, 0xef, 0x0e, 0xaf, 0x7d, 0x67, 0x99, 0xd9, 0x68, 0xb1,
+	0x43, 0xe1, 0xb0, 0x20, 0xaa, 0x34, 0x55, 0x58, 0xb2, 0x03, 0x99, 0xed, 0x07, 0x54, 0x5c, 0x12,
+	0x8a, 0x6a, 0xd8, 0xc2, 0xc7, 0x50, 0x78, 0xe4, 0x73, 0xdf, 0x05, 0xee, 0xfb, 0xf5, 0xef, 0xf6,
+	0xfd, 0x70, 0xc8, 0x9d, 0x97, 0x1e, 0x0e, 0xb5, 0x5e, 0x5f, 0x3d, 0x69, 0x76, 0xd5, 0xd0, 0x1c,
+	0x5f, 0x87, 0x9c, 0x45, 0xbe, 0xba, 0x48, 0x1f, 0x1f, 0x1c, 0xba, 0xec, 0x47, 0xb8, 0x0e, 0x39,
+	0xf6, 0xd2, 0x92, 0xde, 0xb4, 0x39, 0xf4, 0x03, 0x2e, 0x86, 0x7d, 0xc8, 0xf3, 0x7c, 0x61, 0x80,
+	0x30, 0x63, 0xe8, 0x05, 0x5c, 0x84, 0x5c, 0xab, 0xaf, 0xb2, 0x05, 0x81, 0xa0, 0x22, 0x50, 0x6d,
+	0xd0, 0x51, 0x5a, 0x0a, 0xca, 0xd4, 0xef, 0x42, 0x41, 0x24, 0x81, 0x2d, 0x96, 0x38, 0x0d, 0xe8,
+	0x85, 0xb0, 0x19, 0xfa, 0x90, 0xa2, 0xde, 0xd3, 0x93, 0x23, 0x45, 0x45, 0x99, 0xf4, 0xa7, 0xce,
+	0xa1, 0x7c, 0xdd, 0x87, 0x4a, 0xb2, 0x20, 0xfc, 0x51, 0x54, 0x56, 0xff, 0x8b, 0x04, 0xe5, 0x44,
+	0x81, 0xc7, 0x4a, 0x0b, 0x62, 0x59, 0xce, 0x13, 0x8d, 0x58, 0x26, 0xf1, 0x43, 0x69, 0x00, 0x87,
+	0x9a, 0x0c, 0xb9, 0xec, 0xa7, 0xfb, 0x91, 0x96, 0x48, 0x1e, 0x15, 0xea, 0x7f, 0x94, 0x00, 0x2d,
+	0x96, 0x88, 0x0b, 0x61, 0x4a, 0x3f, 0x65, 0x98, 0xf5, 0x3f, 0x48, 0x50, 0x4d, 0xd7, 0x85, 0x0b,
+	0xe1, 0xdd, 0xfc, 0x49, 0xc3, 0xfb, 0x47, 0x06, 0xae, 0xa4, 0xaa, 0xc1, 0xcb, 0x46, 0xf7, 0x25,
+	0x6c, 0x9a, 0x06, 0x9d, 0xba, 0x4e, 0xc0, 0x5e, 0x41, 0x35, 0x8b, 0x3e, 0xa6, 0x96, 0x5c, 0xe7,
+	0x9b, 0xc6, 0xfe, 0x77, 0xd7, 0x9b, 0x8d, 0xce, 0xdc, 0xae, 0xcb, 0xcc, 0x0e, 0xb7, 0x3a, 0x6d,
+	0xe5, 0x64, 0xd0, 0x1f, 0x29, 0xbd, 0xd6, 0x67, 0xda, 0x69, 0xef, 0x97, 0xbd, 0xfe, 0x27, 0x3d,
+	0x15, 0x99, 0x0b, 0xb4, 0x1f, 0x70, 0xd9, 0x0f, 0x00, 0x2d, 0x06, 0x85, 0xaf, 0xc1, 0xaa, 0xb0,
+	0xd0, 0x0b, 0x78, 0x0b, 0x6a, 0xbd, 0xbe, 0x36, 0xec, 0xb4, 0x15, 0x4d, 0x79, 0xf0, 0x40, 0x69,
+	0x8d, 0x86, 0xe2, 0x02, 0x1e, 0xb3, 0x47, 0xa9, 0x05, 0x5e, 0xff, 0x7d, 0x16, 0xb6, 0x56, 0x44,
+	0x82, 0x9b, 0x61, 0xed, 0x2f, 0xae, 0x23, 0xef, 0x5e, 0x26, 0xfa, 0x06, 0xab, 0x2e, 0x06, 0xc4,
+	0x0b, 0xc2, 0xab, 0xc2, 0x5b, 0xc0, 0xb2, 0x64, 0x07, 0xe6, 0xd8, 0xa4, 0x5e, 0xf8, 0xb0, 0x21,
+	0x2e, 0x04, 0xb5, 0x39, 0x2e, 0xde, 0x36, 0x7e, 0x06, 0xd8, 0x75, 0x7c, 0x33, 0x30, 0x1f, 0xb3,
+	0xb7, 0xd5, 0xe8, 0x15, 0x84, 0x5d, 0x10, 0x72, 0x2a, 0x8a, 0x7a, 0x3a, 0x76, 0x10, 0xb3, 0x6d,
+	0x3a, 0x21, 0x0b, 0x6c, 0xb6, 0x99, 0x67, 0x55, 0x14, 0xf5, 0xc4, 0xec, 0x9b, 0x50, 0x31, 0x9c,
+	0x19, 0x2b, 0xb7, 0x04, 0x8f, 0x9d, 0x1d, 0x92, 0x5a, 0x16, 0x58, 0x4c, 0x09, 0xeb, 0xe1, 0xf9,
+	0xf3, 0x4b, 0x45, 0x2d, 0x0b, 0x4c, 0x50, 0x6e, 0x41, 0x8d, 0x4c, 0x26, 0x1e, 0x73, 0x1e, 0x39,
+	0x12, 0x15, 0x7e, 0x35, 0x86, 0x39, 0x71, 0xe7, 0x21, 0x14, 0xa3, 0x3c, 0xb0, 0xa3, 0x9a, 0x65,
+	0x42, 0x73, 0xc5, 0x23, 0x58, 0x86, 0xbd, 0xc8, 0xd8, 0x51, 0xe7, 0x4d, 0xa8, 0x98, 0xbe, 0x36,
+	0x7f, 0x8d, 0xcd, 0xec, 0x66, 0xf6, 0x8a, 0x6a, 0xd9, 0xf4, 0xe3, 0xe7, 0xb7, 0xfa, 0x37, 0x19,
+	0xa8, 0xa6, 0x5f, 0x93, 0x71, 0x1b, 0x8a, 0x96, 0xa3, 0x13, 0x2e, 0x2d, 0xf1, 0x53, 0xc6, 0xde,
+	0x73, 0x1e, 0xa0, 0x1b, 0xdd, 0x90, 0xaf, 0xc6, 0x96, 0x3b, 0x7f, 0x93, 0xa0, 0x18, 0xc1, 0xf8,
+	0x2a, 0xe4, 0x5c, 0x12, 0x9c, 0x73, 0x77, 0xf9, 0xa3, 0x0c, 0x92, 0x54, 0xde, 0x66, 0xb8, 0xef,
+	0x12, 0x5b, 0xce, 0xcc, 0x71, 0xd6, 0x66, 0xdf, 0xd5, 0xa2, 0xc4, 0xe0, 0xd7, 0x07, 0x67, 0x3a,
+	0xa5, 0x76, 0xe0, 0x47, 0xdf, 0x35, 0xc4, 0x5b, 0x21, 0xcc, 0x7e, 0xd4, 0x08, 0x3c, 0x62, 0x5a,
+	0x29, 0x6e, 0x8e, 0x73, 0x51, 0xd4, 0x11, 0x93, 0x0f, 0xe1, 0x7a, 0xe4, 0xd7, 0xa0, 0x01, 0xd1,
+	0xcf, 0xa9, 0x31, 0x37, 0x2a, 0xf0, 0xa7, 0xca, 0x6b, 0x21, 0xa1, 0x1d, 0xf6, 0x47, 0xb6, 0xf5,
+	0x6f, 0x25, 0xd8, 0x8c, 0x2e, 0x3c, 0x46, 0x9c, 0xac, 0x13, 0x00, 0x62, 0xdb, 0x4e, 0x90, 0x4c,
+	0xd7, 0xb2, 0x94, 0x97, 0xec, 0x1a, 0xcd, 0xd8, 0x48, 0x4d, 0x38, 0xd8, 0x99, 0x02, 0xcc, 0x7b,
+	0xd6, 0xa6, 0xed, 0x06, 0x94, 0xc3, 0x9f, 0x0a, 0xf8, 0xef, 0x4d, 0xe2, 0x8a, 0x0c, 0x02, 0x62,
+	0x37, 0x23, 0xf6, 0x2c, 0x7a, 0x46, 0x27, 0xa6, 0x1d, 0x3e, 0x60, 0x8a, 0x46, 0xf4, 0x2c, 0x9a,
+	0x8b, 0x9f, 0x45, 0x8f, 0x7e, 0x27, 0xc1, 0x96, 0xee, 0x4c, 0x17, 0xe3, 0x3d, 0x42, 0x0b, 0xf7,
+	0x74, 0xff, 0x63, 0xe9, 0xf3, 0x8f, 0x26, 0x66, 0x70, 0x3e, 0x3b, 0x6b, 0xe8, 0xce, 0x74, 0x7f,
+	0xe2, 0x58, 0xc4, 0x9e, 0xcc, 0x7f, 0x30, 0xe3, 0xff, 0xe8, 0xef, 0x4e, 0xa8, 0xfd, 0xee, 0xc4,
+	0x49, 0xfc, 0x7c, 0xf6, 0xe1, 0xfc, 0xdf, 0xaf, 0x33, 0xd9, 0xe3, 0xc1, 0xd1, 0x9f, 0x32, 0x3b,
+	0xc7, 0x62, 0xac, 0x41, 0x94, 0x1b, 0x95, 0x8e, 0x2d, 0xaa, 0xb3, 0xf9, 0xfe, 0x6f, 0x00, 0xb0,
+	0x2e, 0xfd, 0x87, 0x89, 0x1b, 0x00, 0x00,
 }
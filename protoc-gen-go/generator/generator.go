@@ -76,14 +76,16 @@ func (c *common) proto3() bool { return fileIsProto3(c.file) }
 
 // 描述一个pb message
 type Descriptor struct {
-	common   *descriptor.DescriptorProto // 对应的DescriptorProto指针
-	parent   *Descriptor                 // 上级message指针slice, 如果有的话
-	nested   []*Descriptor               // 内部message指针slice，如果有的话
-	enums    []*EnumDescriptor           // 内部enum指针slice, 如果有的话.
-	ext      []*ExtensionDescriptor      // 扩展指针slice，如果有的话
-	typename []string                    // 缓存的typename slice
-	index    int                         // 在容器中的索引值，不管是file还是message
-	path     string                      // SourceCodeInfo path，逗号分隔的数字
+	common
+	// 对应的DescriptorProto指针
+	*descriptor.DescriptorProto
+	parent   *Descriptor            // 上级message指针slice, 如果有的话
+	nested   []*Descriptor          // 内部message指针slice，如果有的话
+	enums    []*EnumDescriptor      // 内部enum指针slice, 如果有的话.
+	ext      []*ExtensionDescriptor // 扩展指针slice，如果有的话
+	typename []string               // 缓存的typename slice
+	index    int                    // 在容器中的索引值，不管是file还是message
+	path     string                 // SourceCodeInfo path，逗号分隔的数字
 	group    bool
 }
 
@@ -542,6 +544,7 @@ type Generator struct {
 	init             []string                   // Lines to emit in the init function.
 	indent           string
 	writeOutput      bool
+	fastPath         bool // 为message生成typed Clone、Merge、Equal方法（fastpath=true）
 }
 
 // 创建一个新的代码生成器，并创建请求、响应对象
@@ -550,6 +553,8 @@ func New() *Generator {
 	g.Buffer = new(bytes.Buffer)
 	g.Request = new(plugin.CodeGeneratorRequest)
 	g.Response = new(plugin.CodeGeneratorResponse)
+	// 告知protoc本插件支持proto3 optional字段，否则protoc会拒绝生成
+	g.Response.SupportedFeatures = proto.Uint64(uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL))
 	return g
 }
 
@@ -589,6 +594,9 @@ func (g *Generator) CommandLineParameters(parameter string) {
 		// --go_out=plugins=grpc:.，解析这里的参数plugins=grpc
 		case "plugins":
 			pluginList = v
+		// --go_out=fastpath=true:.，生成typed Clone/Merge/Equal方法
+		case "fastpath":
+			g.fastPath = v == "true"
 		default:
 			if len(k) > 0 && k[0] == 'M' {
 				g.ImportMap[k[1:]] = v
@@ -1550,18 +1558,26 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 	}
 	name = ",name=" + name
 	if message.proto3() {
-		// We only need the extra tag for []byte fields;
-		// no need to add noise for the others.
-		if *field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES {
+		if isProto3Optional(field) {
+			// proto3 optional 字段与proto2字段一样通过nil区分是否设置，
+			// 不能加proto3标记，否则零值会被当作未设置
+			name += ",proto3_optional"
+		} else if *field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES {
+			// We only need the extra tag for []byte fields;
+			// no need to add noise for the others.
 			name += ",proto3"
 		}
 
 	}
 	oneof := ""
-	if field.OneofIndex != nil {
+	if isOneof(field) {
 		oneof = ",oneof"
 	}
-	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s",
+	lazy := ""
+	if isLazy(field) {
+		lazy = ",lazy"
+	}
+	return strconv.Quote(fmt.Sprintf("%s,%d,%s%s%s%s%s%s%s",
 		wiretype,
 		field.GetNumber(),
 		optrepreq,
@@ -1569,6 +1585,7 @@ func (g *Generator) goTag(message *Descriptor, field *descriptor.FieldDescriptor
 		name,
 		enum,
 		oneof,
+		lazy,
 		defaultValue))
 }
 
@@ -1647,9 +1664,9 @@ func (g *Generator) GoType(message *Descriptor, field *descriptor.FieldDescripto
 	}
 	if isRepeated(field) {
 		typ = "[]" + typ
-	} else if message != nil && message.proto3() {
+	} else if message != nil && message.proto3() && !isProto3Optional(field) {
 		return
-	} else if field.OneofIndex != nil && message != nil {
+	} else if isOneof(field) && message != nil {
 		return
 	} else if needsStar(*field.Type) {
 		typ = "*" + typ
@@ -1679,12 +1696,20 @@ var methodNames = [...]string{
 	"Descriptor",
 }
 
+// Method names generated with fastpath=true.
+var fastPathMethodNames = [...]string{
+	"Clone",
+	"Merge",
+	"Equal",
+}
+
 // Names of messages in the `google.protobuf` package for which
 // we will generate XXX_WellKnownType methods.
 var wellKnownTypes = map[string]bool{
 	"Any":       true,
 	"Duration":  true,
 	"Empty":     true,
+	"FieldMask": true,
 	"Struct":    true,
 	"Timestamp": true,
 
@@ -1712,6 +1737,11 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	for _, n := range methodNames {
 		usedNames[n] = true
 	}
+	if g.fastPath {
+		for _, n := range fastPathMethodNames {
+			usedNames[n] = true
+		}
+	}
 
 	// message中定义的字段的名称、getter方法名称、类型名称等记录在这几个map里面
 	// - k=FieldDescriptorProto，v=提取出的字段名称
@@ -1778,7 +1808,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		fieldNames[field] = fieldName
 		fieldGetterNames[field] = fieldGetterName
 
-		oneof := field.OneofIndex != nil
+		oneof := isOneof(field)
 		if oneof && oneofFieldName[*field.OneofIndex] == "" {
 			odp := message.OneofDecl[int(*field.OneofIndex)]
 			fname := allocNames(CamelCase(odp.GetName()))[0]
@@ -1869,22 +1899,28 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	if len(message.ExtensionRange) > 0 {
 		g.P(g.Pkg["proto"], ".XXX_InternalExtensions `json:\"-\"`")
 	}
-	if !message.proto3() {
-		g.P("XXX_unrecognized\t[]byte `json:\"-\"`")
+	// [lazy=true]的message字段在getter首次读取前以编码形式保存在XXX_lazy中
+	for _, field := range message.Field {
+		if isLazy(field) {
+			g.P("XXX_lazy\t", g.Pkg["proto"], ".XXX_LazyFields `json:\"-\"`")
+			break
+		}
 	}
+	// proto2和proto3 message都保留未知字段，以便中间服务转发新版本客户端添加的字段
+	g.P("XXX_unrecognized\t[]byte `json:\"-\"`")
 	g.Out()
 	g.P("}")
 
 	// Update g.Buffer to list valid oneof types.
 	// We do this down here, after we've disambiguated the oneof type names.
 	// We go in reverse order of insertion point to avoid invalidating offsets.
-	for oi := int32(len(message.OneofDecl)); oi >= 0; oi-- {
+	for oi := int32(len(realOneofs(message.DescriptorProto))); oi >= 0; oi-- {
 		ip := oneofInsertPoints[oi]
 		all := g.Buffer.Bytes()
 		rem := all[ip:]
 		g.Buffer = bytes.NewBuffer(all[:ip:ip]) // set cap so we don't scribble on rem
 		for _, field := range message.Field {
-			if field.OneofIndex == nil || *field.OneofIndex != oi {
+			if !isOneof(field) || *field.OneofIndex != oi {
 				continue
 			}
 			g.P("//\t*", oneofTypeName[field])
@@ -2018,13 +2054,13 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	// We shouldn't have to do this, but there was (~19 Aug 2015) a compiler/linker bug
 	// that was triggered by using anonymous interfaces here.
 	// TODO: Revisit this and consider reverting back to anonymous interfaces.
-	for oi := range message.OneofDecl {
+	for oi := range realOneofs(message.DescriptorProto) {
 		dname := oneofDisc[int32(oi)]
 		g.P("type ", dname, " interface { ", dname, "() }")
 	}
 	g.P()
 	for _, field := range message.Field {
-		if field.OneofIndex == nil {
+		if !isOneof(field) {
 			continue
 		}
 		_, wiretype := g.GoType(message, field)
@@ -2034,13 +2070,13 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	}
 	g.P()
 	for _, field := range message.Field {
-		if field.OneofIndex == nil {
+		if !isOneof(field) {
 			continue
 		}
 		g.P("func (*", oneofTypeName[field], ") ", oneofDisc[*field.OneofIndex], "() {}")
 	}
 	g.P()
-	for oi := range message.OneofDecl {
+	for oi := range realOneofs(message.DescriptorProto) {
 		fname := oneofFieldName[int32(oi)]
 		g.P("func (m *", ccTypeName, ") Get", fname, "() ", oneofDisc[int32(oi)], " {")
 		g.P("if m != nil { return m.", fname, " }")
@@ -2052,7 +2088,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	// Field getters
 	var getters []getterSymbol
 	for _, field := range message.Field {
-		oneof := field.OneofIndex != nil
+		oneof := isOneof(field)
 
 		fname := fieldNames[field]
 		typename, _ := g.GoType(message, field)
@@ -2114,6 +2150,10 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			// as does a message or group field, or a repeated field.
 			g.P("if m != nil {")
 			g.In()
+			if isLazy(field) {
+				// 首次读取时用XXX_lazy中保存的编码填充字段指向的message
+				g.P("m.XXX_lazy.Resolve(", fmt.Sprint(field.GetNumber()), ")")
+			}
 			g.P("return m." + fname)
 			g.Out()
 			g.P("}")
@@ -2124,7 +2164,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			continue
 		}
 		if !oneof {
-			if message.proto3() {
+			if message.proto3() && !isProto3Optional(field) {
 				g.P("if m != nil {")
 			} else {
 				g.P("if m != nil && m." + fname + " != nil {")
@@ -2194,14 +2234,18 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			sym:           ccTypeName,
 			hasExtensions: hasExtensions,
 			isMessageSet:  isMessageSet,
-			hasOneof:      len(message.OneofDecl) > 0,
+			hasOneof:      len(realOneofs(message.DescriptorProto)) > 0,
 			getters:       getters,
 		}
 		g.file.addExport(message, ms)
 	}
 
+	if g.fastPath {
+		g.generateFastPath(message, ccTypeName, fieldNames, fieldTypes, mapFieldTypes, oneofFieldName, oneofTypeName)
+	}
+
 	// Oneof functions
-	if len(message.OneofDecl) > 0 {
+	if len(realOneofs(message.DescriptorProto)) > 0 {
 		fieldWire := make(map[*descriptor.FieldDescriptorProto]string)
 
 		// method
@@ -2216,7 +2260,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		g.P("func (*", ccTypeName, ") XXX_OneofFuncs() (func", encSig, ", func", decSig, ", func", sizeSig, ", []interface{}) {")
 		g.P("return ", enc, ", ", dec, ", ", size, ", []interface{}{")
 		for _, field := range message.Field {
-			if field.OneofIndex == nil {
+			if !isOneof(field) {
 				continue
 			}
 			g.P("(*", oneofTypeName[field], ")(nil),")
@@ -2228,12 +2272,12 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		// marshaler
 		g.P("func ", enc, encSig, " {")
 		g.P("m := msg.(*", ccTypeName, ")")
-		for oi, odp := range message.OneofDecl {
+		for oi, odp := range realOneofs(message.DescriptorProto) {
 			g.P("// ", odp.GetName())
 			fname := oneofFieldName[int32(oi)]
 			g.P("switch x := m.", fname, ".(type) {")
 			for _, field := range message.Field {
-				if field.OneofIndex == nil || int(*field.OneofIndex) != oi {
+				if !isOneof(field) || int(*field.OneofIndex) != oi {
 					continue
 				}
 				g.P("case *", oneofTypeName[field], ":")
@@ -2322,7 +2366,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		g.P("m := msg.(*", ccTypeName, ")")
 		g.P("switch tag {")
 		for _, field := range message.Field {
-			if field.OneofIndex == nil {
+			if !isOneof(field) {
 				continue
 			}
 			odp := message.OneofDecl[int(*field.OneofIndex)]
@@ -2405,12 +2449,12 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		// sizer
 		g.P("func ", size, sizeSig, " {")
 		g.P("m := msg.(*", ccTypeName, ")")
-		for oi, odp := range message.OneofDecl {
+		for oi, odp := range realOneofs(message.DescriptorProto) {
 			g.P("// ", odp.GetName())
 			fname := oneofFieldName[int32(oi)]
 			g.P("switch x := m.", fname, ".(type) {")
 			for _, field := range message.Field {
-				if field.OneofIndex == nil || int(*field.OneofIndex) != oi {
+				if !isOneof(field) || int(*field.OneofIndex) != oi {
 					continue
 				}
 				g.P("case *", oneofTypeName[field], ":")
@@ -2499,6 +2543,320 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	g.addInitf("%s.RegisterType((*%s)(nil), %q)", g.Pkg["proto"], ccTypeName, fullName)
 }
 
+// generateFastPath generates typed Clone, Merge and Equal methods for a message.
+// The proto package calls Merge and Equal in place of its reflection-based code
+// (Clone and Merge through proto.Merger, Equal through proto.Equaler).
+func (g *Generator) generateFastPath(message *Descriptor, ccTypeName string, fieldNames, fieldTypes, mapFieldTypes map[*descriptor.FieldDescriptorProto]string, oneofFieldName map[int32]string, oneofTypeName map[*descriptor.FieldDescriptorProto]string) {
+	proto3 := message.proto3()
+
+	// 同一文件中生成的message类型也有typed方法，可以直接调用；其他message经由proto包分派
+	local := func(field *descriptor.FieldDescriptorProto) bool {
+		d, ok := g.ObjectNamed(field.GetTypeName()).(*Descriptor)
+		return ok && d.File() == message.File()
+	}
+	mergeMessage := func(field *descriptor.FieldDescriptorProto, dst, src string) {
+		if local(field) {
+			g.P(dst, ".Merge(", src, ")")
+		} else {
+			g.P(g.Pkg["proto"], ".Merge(", dst, ", ", src, ")")
+		}
+	}
+	equalMessage := func(field *descriptor.FieldDescriptorProto, a, b string) string {
+		if local(field) {
+			return a + ".Equal(" + b + ")"
+		}
+		return g.Pkg["proto"] + ".Equal(" + a + ", " + b + ")"
+	}
+	// bytes字段在proto2和proto3 optional中区分nil和空值，proto3中不区分
+	bytesDiffer := func(field *descriptor.FieldDescriptorProto, a, b string) string {
+		if proto3 && !isProto3Optional(field) {
+			return "string(" + a + ") != string(" + b + ")"
+		}
+		return "(" + a + " == nil) != (" + b + " == nil) || string(" + a + ") != string(" + b + ")"
+	}
+	isMessage := func(field *descriptor.FieldDescriptorProto) bool {
+		return *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE || *field.Type == descriptor.FieldDescriptorProto_TYPE_GROUP
+	}
+	isBytes := func(field *descriptor.FieldDescriptorProto) bool {
+		return *field.Type == descriptor.FieldDescriptorProto_TYPE_BYTES
+	}
+	mapValue := func(field *descriptor.FieldDescriptorProto) (*descriptor.FieldDescriptorProto, string) {
+		d := g.ObjectNamed(field.GetTypeName()).(*Descriptor)
+		valField := d.Field[1]
+		valType, _ := g.GoType(d, valField)
+		return valField, strings.TrimPrefix(valType, "*")
+	}
+	hasLazy := false
+	for _, field := range message.Field {
+		if isLazy(field) {
+			hasLazy = true
+		}
+	}
+
+	g.P("// Clone returns a deep copy of m.")
+	g.P("func (m *", ccTypeName, ") Clone() *", ccTypeName, " {")
+	g.In()
+	g.P("if m == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("c := new(", ccTypeName, ")")
+	g.P("c.Merge(m)")
+	g.P("return c")
+	g.Out()
+	g.P("}")
+	g.P()
+
+	g.P("// Merge merges src, which must be a *", ccTypeName, ", into m by the rules of proto.Merge.")
+	g.P("func (m *", ccTypeName, ") Merge(src ", g.Pkg["proto"], ".Message) {")
+	g.In()
+	g.P("s := src.(*", ccTypeName, ")")
+	g.P("if s == nil {")
+	g.P("return")
+	g.P("}")
+	if hasLazy {
+		g.P(g.Pkg["proto"], ".ResolveLazy(m)")
+		g.P(g.Pkg["proto"], ".ResolveLazy(s)")
+	}
+	for _, field := range message.Field {
+		if isOneof(field) {
+			continue
+		}
+		fname, typ := fieldNames[field], fieldTypes[field]
+		f := "." + fname
+		switch {
+		case mapFieldTypes[field] != "":
+			valField, valType := mapValue(field)
+			g.P("if len(s", f, ") > 0 {")
+			g.P("if m", f, " == nil {")
+			g.P("m", f, " = make(", mapFieldTypes[field], ", len(s", f, "))")
+			g.P("}")
+			g.P("for k, v := range s", f, " {")
+			switch {
+			case isMessage(valField):
+				g.P("c := new(", valType, ")")
+				mergeMessage(valField, "c", "v")
+				g.P("m", f, "[k] = c")
+			case isBytes(valField):
+				g.P("m", f, "[k] = append([]byte{}, v...)")
+			default:
+				g.P("m", f, "[k] = v")
+			}
+			g.P("}")
+			g.P("}")
+		case isRepeated(field) && (isMessage(field) || isBytes(field)):
+			elemType := strings.TrimPrefix(typ, "[]")
+			g.P("if s", f, " != nil {")
+			g.P("if m", f, " == nil {")
+			g.P("m", f, " = make(", typ, ", 0, len(s", f, "))")
+			g.P("}")
+			g.P("for _, x := range s", f, " {")
+			g.P("var c ", elemType)
+			g.P("if x != nil {")
+			if isMessage(field) {
+				g.P("c = new(", strings.TrimPrefix(elemType, "*"), ")")
+				mergeMessage(field, "c", "x")
+			} else {
+				g.P("c = append([]byte{}, x...)")
+			}
+			g.P("}")
+			g.P("m", f, " = append(m", f, ", c)")
+			g.P("}")
+			g.P("}")
+		case isRepeated(field):
+			g.P("if s", f, " != nil {")
+			g.P("if m", f, " == nil {")
+			g.P("m", f, " = make(", typ, ", 0, len(s", f, "))")
+			g.P("}")
+			g.P("m", f, " = append(m", f, ", s", f, "...)")
+			g.P("}")
+		case isMessage(field):
+			g.P("if s", f, " != nil {")
+			g.P("if m", f, " == nil {")
+			g.P("m", f, " = new(", strings.TrimPrefix(typ, "*"), ")")
+			g.P("}")
+			mergeMessage(field, "m"+f, "s"+f)
+			g.P("}")
+		case isBytes(field):
+			if proto3 && !isProto3Optional(field) {
+				g.P("if len(s", f, ") > 0 {")
+			} else {
+				g.P("if s", f, " != nil {")
+			}
+			g.P("m", f, " = append([]byte{}, s", f, "...)")
+			g.P("}")
+		case typ[0] == '*':
+			g.P("if s", f, " != nil {")
+			g.P("v := *s", f)
+			g.P("m", f, " = &v")
+			g.P("}")
+		default:
+			// proto3标量字段的零值即未设置
+			switch *field.Type {
+			case descriptor.FieldDescriptorProto_TYPE_BOOL:
+				g.P("if s", f, " {")
+			case descriptor.FieldDescriptorProto_TYPE_STRING:
+				g.P("if s", f, ` != "" {`)
+			default:
+				g.P("if s", f, " != 0 {")
+			}
+			g.P("m", f, " = s", f)
+			g.P("}")
+		}
+	}
+	for oi := range realOneofs(message.DescriptorProto) {
+		uf := "." + oneofFieldName[int32(oi)]
+		g.P("switch x := s", uf, ".(type) {")
+		for _, field := range message.Field {
+			if !isOneof(field) || int(*field.OneofIndex) != oi {
+				continue
+			}
+			fname, tname := fieldNames[field], oneofTypeName[field]
+			g.P("case *", tname, ":")
+			switch {
+			case isMessage(field):
+				g.P("y, ok := m", uf, ".(*", tname, ")")
+				g.P("if !ok {")
+				g.P("y = new(", tname, ")")
+				g.P("m", uf, " = y")
+				g.P("}")
+				g.P("if x.", fname, " != nil {")
+				g.P("if y.", fname, " == nil {")
+				g.P("y.", fname, " = new(", strings.TrimPrefix(fieldTypes[field], "*"), ")")
+				g.P("}")
+				mergeMessage(field, "y."+fname, "x."+fname)
+				g.P("}")
+			case isBytes(field):
+				g.P("var c []byte")
+				g.P("if x.", fname, " != nil {")
+				g.P("c = append([]byte{}, x.", fname, "...)")
+				g.P("}")
+				g.P("m", uf, " = &", tname, "{", fname, ": c}")
+			default:
+				g.P("m", uf, " = &", tname, "{", fname, ": x.", fname, "}")
+			}
+		}
+		g.P("}")
+	}
+	if len(message.ExtensionRange) > 0 {
+		g.P(g.Pkg["proto"], ".XXX_MergeExtensions(m, s)")
+	}
+	g.P("if len(s.XXX_unrecognized) > 0 {")
+	g.P("m.XXX_unrecognized = append([]byte(nil), s.XXX_unrecognized...)")
+	g.P("}")
+	g.Out()
+	g.P("}")
+	g.P()
+
+	g.P("// Equal reports whether m and other are equal by the rules of proto.Equal.")
+	g.P("func (m *", ccTypeName, ") Equal(other ", g.Pkg["proto"], ".Message) bool {")
+	g.In()
+	g.P("o, ok := other.(*", ccTypeName, ")")
+	g.P("if !ok {")
+	g.P("return false")
+	g.P("}")
+	g.P("if m == nil || o == nil {")
+	g.P("return m == o")
+	g.P("}")
+	if hasLazy {
+		g.P(g.Pkg["proto"], ".ResolveLazy(m)")
+		g.P(g.Pkg["proto"], ".ResolveLazy(o)")
+	}
+	for _, field := range message.Field {
+		if isOneof(field) {
+			continue
+		}
+		f := "." + fieldNames[field]
+		switch {
+		case mapFieldTypes[field] != "":
+			valField, _ := mapValue(field)
+			g.P("if len(m", f, ") != len(o", f, ") {")
+			g.P("return false")
+			g.P("}")
+			g.P("for k, v := range m", f, " {")
+			g.P("w, ok := o", f, "[k]")
+			switch {
+			case isMessage(valField):
+				g.P("if !ok || !", equalMessage(valField, "v", "w"), " {")
+			case isBytes(valField):
+				g.P("if !ok || (v == nil) != (w == nil) || string(v) != string(w) {")
+			default:
+				g.P("if !ok || v != w {")
+			}
+			g.P("return false")
+			g.P("}")
+			g.P("}")
+		case isRepeated(field):
+			g.P("if len(m", f, ") != len(o", f, ") {")
+			g.P("return false")
+			g.P("}")
+			g.P("for i, x := range m", f, " {")
+			switch {
+			case isMessage(field):
+				g.P("if !", equalMessage(field, "x", "o"+f+"[i]"), " {")
+			case isBytes(field):
+				g.P("if ", bytesDiffer(field, "x", "o"+f+"[i]"), " {")
+			default:
+				g.P("if x != o", f, "[i] {")
+			}
+			g.P("return false")
+			g.P("}")
+			g.P("}")
+		case isMessage(field):
+			g.P("if !", equalMessage(field, "m"+f, "o"+f), " {")
+			g.P("return false")
+			g.P("}")
+		case isBytes(field):
+			g.P("if ", bytesDiffer(field, "m"+f, "o"+f), " {")
+			g.P("return false")
+			g.P("}")
+		case fieldTypes[field][0] == '*':
+			g.P("if (m", f, " == nil) != (o", f, " == nil) || m", f, " != nil && *m", f, " != *o", f, " {")
+			g.P("return false")
+			g.P("}")
+		default:
+			g.P("if m", f, " != o", f, " {")
+			g.P("return false")
+			g.P("}")
+		}
+	}
+	for oi := range realOneofs(message.DescriptorProto) {
+		uf := "." + oneofFieldName[int32(oi)]
+		g.P("switch x := m", uf, ".(type) {")
+		for _, field := range message.Field {
+			if !isOneof(field) || int(*field.OneofIndex) != oi {
+				continue
+			}
+			fname, tname := fieldNames[field], oneofTypeName[field]
+			g.P("case *", tname, ":")
+			g.P("y, ok := o", uf, ".(*", tname, ")")
+			switch {
+			case isMessage(field):
+				g.P("if !ok || !", equalMessage(field, "x."+fname, "y."+fname), " {")
+			case isBytes(field):
+				g.P("if !ok || (x.", fname, " == nil) != (y.", fname, " == nil) || string(x.", fname, ") != string(y.", fname, ") {")
+			default:
+				g.P("if !ok || x.", fname, " != y.", fname, " {")
+			}
+			g.P("return false")
+			g.P("}")
+		}
+		g.P("case nil:")
+		g.P("if o", uf, " != nil {")
+		g.P("return false")
+		g.P("}")
+		g.P("}")
+	}
+	if len(message.ExtensionRange) > 0 {
+		g.P("if !", g.Pkg["proto"], ".XXX_EqualExtensions(m, o) {")
+		g.P("return false")
+		g.P("}")
+	}
+	g.P("return string(m.XXX_unrecognized) == string(o.XXX_unrecognized)")
+	g.Out()
+	g.P("}")
+	g.P()
+}
+
 func (g *Generator) generateExtension(ext *ExtensionDescriptor) {
 	ccTypeName := ext.DescName()
 
@@ -2724,6 +3082,35 @@ func isRepeated(field *descriptor.FieldDescriptorProto) bool {
 	return field.Label != nil && *field.Label == descriptor.FieldDescriptorProto_LABEL_REPEATED
 }
 
+// Is this field declared with the proto3 optional keyword?
+func isProto3Optional(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetProto3Optional()
+}
+
+// Is this field a member of a real oneof? Proto3 optional fields are
+// placed in a synthetic oneof of their own that has no Go representation.
+func isOneof(field *descriptor.FieldDescriptorProto) bool {
+	return field.OneofIndex != nil && !isProto3Optional(field)
+}
+
+// realOneofs returns the oneofs of message that are not synthetic.
+// protoc always places synthetic oneofs after all real ones.
+func realOneofs(message *descriptor.DescriptorProto) []*descriptor.OneofDescriptorProto {
+	n := len(message.OneofDecl)
+	for _, field := range message.Field {
+		if isProto3Optional(field) && int(field.GetOneofIndex()) < n {
+			n = int(field.GetOneofIndex())
+		}
+	}
+	return message.OneofDecl[:n]
+}
+
+// Is this a singular message field marked [lazy=true]?
+func isLazy(field *descriptor.FieldDescriptorProto) bool {
+	return field.Options.GetLazy() && field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE &&
+		!isRepeated(field) && !isOneof(field)
+}
+
 // Is this field a scalar numeric type?
 func isScalar(field *descriptor.FieldDescriptorProto) bool {
 	if field.Type == nil {
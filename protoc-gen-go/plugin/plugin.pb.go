@@ -30,6 +30,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type CodeGeneratorResponse_Feature int32
+
+const (
+	CodeGeneratorResponse_FEATURE_NONE            CodeGeneratorResponse_Feature = 0
+	CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL CodeGeneratorResponse_Feature = 1
+)
+
+var CodeGeneratorResponse_Feature_name = map[int32]string{
+	0: "FEATURE_NONE",
+	1: "FEATURE_PROTO3_OPTIONAL",
+}
+var CodeGeneratorResponse_Feature_value = map[string]int32{
+	"FEATURE_NONE":            0,
+	"FEATURE_PROTO3_OPTIONAL": 1,
+}
+
+func (x CodeGeneratorResponse_Feature) Enum() *CodeGeneratorResponse_Feature {
+	p := new(CodeGeneratorResponse_Feature)
+	*p = x
+	return p
+}
+func (x CodeGeneratorResponse_Feature) String() string {
+	return proto.EnumName(CodeGeneratorResponse_Feature_name, int32(x))
+}
+func (x *CodeGeneratorResponse_Feature) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CodeGeneratorResponse_Feature_value, data, "CodeGeneratorResponse_Feature")
+	if err != nil {
+		return err
+	}
+	*x = CodeGeneratorResponse_Feature(value)
+	return nil
+}
+func (CodeGeneratorResponse_Feature) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 0}
+}
+
 // The version number of protocol compiler.
 type Version struct {
 	Major *int32 `protobuf:"varint,1,opt,name=major" json:"major,omitempty"`
@@ -145,9 +181,12 @@ type CodeGeneratorResponse struct {
 	// problem in protoc itself -- such as the input CodeGeneratorRequest being
 	// unparseable -- should be reported by writing a message to stderr and
 	// exiting with a non-zero status code.
-	Error            *string                       `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	File             []*CodeGeneratorResponse_File `protobuf:"bytes,15,rep,name=file" json:"file,omitempty"`
-	XXX_unrecognized []byte                        `json:"-"`
+	Error *string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// A bitmask of supported features that the code generator supports.
+	// This is a bitwise "or" of values from the Feature enum.
+	SupportedFeatures *uint64                       `protobuf:"varint,2,opt,name=supported_features,json=supportedFeatures" json:"supported_features,omitempty"`
+	File              []*CodeGeneratorResponse_File `protobuf:"bytes,15,rep,name=file" json:"file,omitempty"`
+	XXX_unrecognized  []byte                        `json:"-"`
 }
 
 func (m *CodeGeneratorResponse) Reset()                    { *m = CodeGeneratorResponse{} }
@@ -162,6 +201,13 @@ func (m *CodeGeneratorResponse) GetError() string {
 	return ""
 }
 
+func (m *CodeGeneratorResponse) GetSupportedFeatures() uint64 {
+	if m != nil && m.SupportedFeatures != nil {
+		return *m.SupportedFeatures
+	}
+	return 0
+}
+
 func (m *CodeGeneratorResponse) GetFile() []*CodeGeneratorResponse_File {
 	if m != nil {
 		return m.File
@@ -257,37 +303,42 @@ func init() {
 	proto.RegisterType((*CodeGeneratorRequest)(nil), "google.protobuf.compiler.CodeGeneratorRequest")
 	proto.RegisterType((*CodeGeneratorResponse)(nil), "google.protobuf.compiler.CodeGeneratorResponse")
 	proto.RegisterType((*CodeGeneratorResponse_File)(nil), "google.protobuf.compiler.CodeGeneratorResponse.File")
+	proto.RegisterEnum("google.protobuf.compiler.CodeGeneratorResponse_Feature", CodeGeneratorResponse_Feature_name, CodeGeneratorResponse_Feature_value)
 }
 
 func init() { proto.RegisterFile("google/protobuf/compiler/plugin.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6a, 0x14, 0x41,
-	0x10, 0xc6, 0x19, 0x77, 0x63, 0x98, 0x8a, 0x64, 0x43, 0x13, 0xa5, 0x09, 0x39, 0x8c, 0x8b, 0xe2,
-	0x5c, 0x32, 0x0b, 0xc1, 0x8b, 0x78, 0x4b, 0x44, 0x3d, 0x78, 0x58, 0x1a, 0xf1, 0x20, 0xc8, 0x30,
-	0x99, 0xd4, 0x74, 0x5a, 0x66, 0xba, 0xc6, 0xee, 0x1e, 0xf1, 0x49, 0x7d, 0x0f, 0xdf, 0x40, 0xfa,
-	0xcf, 0x24, 0xb2, 0xb8, 0xa7, 0xee, 0xef, 0x57, 0xd5, 0xd5, 0x55, 0x1f, 0x05, 0x2f, 0x25, 0x91,
-	0xec, 0x71, 0x33, 0x1a, 0x72, 0x74, 0x33, 0x75, 0x9b, 0x96, 0x86, 0x51, 0xf5, 0x68, 0x36, 0x63,
-	0x3f, 0x49, 0xa5, 0xab, 0x10, 0x60, 0x3c, 0xa6, 0x55, 0x73, 0x5a, 0x35, 0xa7, 0x9d, 0x15, 0xbb,
-	0x05, 0x6e, 0xd1, 0xb6, 0x46, 0x8d, 0x8e, 0x4c, 0xcc, 0x5e, 0xb7, 0x70, 0xf8, 0x05, 0x8d, 0x55,
-	0xa4, 0xd9, 0x29, 0x1c, 0x0c, 0xcd, 0x77, 0x32, 0x3c, 0x2b, 0xb2, 0xf2, 0x40, 0x44, 0x11, 0xa8,
-	0xd2, 0x64, 0xf8, 0xa3, 0x44, 0xbd, 0xf0, 0x74, 0x6c, 0x5c, 0x7b, 0xc7, 0x17, 0x91, 0x06, 0xc1,
-	0x9e, 0xc1, 0x63, 0x3b, 0x75, 0x9d, 0xfa, 0xc5, 0x97, 0x45, 0x56, 0xe6, 0x22, 0xa9, 0xf5, 0x9f,
-	0x0c, 0x4e, 0xaf, 0xe9, 0x16, 0x3f, 0xa0, 0x46, 0xd3, 0x38, 0x32, 0x02, 0x7f, 0x4c, 0x68, 0x1d,
-	0x2b, 0xe1, 0xa4, 0x53, 0x3d, 0xd6, 0x8e, 0x6a, 0x19, 0x63, 0xc8, 0xb3, 0x62, 0x51, 0xe6, 0xe2,
-	0xd8, 0xf3, 0xcf, 0x94, 0x5e, 0x20, 0x3b, 0x87, 0x7c, 0x6c, 0x4c, 0x33, 0xa0, 0xc3, 0xd8, 0x4a,
-	0x2e, 0x1e, 0x00, 0xbb, 0x06, 0x08, 0xe3, 0xd4, 0xfe, 0x15, 0x5f, 0x15, 0x8b, 0xf2, 0xe8, 0xf2,
-	0x45, 0xb5, 0x6b, 0xcb, 0x7b, 0xd5, 0xe3, 0xbb, 0x7b, 0x03, 0xb6, 0x1e, 0x8b, 0x3c, 0x44, 0x7d,
-	0x84, 0x7d, 0x82, 0x93, 0xd9, 0xb8, 0xfa, 0x67, 0xf4, 0x24, 0x8c, 0x77, 0x74, 0xf9, 0xbc, 0xda,
-	0xe7, 0x70, 0x95, 0xcc, 0x13, 0xab, 0x99, 0x24, 0xb0, 0xfe, 0x9d, 0xc1, 0xd3, 0x9d, 0x99, 0xed,
-	0x48, 0xda, 0xa2, 0xf7, 0x0e, 0x8d, 0x49, 0x3e, 0xe7, 0x22, 0x0a, 0xf6, 0x11, 0x96, 0xff, 0x34,
-	0xff, 0x7a, 0xff, 0x8f, 0xff, 0x2d, 0x1a, 0x66, 0x13, 0xa1, 0xc2, 0xd9, 0x37, 0x58, 0x86, 0x79,
-	0x18, 0x2c, 0x75, 0x33, 0x60, 0xfa, 0x26, 0xdc, 0xd9, 0x2b, 0x58, 0x29, 0x6d, 0xd1, 0x38, 0x45,
-	0xba, 0x1e, 0x49, 0x69, 0x97, 0xcc, 0x3c, 0xbe, 0xc7, 0x5b, 0x4f, 0x19, 0x87, 0xc3, 0x96, 0xb4,
-	0x43, 0xed, 0xf8, 0x2a, 0x24, 0xcc, 0xf2, 0x4a, 0xc2, 0x79, 0x4b, 0xc3, 0xde, 0xfe, 0xae, 0x9e,
-	0x6c, 0xc3, 0x6e, 0x06, 0x7b, 0xed, 0xd7, 0x37, 0x52, 0xb9, 0xbb, 0xe9, 0xc6, 0x87, 0x37, 0x92,
-	0xfa, 0x46, 0xcb, 0x87, 0x65, 0x0c, 0x97, 0xf6, 0x42, 0xa2, 0xbe, 0x90, 0x94, 0x56, 0xfa, 0x6d,
-	0x3c, 0x6a, 0x49, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x15, 0x40, 0xc5, 0xfe, 0x02, 0x00,
-	0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xd1, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc6, 0xff, 0x59, 0xbb, 0x7f, 0x95, 0xb3, 0x69, 0x2d, 0xd6, 0x80, 0x68, 0xec, 0xa2, 0x54,
+	0x20, 0x7a, 0xd3, 0x54, 0x1a, 0x5c, 0x80, 0xb8, 0xda, 0x46, 0x0b, 0x48, 0x53, 0x5b, 0x59, 0x85,
+	0x0b, 0x24, 0x14, 0x65, 0xe9, 0xa9, 0x67, 0x94, 0xfa, 0x04, 0xc7, 0x41, 0xbc, 0x1d, 0xcf, 0xc4,
+	0x1b, 0xa0, 0xd8, 0x4e, 0x87, 0x2a, 0x7a, 0x15, 0x9f, 0xdf, 0x77, 0x6c, 0x9d, 0xef, 0x8b, 0x0d,
+	0xcf, 0x05, 0x91, 0xc8, 0x71, 0x5c, 0x68, 0x32, 0x74, 0x5b, 0xad, 0xc7, 0x19, 0x6d, 0x0a, 0x99,
+	0xa3, 0x1e, 0x17, 0x79, 0x25, 0xa4, 0x8a, 0xad, 0xc0, 0x22, 0xd7, 0x16, 0x37, 0x6d, 0x71, 0xd3,
+	0x76, 0xd6, 0xdf, 0x3d, 0x60, 0x85, 0x65, 0xa6, 0x65, 0x61, 0x48, 0xbb, 0xee, 0x41, 0x06, 0x9d,
+	0xcf, 0xa8, 0x4b, 0x49, 0x8a, 0x9d, 0xc2, 0xe1, 0x26, 0xfd, 0x46, 0x3a, 0x0a, 0xfa, 0xc1, 0xf0,
+	0x90, 0xbb, 0xc2, 0x52, 0xa9, 0x48, 0x47, 0x07, 0x9e, 0x4a, 0xe5, 0x68, 0x91, 0x9a, 0xec, 0x2e,
+	0x6a, 0x39, 0x6a, 0x0b, 0xf6, 0x08, 0xfe, 0x2f, 0xab, 0xf5, 0x5a, 0xfe, 0x8c, 0xda, 0xfd, 0x60,
+	0x18, 0x72, 0x5f, 0x0d, 0x7e, 0x07, 0x70, 0x7a, 0x4d, 0x2b, 0x7c, 0x8f, 0x0a, 0x75, 0x6a, 0x48,
+	0x73, 0xfc, 0x5e, 0x61, 0x69, 0xd8, 0x10, 0x7a, 0x6b, 0x99, 0x63, 0x62, 0x28, 0x11, 0x4e, 0xc3,
+	0x28, 0xe8, 0xb7, 0x86, 0x21, 0x3f, 0xa9, 0xf9, 0x92, 0xfc, 0x0e, 0x64, 0xe7, 0x10, 0x16, 0xa9,
+	0x4e, 0x37, 0x68, 0xd0, 0x8d, 0x12, 0xf2, 0x7b, 0xc0, 0xae, 0x01, 0xac, 0x9d, 0xa4, 0xde, 0x15,
+	0x75, 0xfb, 0xad, 0xe1, 0xd1, 0xc5, 0xb3, 0x78, 0x37, 0x96, 0xa9, 0xcc, 0xf1, 0xdd, 0x36, 0x80,
+	0x45, 0x8d, 0x79, 0x68, 0xd5, 0x5a, 0x61, 0x37, 0xd0, 0x6b, 0x82, 0x4b, 0x7e, 0xb8, 0x4c, 0xac,
+	0xbd, 0xa3, 0x8b, 0xa7, 0xf1, 0xbe, 0x84, 0x63, 0x1f, 0x1e, 0xef, 0x36, 0xc4, 0x83, 0xc1, 0xaf,
+	0x03, 0x78, 0xb8, 0xe3, 0xb9, 0x2c, 0x48, 0x95, 0x58, 0x67, 0x87, 0x5a, 0xfb, 0x9c, 0x43, 0xee,
+	0x0a, 0x36, 0x02, 0x56, 0x56, 0x45, 0x41, 0xda, 0xe0, 0x2a, 0x59, 0x63, 0x6a, 0x2a, 0x8d, 0xa5,
+	0x75, 0xda, 0xe6, 0x0f, 0xb6, 0xca, 0xd4, 0x0b, 0xec, 0x03, 0xb4, 0xff, 0xf2, 0xfa, 0x6a, 0xff,
+	0x80, 0xff, 0x9c, 0xc1, 0x46, 0xc1, 0xed, 0x09, 0x67, 0x5f, 0xa1, 0x6d, 0xed, 0x33, 0x68, 0xab,
+	0x74, 0x83, 0x7e, 0x2a, 0xbb, 0x66, 0x2f, 0xa0, 0x2b, 0x55, 0x89, 0xda, 0x48, 0x52, 0x49, 0x41,
+	0x52, 0x19, 0x9f, 0xfd, 0xc9, 0x16, 0x2f, 0x6a, 0xca, 0x22, 0xe8, 0x64, 0xa4, 0x0c, 0x2a, 0x13,
+	0x75, 0x6d, 0x43, 0x53, 0x0e, 0x5e, 0x43, 0xc7, 0x0f, 0xcd, 0x7a, 0x70, 0x3c, 0x9d, 0x5c, 0x2e,
+	0x3f, 0xf1, 0x49, 0x32, 0x9b, 0xcf, 0x26, 0xbd, 0xff, 0xd8, 0x13, 0x78, 0xdc, 0x90, 0x05, 0x9f,
+	0x2f, 0xe7, 0x2f, 0x93, 0xf9, 0x62, 0xf9, 0x71, 0x3e, 0xbb, 0xbc, 0xe9, 0x05, 0x57, 0x02, 0xce,
+	0x33, 0xda, 0xec, 0x75, 0x76, 0x75, 0xbc, 0xb0, 0x8f, 0xc0, 0xfe, 0xc7, 0xf2, 0xcb, 0x1b, 0x21,
+	0xcd, 0x5d, 0x75, 0x5b, 0xcb, 0x63, 0x41, 0x79, 0xaa, 0xc4, 0xfd, 0xad, 0xb7, 0x8b, 0x6c, 0x24,
+	0x50, 0x8d, 0x04, 0xf9, 0xb7, 0xf3, 0xd6, 0x7d, 0x12, 0x41, 0x7f, 0x06, 0x00, 0x3c, 0x1b, 0x3b,
+	0x2b, 0x67, 0x03, 0x00, 0x00,
 }
@@ -84,12 +84,18 @@ type DynamicAny struct {
 // google.protobuf.Any message. It returns an error if corresponding message
 // type isn't linked in.
 func Empty(any *any.Any) (proto.Message, error) {
+	return EmptyFrom(proto.GlobalRegistry, any)
+}
+
+// EmptyFrom is like Empty but looks the message type up in reg
+// rather than in proto.GlobalRegistry.
+func EmptyFrom(reg *proto.Registry, any *any.Any) (proto.Message, error) {
 	aname, err := AnyMessageName(any)
 	if err != nil {
 		return nil, err
 	}
 
-	t := proto.MessageType(aname)
+	t := reg.MessageType(aname)
 	if t == nil {
 		return nil, fmt.Errorf("any: message type %q isn't linked in", aname)
 	}
@@ -102,15 +108,22 @@ func Empty(any *any.Any) (proto.Message, error) {
 //
 // pb can be a proto.Message, or a *DynamicAny.
 func UnmarshalAny(any *any.Any, pb proto.Message) error {
+	return UnmarshalAnyFrom(proto.GlobalRegistry, any, pb)
+}
+
+// UnmarshalAnyFrom is like UnmarshalAny but resolves type names in reg
+// rather than in proto.GlobalRegistry, both to allocate the message for
+// a *DynamicAny and to name the type of pb.
+func UnmarshalAnyFrom(reg *proto.Registry, any *any.Any, pb proto.Message) error {
 	if d, ok := pb.(*DynamicAny); ok {
 		if d.Message == nil {
 			var err error
-			d.Message, err = Empty(any)
+			d.Message, err = EmptyFrom(reg, any)
 			if err != nil {
 				return err
 			}
 		}
-		return UnmarshalAny(any, d.Message)
+		return UnmarshalAnyFrom(reg, any, d.Message)
 	}
 
 	aname, err := AnyMessageName(any)
@@ -118,7 +131,7 @@ func UnmarshalAny(any *any.Any, pb proto.Message) error {
 		return err
 	}
 
-	mname := proto.MessageName(pb)
+	mname := reg.MessageName(pb)
 	if aname != mname {
 		return fmt.Errorf("mismatched message type: got %q want %q", aname, mname)
 	}
@@ -122,7 +122,8 @@ type Any struct {
 	//
 	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl" json:"type_url,omitempty"`
 	// Must be a valid serialized protocol buffer of the above specified type.
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Value            []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Any) Reset()                    { *m = Any{} }
@@ -106,8 +106,43 @@ func TestEmpty(t *testing.T) {
 
 	// that's a valid type_url for a message which shouldn't be linked into this
 	// test binary. We want an error.
-	a.TypeUrl = "type.googleapis.com/google.protobuf.FieldMask"
+	a.TypeUrl = "type.googleapis.com/google.protobuf.Api"
 	if _, err := Empty(a); err == nil {
 		t.Errorf("got no error for an attempt to create a message of type %q, which shouldn't be linked in", a.TypeUrl)
 	}
 }
+
+func TestRegistry(t *testing.T) {
+	reg := proto.NewRegistry()
+	if err := reg.RegisterType((*pb.FileDescriptorProto)(nil), "test.File"); err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	b, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &any.Any{TypeUrl: googleApis + "test.File", Value: b}
+
+	if _, err := Empty(a); err == nil {
+		t.Errorf("Empty(%q) resolved a type only present in a private registry", a.TypeUrl)
+	}
+	got, err := EmptyFrom(reg, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got.(*pb.FileDescriptorProto); !ok {
+		t.Errorf("EmptyFrom returned %T, want *descriptor.FileDescriptorProto", got)
+	}
+
+	var dyn DynamicAny
+	if err := UnmarshalAnyFrom(reg, a, &dyn); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(dyn.Message, want) {
+		t.Errorf("UnmarshalAnyFrom: got %v, want %v", dyn.Message, want)
+	}
+	if err := UnmarshalAnyFrom(reg, a, &pb.DescriptorProto{}); err == nil {
+		t.Error("UnmarshalAnyFrom into an unregistered type: got nil error")
+	}
+}
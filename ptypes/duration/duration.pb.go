@@ -98,7 +98,8 @@ type Duration struct {
 	// of one second or more, a non-zero value for the `nanos` field must be
 	// of the same sign as the `seconds` field. Must be from -999,999,999
 	// to +999,999,999 inclusive.
-	Nanos int32 `protobuf:"varint,2,opt,name=nanos" json:"nanos,omitempty"`
+	Nanos            int32  `protobuf:"varint,2,opt,name=nanos" json:"nanos,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Duration) Reset()                    { *m = Duration{} }
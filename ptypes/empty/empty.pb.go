@@ -37,6 +37,7 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 //
 // The JSON representation for `Empty` is empty JSON object `{}`.
 type Empty struct {
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Empty) Reset()                    { *m = Empty{} }
@@ -0,0 +1,278 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+// This file implements validation, pruning and merging of messages with
+// google.protobuf.FieldMask.
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	fmpb "github.com/golang/protobuf/ptypes/field_mask"
+)
+
+// maskTree is the set of paths of a FieldMask arranged by field name.
+// A nil subtree covers the whole field.
+type maskTree map[string]maskTree
+
+func newMaskTree(mask *fmpb.FieldMask) maskTree {
+	tree := make(maskTree)
+	for _, path := range mask.GetPaths() {
+		node := tree
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			sub, ok := node[name]
+			if ok && sub == nil {
+				// Already covered by a shorter path.
+				break
+			}
+			if i == len(names)-1 {
+				node[name] = nil
+				break
+			}
+			if !ok {
+				sub = make(maskTree)
+				node[name] = sub
+			}
+			node = sub
+		}
+	}
+	return tree
+}
+
+// maskField locates the field named by a path segment in the struct type t.
+type maskField struct {
+	index int          // struct field index
+	oneof reflect.Type // oneof wrapper type, or nil for a regular field
+	typ   reflect.Type // Go type of the field's value
+}
+
+func findMaskField(t reflect.Type, name string) (maskField, bool) {
+	sprop := proto.GetProperties(t)
+	for i, prop := range sprop.Prop {
+		if prop.OrigName == name && !strings.HasPrefix(t.Field(i).Name, "XXX_") && t.Field(i).Tag.Get("protobuf_oneof") == "" {
+			return maskField{index: i, typ: t.Field(i).Type}, true
+		}
+	}
+	if op, ok := sprop.OneofTypes[name]; ok {
+		return maskField{index: op.Field, oneof: op.Type, typ: op.Type.Elem().Field(0).Type}, true
+	}
+	return maskField{}, false
+}
+
+// isMessage reports whether the field holds a single message.
+func (f maskField) isMessage() bool {
+	return f.typ.Kind() == reflect.Ptr && f.typ.Elem().Kind() == reflect.Struct
+}
+
+// message returns the message held by the field in the struct v,
+// or an invalid Value if there is none.
+func (f maskField) message(v reflect.Value) reflect.Value {
+	fv := v.Field(f.index)
+	if f.oneof != nil {
+		if fv.IsNil() || fv.Elem().Type() != f.oneof {
+			return reflect.Value{}
+		}
+		fv = fv.Elem().Elem().Field(0)
+	}
+	if fv.IsNil() {
+		return reflect.Value{}
+	}
+	return fv
+}
+
+// ValidateFieldMask checks that every path in mask names a field of m's
+// message type, and that only the last field in each path is anything but
+// a singular message field.
+func ValidateFieldMask(mask *fmpb.FieldMask, m proto.Message) error {
+	t := reflect.TypeOf(m)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("field mask: %T is not a generated message", m)
+	}
+	for _, path := range mask.GetPaths() {
+		mt := t.Elem()
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			f, ok := findMaskField(mt, name)
+			if !ok {
+				return fmt.Errorf("field mask: path %q: no field %q in %v", path, name, mt)
+			}
+			if i == len(names)-1 {
+				break
+			}
+			if !f.isMessage() {
+				return fmt.Errorf("field mask: path %q: field %q is not a singular message", path, name)
+			}
+			mt = f.typ.Elem()
+		}
+	}
+	return nil
+}
+
+// PruneFieldMask clears every field of m that is not covered by mask,
+// along with its unknown fields and extensions. An empty mask covers
+// all fields, following the convention for get and update requests,
+// and leaves m unchanged.
+func PruneFieldMask(mask *fmpb.FieldMask, m proto.Message) error {
+	if err := ValidateFieldMask(mask, m); err != nil {
+		return err
+	}
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	v := reflect.ValueOf(m)
+	if v.IsNil() {
+		return nil
+	}
+	return pruneStruct(v, newMaskTree(mask))
+}
+
+// v is a non-nil pointer to a message struct.
+func pruneStruct(v reflect.Value, tree maskTree) error {
+	if err := proto.ResolveLazy(v.Interface().(proto.Message)); err != nil {
+		return err
+	}
+	s := v.Elem()
+	sprop := proto.GetProperties(s.Type())
+	for i := 0; i < s.NumField(); i++ {
+		sf, fv := s.Type().Field(i), s.Field(i)
+		if strings.HasPrefix(sf.Name, "XXX_") {
+			// Unknown fields and extensions are never covered.
+			fv.Set(reflect.Zero(sf.Type))
+			continue
+		}
+		name := sprop.Prop[i].OrigName
+		if sf.Tag.Get("protobuf_oneof") != "" {
+			if fv.IsNil() {
+				continue
+			}
+			for n, op := range sprop.OneofTypes {
+				if op.Type == fv.Elem().Type() {
+					name = n
+				}
+			}
+		}
+		sub, ok := tree[name]
+		if !ok {
+			fv.Set(reflect.Zero(sf.Type))
+			continue
+		}
+		if sub == nil {
+			continue
+		}
+		f, _ := findMaskField(s.Type(), name)
+		if mv := f.message(s); mv.IsValid() {
+			if err := pruneStruct(mv, sub); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MergeFieldMask merges the fields of src covered by mask into dst, which
+// must have the same type. Each covered field is merged with the semantics
+// of proto.Merge: set scalars overwrite, repeated fields are appended, map
+// entries are added and messages are merged recursively, while unset fields
+// in src leave dst unchanged. An empty mask merges all of src.
+func MergeFieldMask(mask *fmpb.FieldMask, dst, src proto.Message) error {
+	if reflect.TypeOf(dst) != reflect.TypeOf(src) {
+		return fmt.Errorf("field mask: cannot merge %T into %T", src, dst)
+	}
+	if err := ValidateFieldMask(mask, src); err != nil {
+		return err
+	}
+	if len(mask.GetPaths()) == 0 {
+		proto.Merge(dst, src)
+		return nil
+	}
+	if reflect.ValueOf(src).IsNil() {
+		return nil
+	}
+	return mergeStruct(reflect.ValueOf(dst), reflect.ValueOf(src), newMaskTree(mask))
+}
+
+// dst and src are non-nil pointers to message structs of the same type.
+func mergeStruct(dst, src reflect.Value, tree maskTree) error {
+	proto.ResolveLazy(dst.Interface().(proto.Message))
+	proto.ResolveLazy(src.Interface().(proto.Message))
+	t := src.Type().Elem()
+
+	// Covered fields are copied into a message of their own,
+	// which is then merged into dst as a whole.
+	var leaves reflect.Value
+	for name, sub := range tree {
+		f, _ := findMaskField(t, name)
+		if sub == nil {
+			if f.oneof != nil && !oneofHolds(src.Elem(), f) {
+				continue
+			}
+			if !leaves.IsValid() {
+				leaves = reflect.New(t)
+			}
+			leaves.Elem().Field(f.index).Set(src.Elem().Field(f.index))
+			continue
+		}
+
+		sm := f.message(src.Elem())
+		if !sm.IsValid() {
+			continue
+		}
+		dm := f.message(dst.Elem())
+		if !dm.IsValid() {
+			dm = reflect.New(f.typ.Elem())
+			if f.oneof != nil {
+				w := reflect.New(f.oneof.Elem())
+				w.Elem().Field(0).Set(dm)
+				dst.Elem().Field(f.index).Set(w)
+			} else {
+				dst.Elem().Field(f.index).Set(dm)
+			}
+		}
+		if err := mergeStruct(dm, sm, sub); err != nil {
+			return err
+		}
+	}
+	if leaves.IsValid() {
+		proto.Merge(dst.Interface().(proto.Message), leaves.Interface().(proto.Message))
+	}
+	return nil
+}
+
+// oneofHolds reports whether the oneof field f of struct v is set to f.
+func oneofHolds(v reflect.Value, f maskField) bool {
+	fv := v.Field(f.index)
+	return !fv.IsNil() && fv.Elem().Type() == f.oneof
+}
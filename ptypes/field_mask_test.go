@@ -0,0 +1,200 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+	fmpb "github.com/golang/protobuf/ptypes/field_mask"
+)
+
+func mask(paths ...string) *fmpb.FieldMask {
+	return &fmpb.FieldMask{Paths: paths}
+}
+
+func TestValidateFieldMask(t *testing.T) {
+	tests := []struct {
+		mask *fmpb.FieldMask
+		err  string // substring of the error, or "" for success
+	}{
+		{nil, ""},
+		{mask("count", "inner.host", "others", "rep_inner", "SomeGroup.group_field"), ""},
+		{mask("bogus"), `no field "bogus"`},
+		{mask("inner.bogus"), `no field "bogus"`},
+		{mask("count.x"), `"count" is not a singular message`},
+		{mask("others.key"), `"others" is not a singular message`},
+	}
+	for _, tc := range tests {
+		err := ValidateFieldMask(tc.mask, &pb.MyMessage{})
+		if tc.err == "" && err != nil || tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("ValidateFieldMask(%v) = %v; want error containing %q", tc.mask, err, tc.err)
+		}
+	}
+
+	if err := ValidateFieldMask(mask("msg.string_field", "number"), &pb.Communique{}); err != nil {
+		t.Errorf("ValidateFieldMask with oneof paths: %v", err)
+	}
+	if err := ValidateFieldMask(mask("union"), &pb.Communique{}); err == nil {
+		t.Error("ValidateFieldMask accepted a oneof name")
+	}
+}
+
+func TestPruneFieldMask(t *testing.T) {
+	m := &pb.MyMessage{
+		Count:            proto.Int32(1),
+		Name:             proto.String("n"),
+		Inner:            &pb.InnerMessage{Host: proto.String("h"), Port: proto.Int32(2)},
+		Others:           []*pb.OtherMessage{{Key: proto.Int64(3)}},
+		Somegroup:        &pb.MyMessage_SomeGroup{GroupField: proto.Int32(4)},
+		XXX_unrecognized: []byte("\x08\x01"),
+	}
+	if err := PruneFieldMask(mask("count", "inner.port", "others", "inner.connected"), m); err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.MyMessage{
+		Count:  proto.Int32(1),
+		Inner:  &pb.InnerMessage{Port: proto.Int32(2)},
+		Others: []*pb.OtherMessage{{Key: proto.Int64(3)}},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("PruneFieldMask:\n got %v\nwant %v", m, want)
+	}
+
+	c := &pb.Communique{MakeMeCry: proto.Bool(true), Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: proto.String("s"), BytesField: []byte("b")}}}
+	if err := PruneFieldMask(mask("msg.string_field"), c); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: proto.String("s")}}}); !proto.Equal(c, want) {
+		t.Errorf("PruneFieldMask of oneof:\n got %v\nwant %v", c, want)
+	}
+	c = &pb.Communique{Union: &pb.Communique_Number{Number: 1}}
+	if err := PruneFieldMask(mask("msg"), c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Union != nil {
+		t.Errorf("PruneFieldMask kept oneof member outside the mask: %v", c)
+	}
+
+	all := proto.Clone(want)
+	if err := PruneFieldMask(nil, all); err != nil || !proto.Equal(all, want) {
+		t.Errorf("PruneFieldMask with empty mask = %v, %v; want %v", err, all, want)
+	}
+	if err := PruneFieldMask(mask("bogus"), m); err == nil {
+		t.Error("PruneFieldMask accepted an invalid mask")
+	}
+}
+
+func TestMergeFieldMask(t *testing.T) {
+	dst := &pb.MyMessage{
+		Count: proto.Int32(1),
+		Name:  proto.String("old"),
+		Pet:   []string{"cat"},
+		Inner: &pb.InnerMessage{Host: proto.String("h"), Port: proto.Int32(2)},
+	}
+	src := &pb.MyMessage{
+		Count:     proto.Int32(10),
+		Name:      proto.String("new"),
+		Pet:       []string{"dog"},
+		Inner:     &pb.InnerMessage{Host: proto.String("H"), Port: proto.Int32(20)},
+		Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(4)},
+		Others:    []*pb.OtherMessage{{Key: proto.Int64(3)}},
+	}
+	if err := MergeFieldMask(mask("name", "pet", "inner.port", "SomeGroup.group_field", "quote"), dst, src); err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.MyMessage{
+		Count:     proto.Int32(1),
+		Name:      proto.String("new"),
+		Pet:       []string{"cat", "dog"},
+		Inner:     &pb.InnerMessage{Host: proto.String("h"), Port: proto.Int32(20)},
+		Somegroup: &pb.MyMessage_SomeGroup{GroupField: proto.Int32(4)},
+	}
+	if !proto.Equal(dst, want) {
+		t.Errorf("MergeFieldMask:\n got %v\nwant %v", dst, want)
+	}
+	// dst must not share memory with src.
+	src.Somegroup.GroupField = proto.Int32(5)
+	if dst.Somegroup.GetGroupField() != 4 {
+		t.Error("MergeFieldMask aliased src")
+	}
+
+	cd := &pb.Communique{Union: &pb.Communique_Number{Number: 1}}
+	cs := &pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{StringField: proto.String("s"), BytesField: []byte("b")}}}
+	if err := MergeFieldMask(mask("msg.bytes_field"), cd, cs); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.Communique{Union: &pb.Communique_Msg{Msg: &pb.Strings{BytesField: []byte("b")}}}); !proto.Equal(cd, want) {
+		t.Errorf("MergeFieldMask of oneof:\n got %v\nwant %v", cd, want)
+	}
+	if err := MergeFieldMask(mask("number"), cd, cs); err != nil || cd.GetMsg() == nil {
+		t.Errorf("MergeFieldMask of unset oneof member changed dst: %v, %v", err, cd)
+	}
+
+	// Lazy fields of dst are decoded before being merged into.
+	b, err := proto.Marshal(&pb.LazyMessage{
+		Id:      proto.Int32(1),
+		Payload: &pb.GoTestField{Label: proto.String("L"), Type: proto.String("T")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ld := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, ld); err != nil {
+		t.Fatal(err)
+	}
+	ls := &pb.LazyMessage{Payload: &pb.GoTestField{Type: proto.String("NEW")}}
+	if err := MergeFieldMask(mask("payload.Type"), ld, ls); err != nil {
+		t.Fatal(err)
+	}
+	lwant := &pb.LazyMessage{
+		Id:      proto.Int32(1),
+		Payload: &pb.GoTestField{Label: proto.String("L"), Type: proto.String("NEW")},
+	}
+	if b, err = proto.Marshal(ld); err != nil {
+		t.Fatal(err)
+	}
+	lgot := new(pb.LazyMessage)
+	if err := proto.Unmarshal(b, lgot); err != nil || !proto.Equal(lgot, lwant) {
+		t.Errorf("MergeFieldMask into lazy field:\n got %v, %v\nwant %v", lgot, err, lwant)
+	}
+
+	all := &pb.MyMessage{}
+	if err := MergeFieldMask(nil, all, src); err != nil || !proto.Equal(all, src) {
+		t.Errorf("MergeFieldMask with empty mask = %v, %v; want %v", err, all, src)
+	}
+	if err := MergeFieldMask(mask("count"), &pb.MyMessage{}, &pb.InnerMessage{}); err == nil {
+		t.Error("MergeFieldMask accepted mismatched types")
+	}
+}
@@ -12,6 +12,7 @@ PROTO_FILES='
   any.proto
   duration.proto
   empty.proto
+  field_mask.proto
   struct.proto
   timestamp.proto
   wrappers.proto
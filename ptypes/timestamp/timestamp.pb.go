@@ -114,7 +114,8 @@ type Timestamp struct {
 	// second values with fractions must still have non-negative nanos values
 	// that count forward in time. Must be from 0 to 999,999,999
 	// inclusive.
-	Nanos int32 `protobuf:"varint,2,opt,name=nanos" json:"nanos,omitempty"`
+	Nanos            int32  `protobuf:"varint,2,opt,name=nanos" json:"nanos,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Timestamp) Reset()                    { *m = Timestamp{} }
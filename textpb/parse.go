@@ -0,0 +1,380 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package textpb
+
+// Tokenizing and parsing of the text format into a syntax tree.
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A token is one token of the input together with the whitespace and
+// comments around it, so that writing every token back out in order
+// reproduces the input exactly.
+type token struct {
+	space string // whitespace and comments before the token
+	text  string // the token itself; "" for the end of input
+	trail string // a comment after the token on the same line, with the blanks before it
+	line  int    // line number of the token, for error messages
+}
+
+func (t *token) write(b *bytes.Buffer) {
+	b.WriteString(t.space)
+	b.WriteString(t.text)
+	b.WriteString(t.trail)
+}
+
+// A value is the value of a field: a scalar, a message or group, or a
+// list in [a, b, c] notation.
+type value struct {
+	scalar []*token  // the token, or several adjacent strings
+	msg    *Message  // message or group value
+	list   *listExpr // list value
+}
+
+// A listExpr is a list of values in [a, b, c] notation.
+type listExpr struct {
+	open   *token
+	elems  []*value
+	commas []*token // commas[i] follows elems[i]
+	close  *token
+}
+
+func (v *value) write(b *bytes.Buffer) {
+	switch {
+	case v.msg != nil:
+		v.msg.write(b)
+	case v.list != nil:
+		v.list.open.write(b)
+		for i, e := range v.list.elems {
+			e.write(b)
+			if i < len(v.list.commas) {
+				v.list.commas[i].write(b)
+			}
+		}
+		v.list.close.write(b)
+	default:
+		for _, t := range v.scalar {
+			t.write(b)
+		}
+	}
+}
+
+// first returns the first token of v.
+func (v *value) first() *token {
+	switch {
+	case v.msg != nil:
+		return v.msg.open
+	case v.list != nil:
+		return v.list.open
+	}
+	return v.scalar[0]
+}
+
+// last returns the last token of v.
+func (v *value) last() *token {
+	switch {
+	case v.msg != nil:
+		return v.msg.close
+	case v.list != nil:
+		return v.list.close
+	}
+	return v.scalar[len(v.scalar)-1]
+}
+
+// adopt makes f the parent of the messages in v.
+func (v *value) adopt(f *Field) {
+	if v.msg != nil {
+		v.msg.parent = f
+	}
+	if v.list != nil {
+		for _, e := range v.list.elems {
+			e.adopt(f)
+		}
+	}
+}
+
+func (f *Field) write(b *bytes.Buffer) {
+	for _, t := range f.name {
+		t.write(b)
+	}
+	if f.colon != nil {
+		f.colon.write(b)
+	}
+	f.value.write(b)
+	if f.sep != nil {
+		f.sep.write(b)
+	}
+}
+
+func (m *Message) write(b *bytes.Buffer) {
+	if m.open != nil {
+		m.open.write(b)
+	}
+	for _, f := range m.fields {
+		f.write(b)
+	}
+	m.close.write(b)
+}
+
+func isQuote(c byte) bool {
+	return c == '"' || c == '\''
+}
+
+func isIdentOrNumberChar(c byte) bool {
+	switch {
+	case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z':
+		return true
+	case '0' <= c && c <= '9':
+		return true
+	}
+	switch c {
+	case '-', '+', '.', '_':
+		return true
+	}
+	return false
+}
+
+// tokenize splits s into tokens with proto.TextScanner, so that it reads
+// the text format exactly as the parser in package proto does. The last
+// token is the end of input, holding whatever whitespace and comments
+// follow the last real token.
+func tokenize(s string) ([]*token, error) {
+	var toks []*token
+	sc := proto.NewTextScanner(s)
+	i := 0 // end of the previous token and its trailing comment
+	for {
+		tok, err := sc.Scan()
+		if err != nil {
+			if pe, ok := err.(*proto.ParseError); ok {
+				return nil, fmt.Errorf("textpb: line %d: %s", pe.Line, pe.Message)
+			}
+			return nil, err
+		}
+		t := &token{space: s[i:tok.Offset], text: tok.Value, line: tok.Line}
+		toks = append(toks, t)
+		if tok.Value == "" {
+			return toks, nil
+		}
+		i = tok.Offset + len(tok.Value)
+
+		// A comment on the rest of the line belongs to the token.
+		j := i
+		for j < len(s) && (s[j] == ' ' || s[j] == '\t') {
+			j++
+		}
+		switch {
+		case strings.HasPrefix(s[j:], "#"):
+			for j < len(s) && s[j] != '\n' {
+				j++
+			}
+			t.trail, i = s[i:j], j
+		case strings.HasPrefix(s[j:], "/*"):
+			n := strings.Index(s[j+2:], "*/")
+			if n >= 0 && !strings.Contains(s[j:j+n+4], "\n") {
+				t.trail, i = s[i:j+n+4], j+n+4
+			}
+		}
+	}
+}
+
+// A parser builds a syntax tree from a list of tokens.
+type parser struct {
+	toks []*token
+	doc  *Document
+}
+
+func (p *parser) peek() *token { return p.toks[0] }
+
+func (p *parser) next() *token {
+	t := p.toks[0]
+	if len(p.toks) > 1 {
+		p.toks = p.toks[1:]
+	}
+	return t
+}
+
+func (p *parser) errorf(t *token, format string, a ...interface{}) error {
+	return fmt.Errorf("textpb: line %d: %s", t.line, fmt.Sprintf(format, a...))
+}
+
+// readFields reads the fields of m up to the token end, which it does
+// not consume; end is "" for the end of input.
+func (p *parser) readFields(m *Message, end string) error {
+	for {
+		t := p.peek()
+		if t.text == end {
+			return nil
+		}
+		if t.text == "" {
+			return p.errorf(t, "unexpected end of input")
+		}
+		f, err := p.readField(m)
+		if err != nil {
+			return err
+		}
+		m.fields = append(m.fields, f)
+	}
+}
+
+func (p *parser) readField(m *Message) (*Field, error) {
+	f := &Field{parent: m}
+	t := p.next()
+	switch {
+	case t.text == "[":
+		// An extension or an expanded Any.
+		f.name = append(f.name, t)
+		for t.text != "]" {
+			if t = p.next(); t.text == "" {
+				return nil, p.errorf(t, "unexpected end of input")
+			}
+			f.name = append(f.name, t)
+		}
+	case t.text != "" && isIdentOrNumberChar(t.text[0]):
+		f.name = []*token{t}
+	default:
+		return nil, p.errorf(t, "expected field name, found %q", t.text)
+	}
+	if p.peek().text == ":" {
+		f.colon = p.next()
+	}
+	typ := f.resolve()
+	v, err := p.readValue(f, typ)
+	if err != nil {
+		return nil, err
+	}
+	f.value = v
+	if s := p.peek().text; s == "," || s == ";" {
+		f.sep = p.next()
+	}
+	return f, nil
+}
+
+// readValue reads a value of field f; typ is the type of its messages.
+func (p *parser) readValue(f *Field, typ reflect.Type) (*value, error) {
+	t := p.next()
+	switch t.text {
+	case "{", "<":
+		end := "}"
+		if t.text == "<" {
+			end = ">"
+		}
+		m := &Message{typ: typ, doc: p.doc, parent: f, open: t}
+		if err := p.readFields(m, end); err != nil {
+			return nil, err
+		}
+		m.close = p.next()
+		return &value{msg: m}, nil
+	case "[":
+		l := &listExpr{open: t}
+		if p.peek().text == "]" {
+			l.close = p.next()
+			return &value{list: l}, nil
+		}
+		for {
+			e, err := p.readValue(f, typ)
+			if err != nil {
+				return nil, err
+			}
+			l.elems = append(l.elems, e)
+			t := p.next()
+			if t.text == "]" {
+				l.close = t
+				return &value{list: l}, nil
+			}
+			if t.text != "," {
+				return nil, p.errorf(t, "expected ']' or ',', found %q", t.text)
+			}
+			l.commas = append(l.commas, t)
+		}
+	case "", "]", "}", ">", ":", ",", ";", "/":
+		return nil, p.errorf(t, "expected value, found %q", t.text)
+	}
+	v := &value{scalar: []*token{t}}
+	for isQuote(t.text[0]) && p.peek().text != "" && isQuote(p.peek().text[0]) {
+		// Adjacent strings are concatenated.
+		v.scalar = append(v.scalar, p.next())
+	}
+	return v, nil
+}
+
+// resolve looks up the descriptor of f in the type of its message and
+// returns the type of the messages f holds, if any.
+func (f *Field) resolve() reflect.Type {
+	m := f.parent
+	if m.typ == nil {
+		return nil
+	}
+	name := f.Name()
+	if strings.HasPrefix(name, "[") {
+		name = strings.Trim(name, `[]"'`)
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			// An expanded Any; name is its type URL.
+			if t := m.doc.reg.MessageType(name[i+1:]); t != nil && t.Kind() == reflect.Ptr {
+				return t
+			}
+			return nil
+		}
+	}
+	r := proto.Reflect(reflect.Zero(m.typ).Interface().(proto.Message))
+	if f.fd = r.FieldByName(name); f.fd == nil {
+		return nil
+	}
+	switch t := reflect.TypeOf(r.NewField(f.fd)); {
+	case t.Kind() == reflect.Ptr:
+		return t
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Ptr:
+		return t.Elem()
+	}
+	// Map entries have no generated type.
+	return nil
+}
+
+// parseFields parses s as the fields of a message of type typ.
+func parseFields(s string, typ reflect.Type, doc *Document) (*Message, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks, doc: doc}
+	m := &Message{typ: typ, doc: doc}
+	if err := p.readFields(m, ""); err != nil {
+		return nil, err
+	}
+	m.close = p.next()
+	return m, nil
+}
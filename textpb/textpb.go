@@ -0,0 +1,703 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package textpb edits protocol buffer text format files without losing their
+comments and layout.
+
+Parse reads a file into a Document, a syntax tree that keeps every comment,
+blank line and bracket style of the input. Fields are looked up, set,
+appended and deleted by name through the Document's messages, which know
+the Go type of the message they describe and so format new values the way
+proto.MarshalText would. Writing the Document back out reproduces the parts
+that were not edited byte for byte:
+
+	doc, err := textpb.Parse(data, &pb.Config{})
+	if err != nil {
+		return err
+	}
+	root := doc.Root()
+	if err := root.Set("version", int32(4)); err != nil {
+		return err
+	}
+	if err := root.Append("hosts", "db3.example.com"); err != nil {
+		return err
+	}
+	data = doc.Bytes()
+
+Comments directly above a field belong to it and go away with it when it
+is deleted; a comment after a value on the same line stays with the field
+when its value is set.
+*/
+package textpb
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A Document is a text format message that keeps the layout it was
+// parsed with.
+type Document struct {
+	root   *Message
+	reg    *proto.Registry // resolves expanded Any types; nil means proto.GlobalRegistry
+	braces bool            // write new message fields with {} rather than <>
+	colon  bool            // write a colon between the name and the value of new message fields
+}
+
+// Parse parses b, which must be text format for a message of the type of
+// pb, into a Document. pb itself is not modified. If pb is nil, the
+// Document is untyped: its fields can be looked up, deleted and commented,
+// but not set.
+//
+// Missing required fields are not an error, so that partial configuration
+// files can be edited.
+func Parse(b []byte, pb proto.Message) (*Document, error) {
+	return ParseFrom(proto.GlobalRegistry, b, pb)
+}
+
+// ParseFrom is like Parse but resolves the message types of expanded
+// google.protobuf.Any values in reg rather than in proto.GlobalRegistry,
+// both when parsing and when the Document is edited or unmarshaled.
+func ParseFrom(reg *proto.Registry, b []byte, pb proto.Message) (*Document, error) {
+	var typ reflect.Type
+	if pb != nil {
+		typ = reflect.TypeOf(pb)
+		tu := proto.TextUnmarshaler{AllowPartial: true, Registry: reg}
+		if err := tu.Unmarshal(string(b), reflect.New(typ.Elem()).Interface().(proto.Message)); err != nil {
+			return nil, err
+		}
+	}
+	d := &Document{reg: reg, colon: true}
+	root, err := parseFields(string(b), typ, d)
+	if err != nil {
+		return nil, err
+	}
+	d.root = root
+
+	// New message fields follow the style of the existing ones.
+	var msgs, colons, angles int
+	var walk func(m *Message)
+	walk = func(m *Message) {
+		for _, f := range m.fields {
+			if v := f.value; v.msg != nil {
+				msgs++
+				if f.colon != nil {
+					colons++
+				}
+				if v.msg.open.text == "<" {
+					angles++
+				}
+				walk(v.msg)
+			}
+		}
+	}
+	walk(root)
+	if msgs > 0 {
+		d.braces = angles == 0
+		d.colon = colons > 0
+	}
+	return d, nil
+}
+
+// Root returns the top-level message of d.
+func (d *Document) Root() *Message { return d.root }
+
+// Bytes returns the text of d.
+func (d *Document) Bytes() []byte {
+	var b bytes.Buffer
+	d.root.write(&b)
+	return b.Bytes()
+}
+
+// String returns the text of d.
+func (d *Document) String() string { return string(d.Bytes()) }
+
+// Unmarshal parses the text of d into pb, like proto.UnmarshalText.
+func (d *Document) Unmarshal(pb proto.Message) error {
+	tu := proto.TextUnmarshaler{Registry: d.reg}
+	return tu.Unmarshal(d.String(), pb)
+}
+
+// A Message is the body of a message in a Document: the top-level message,
+// or the value of a message or group field.
+type Message struct {
+	typ    reflect.Type // the Go type of the message, or nil if unknown
+	doc    *Document
+	parent *Field // the field holding the message; nil at the top level
+	open   *token // '{' or '<'; nil at the top level
+	fields []*Field
+	close  *token // '}' or '>'; the end of input at the top level
+}
+
+// A Field is one field of a Message as written in the text: its name, its
+// value, and the comments around it. A repeated field may be written more
+// than once in a message, each occurrence being a separate Field.
+type Field struct {
+	name   []*token // the name, or the tokens from '[' to ']'
+	colon  *token   // nil if there is no colon
+	value  *value
+	sep    *token           // ',' or ';' after the value, or nil
+	fd     *proto.FieldDesc // nil if the field is unknown
+	parent *Message
+}
+
+// Fields returns the fields of m in the order they are written.
+func (m *Message) Fields() []*Field {
+	return append([]*Field(nil), m.fields...)
+}
+
+// Lookup returns the fields of m with the given name, in the order they are
+// written. Extensions are named as in the text, like "[pkg.ext]".
+func (m *Message) Lookup(name string) []*Field {
+	var fs []*Field
+	for _, f := range m.fields {
+		if f.Name() == name {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// Set sets the field name to v, which has the type proto.Reflect uses for
+// the field: a scalar, a pointer to a message, a slice for a repeated field
+// or a map for a map field. Scalars of another Go type, and the elements of
+// a slice, are converted as Append converts them, so Set("count", 7) sets
+// an int32 field. A singular field that is already written keeps its place
+// and comments; a new one is added at the end of m. A repeated or map field
+// is replaced as a whole, in the place of its first occurrence. Setting a
+// member of a oneof removes the other members, the first of which it
+// replaces if it is not written yet. Setting a field to a value that the
+// text marshaler would not write, such as a nil message or a proto3 zero
+// value, deletes it.
+func (m *Message) Set(name string, v interface{}) error {
+	fd, err := m.fieldDesc(name)
+	if err != nil {
+		return err
+	}
+	old := m.Lookup(name)
+	gone := old
+	if fd.Oneof != "" {
+		// The other members of the oneof give way to this one.
+		for _, f := range m.fields {
+			if f.fd != nil && f.fd.Oneof == fd.Oneof && f.Name() != name {
+				gone = append(gone, f)
+			}
+		}
+	}
+	v = convert(v, reflect.TypeOf(m.reflect().NewField(fd)))
+	fs, err := m.render(fd, v, len(old) > 0 && old[0].value.list != nil)
+	if err != nil {
+		return err
+	}
+
+	if !fd.Repeated && !fd.Map && len(old) == 1 && len(fs) == 1 {
+		old[0].setValue(fs[0].value)
+		for _, f := range gone[1:] {
+			m.remove(f)
+		}
+		return nil
+	}
+	at := len(m.fields)
+	if len(gone) > 0 {
+		at = m.index(gone[0])
+		if len(fs) > 0 {
+			fs[0].name[0].space = gone[0].name[0].space
+		}
+	}
+	m.insert(at, fs...)
+	for _, f := range gone {
+		m.remove(f)
+	}
+	return nil
+}
+
+// Append adds v as a new element of the repeated field name. If the field
+// is already written, v goes after its last occurrence, inside the list if
+// that is written as one; otherwise it is added at the end of m. A scalar
+// of another Go type is converted if the field can hold it, like 7 for an
+// int32 field or int32(2) for an enum field.
+func (m *Message) Append(name string, v interface{}) error {
+	fd, err := m.fieldDesc(name)
+	if err != nil {
+		return err
+	}
+	if !fd.Repeated {
+		return fmt.Errorf("textpb: Append to non-repeated field %s", name)
+	}
+	st := reflect.TypeOf(m.reflect().NewField(fd))
+	ev := reflect.ValueOf(v)
+	if !ev.IsValid() {
+		return fmt.Errorf("textpb: cannot append nil to field %s", name)
+	}
+	cv, ok := convertValue(ev, st.Elem())
+	if !ok {
+		return fmt.Errorf("textpb: cannot append %v to field %s of type %v", ev.Type(), name, st)
+	}
+	ev = cv
+	sl := reflect.Append(reflect.MakeSlice(st, 0, 1), ev).Interface()
+
+	at := len(m.fields)
+	old := m.Lookup(name)
+	if len(old) > 0 {
+		last := old[len(old)-1]
+		at = m.index(last) + 1
+		if l := last.value.list; l != nil {
+			fs, err := m.render(fd, sl, true)
+			if err != nil {
+				return err
+			}
+			if len(fs) == 1 && fs[0].value.list != nil {
+				l.append(fs[0].value.list.elems[0])
+				fs[0].value.list.elems[0].adopt(last)
+				return nil
+			}
+		}
+	}
+	fs, err := m.render(fd, sl, false)
+	if err != nil {
+		return err
+	}
+	m.insert(at, fs...)
+	return nil
+}
+
+// Delete removes every occurrence of the field name from m, together with
+// the comments directly above each, and reports how many there were.
+func (m *Message) Delete(name string) int {
+	fs := m.Lookup(name)
+	for _, f := range fs {
+		m.remove(f)
+	}
+	return len(fs)
+}
+
+// Message returns the value of the singular message field name, adding an
+// empty message at the end of m if the field is not written.
+func (m *Message) Message(name string) (*Message, error) {
+	if fs := m.Lookup(name); len(fs) > 0 {
+		if msg := fs[len(fs)-1].value.msg; msg != nil {
+			return msg, nil
+		}
+		return nil, fmt.Errorf("textpb: field %s is not a message", name)
+	}
+	fd, err := m.fieldDesc(name)
+	if err != nil {
+		return nil, err
+	}
+	v := m.reflect().NewField(fd)
+	if fd.Repeated || fd.Map || reflect.TypeOf(v).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("textpb: field %s is not a singular message", name)
+	}
+	if err := m.Set(name, v); err != nil {
+		return nil, err
+	}
+	fs := m.Lookup(name)
+	return fs[len(fs)-1].value.msg, nil
+}
+
+// Name returns the name of f as written, like "count" or "[pkg.ext]".
+func (f *Field) Name() string {
+	if len(f.name) == 1 {
+		return f.name[0].text
+	}
+	var b bytes.Buffer
+	for _, t := range f.name {
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// Text returns the value of f as written, without the comments around it.
+func (f *Field) Text() string {
+	var b bytes.Buffer
+	f.value.write(&b)
+	s := b.String()
+	return s[len(f.value.first().space) : len(s)-len(f.value.last().trail)]
+}
+
+// Message returns the value of f if it is a message or group, or nil.
+func (f *Field) Message() *Message { return f.value.msg }
+
+// Value returns the value of f as proto.Reflect would report it. For a
+// repeated field that is the slice of the elements in this occurrence.
+func (f *Field) Value() (interface{}, error) {
+	m := f.parent
+	if f.fd == nil {
+		return nil, fmt.Errorf("textpb: unknown field %s", f.Name())
+	}
+	var b bytes.Buffer
+	f.write(&b)
+	pb := reflect.New(m.typ.Elem()).Interface().(proto.Message)
+	tu := proto.TextUnmarshaler{AllowPartial: true}
+	if err := tu.Unmarshal(b.String(), pb); err != nil {
+		return nil, err
+	}
+	return proto.Reflect(pb).Get(f.fd), nil
+}
+
+// Comments returns the lines of the '#' comments directly above f, without
+// the '#' and the space after it.
+func (f *Field) Comments() []string {
+	lines := strings.Split(f.name[0].space, "\n")
+	n := len(lines) - 1 // lines[n] is the indentation of f
+	k := commentStart(lines)
+	var cs []string
+	for _, l := range lines[k:n] {
+		l = strings.TrimPrefix(strings.TrimSpace(l), "#")
+		cs = append(cs, strings.TrimPrefix(l, " "))
+	}
+	return cs
+}
+
+// SetComments replaces the comments directly above f with the given lines,
+// each written as a '#' comment.
+func (f *Field) SetComments(lines ...string) {
+	old := strings.Split(f.name[0].space, "\n")
+	k := commentStart(old)
+	indent := f.indent()
+	var b bytes.Buffer
+	b.WriteString(strings.Join(old[:k], "\n"))
+	if k > 0 || len(old) == 1 && !f.first() {
+		b.WriteByte('\n')
+	}
+	for _, l := range lines {
+		b.WriteString(indent)
+		b.WriteString(strings.TrimRight("# "+l, " "))
+		b.WriteByte('\n')
+	}
+	b.WriteString(indent)
+	f.name[0].space = b.String()
+}
+
+// commentStart returns the index of the first of the comment lines that end
+// just before the last element of lines.
+func commentStart(lines []string) int {
+	k := len(lines) - 1
+	for k > 0 && strings.HasPrefix(strings.TrimSpace(lines[k-1]), "#") {
+		k--
+	}
+	return k
+}
+
+// first reports whether f is the first token of its document.
+func (f *Field) first() bool {
+	return f.parent.parent == nil && len(f.parent.fields) > 0 && f.parent.fields[0] == f
+}
+
+// indent returns the indentation of the line f starts on.
+func (f *Field) indent() string {
+	s := f.name[0].space
+	if i := strings.LastIndex(s, "\n"); i >= 0 {
+		return s[i+1:]
+	}
+	return f.parent.indent()
+}
+
+// setValue replaces the value of f by v, keeping the comments and
+// the bracket style of the old value.
+func (f *Field) setValue(v *value) {
+	old := f.value
+	v.first().space = old.first().space
+	if v.last().trail == "" {
+		v.last().trail = old.last().trail
+	}
+	if old.msg != nil && v.msg != nil {
+		v.msg.open.text, v.msg.close.text = old.msg.open.text, old.msg.close.text
+	}
+	v.adopt(f)
+	f.value = v
+}
+
+// append adds e at the end of l, after a comma.
+func (l *listExpr) append(e *value) {
+	if len(l.elems) == 0 {
+		e.first().space = ""
+		l.elems = append(l.elems, e)
+		return
+	}
+	prev := l.elems[len(l.elems)-1]
+	comma := &token{text: ",", trail: prev.last().trail}
+	prev.last().trail = ""
+	if s := prev.first().space; strings.Contains(s, "\n") {
+		e.first().space = s[strings.LastIndex(s, "\n"):]
+	} else {
+		e.first().space = " "
+	}
+	l.commas = append(l.commas, comma)
+	l.elems = append(l.elems, e)
+}
+
+// fieldDesc returns the descriptor of the field name of m.
+func (m *Message) fieldDesc(name string) (*proto.FieldDesc, error) {
+	if m.typ == nil {
+		return nil, fmt.Errorf("textpb: cannot set field %s of a message of unknown type", name)
+	}
+	var fd *proto.FieldDesc
+	if strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]") {
+		if fd = m.reflect().FieldByName(name[1 : len(name)-1]); fd != nil && fd.Extension == nil {
+			fd = nil
+		}
+	} else {
+		fd = m.reflect().FieldByName(name)
+	}
+	if fd == nil {
+		return nil, fmt.Errorf("textpb: unknown field %s in %v", name, m.typ.Elem())
+	}
+	return fd, nil
+}
+
+// convert returns v converted to t, the type proto.Reflect uses for a
+// field, by the rules of convertValue; a slice is converted element by
+// element. If v cannot be converted, it is returned unchanged for
+// proto.Reflect to reject.
+func convert(v interface{}, t reflect.Type) interface{} {
+	ev := reflect.ValueOf(v)
+	if !ev.IsValid() || ev.Type() == t {
+		return v
+	}
+	if ev.Kind() == reflect.Slice && t.Kind() == reflect.Slice {
+		sl := reflect.MakeSlice(t, ev.Len(), ev.Len())
+		for i := 0; i < ev.Len(); i++ {
+			e, ok := convertValue(ev.Index(i), t.Elem())
+			if !ok {
+				return v
+			}
+			sl.Index(i).Set(e)
+		}
+		return sl.Interface()
+	}
+	if cv, ok := convertValue(ev, t); ok {
+		return cv.Interface()
+	}
+	return v
+}
+
+// convertValue converts v to the scalar type t if t can hold it: an integer
+// that t represents exactly, a floating-point number within the range of a
+// floating-point t, or a string or bool of another named type. Values of
+// type t are returned as they are.
+func convertValue(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	if v.Type() == t {
+		return v, true
+	}
+	z := reflect.Zero(t)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x := v.Int()
+		switch t.Kind() {
+		case reflect.Int32, reflect.Int64:
+			if !z.OverflowInt(x) {
+				return v.Convert(t), true
+			}
+		case reflect.Uint32, reflect.Uint64:
+			if x >= 0 && !z.OverflowUint(uint64(x)) {
+				return v.Convert(t), true
+			}
+		case reflect.Float32, reflect.Float64:
+			if f := v.Convert(t); int64(f.Float()) == x {
+				return f, true
+			}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		x := v.Uint()
+		switch t.Kind() {
+		case reflect.Int32, reflect.Int64:
+			if int64(x) >= 0 && !z.OverflowInt(int64(x)) {
+				return v.Convert(t), true
+			}
+		case reflect.Uint32, reflect.Uint64:
+			if !z.OverflowUint(x) {
+				return v.Convert(t), true
+			}
+		case reflect.Float32, reflect.Float64:
+			if f := v.Convert(t); uint64(f.Float()) == x {
+				return f, true
+			}
+		}
+	case reflect.Float32, reflect.Float64:
+		if x := v.Float(); (t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64) && (!z.OverflowFloat(x) || math.IsInf(x, 0)) {
+			return v.Convert(t), true
+		}
+	case reflect.String, reflect.Bool:
+		if t.Kind() == v.Kind() {
+			return v.Convert(t), true
+		}
+	}
+	return v, false
+}
+
+func (m *Message) reflect() *proto.MessageReflection {
+	return proto.Reflect(reflect.Zero(m.typ).Interface().(proto.Message))
+}
+
+// index returns the position of f in m.
+func (m *Message) index(f *Field) int {
+	for i, g := range m.fields {
+		if g == f {
+			return i
+		}
+	}
+	return -1
+}
+
+// remove removes f from m, along with the comments directly above it.
+// Comments separated from f by a blank line, such as a file header or
+// a section heading, stay in place.
+func (m *Message) remove(f *Field) {
+	i := m.index(f)
+	m.fields = append(m.fields[:i], m.fields[i+1:]...)
+	next := m.close
+	if i < len(m.fields) {
+		next = m.fields[i].name[0]
+	}
+	lines := strings.Split(f.name[0].space, "\n")
+	if kept := strings.Join(lines[:commentStart(lines)], "\n"); strings.TrimSpace(kept) != "" {
+		space := next.space
+		if !strings.HasPrefix(space, "\n") {
+			// next was on the line of f; it now starts one of its own.
+			space = "\n" + lines[len(lines)-1] + strings.TrimLeft(space, " \t")
+		}
+		next.space = kept + space
+	} else if i == 0 && m.parent == nil && len(m.fields) > 0 && len(lines) == 1 {
+		// Don't start the document with a blank line.
+		next.space = strings.TrimLeft(next.space, "\n")
+	}
+}
+
+// insert inserts fs into m at position i.
+func (m *Message) insert(i int, fs ...*Field) {
+	if len(fs) == 0 {
+		return
+	}
+	if len(m.fields) == 0 {
+		switch {
+		case m.parent == nil:
+			// Whatever comments the document holds stay above the new fields.
+			fs[0].name[0].space, m.close.space = m.close.space, "\n"
+		case !m.multiline():
+			fs[0].name[0].space = ""
+			if m.close.space == "" {
+				m.close.space = " "
+			}
+		case !strings.Contains(m.close.space, "\n"):
+			// The end of the message goes on a line of its own.
+			m.close.space = "\n" + m.parent.indent()
+		}
+	}
+	m.fields = append(m.fields[:i], append(fs, m.fields[i:]...)...)
+}
+
+// multiline reports whether each field of m is on a line of its own.
+func (m *Message) multiline() bool {
+	for _, f := range m.fields {
+		if strings.Contains(f.name[0].space, "\n") {
+			return true
+		}
+	}
+	switch {
+	case strings.Contains(m.close.space, "\n"):
+		return true
+	case len(m.fields) > 0:
+		return false
+	case m.parent == nil:
+		return true
+	}
+	return m.parent.parent.multiline()
+}
+
+// indent returns the indentation of the fields of m.
+func (m *Message) indent() string {
+	for i := len(m.fields) - 1; i >= 0; i-- {
+		s := m.fields[i].name[0].space
+		if j := strings.LastIndex(s, "\n"); j >= 0 {
+			return s[j+1:]
+		}
+	}
+	if m.parent == nil {
+		return ""
+	}
+	return m.parent.indent() + "  "
+}
+
+// render returns the fields that the text marshaler writes for field fd
+// set to v in an otherwise empty message of m's type, laid out to be
+// inserted into m.
+func (m *Message) render(fd *proto.FieldDesc, v interface{}, short bool) ([]*Field, error) {
+	pb := reflect.New(m.typ.Elem()).Interface().(proto.Message)
+	if err := proto.Reflect(pb).Set(fd, v); err != nil {
+		return nil, err
+	}
+	multiline := m.multiline()
+	tm := proto.TextMarshaler{
+		Compact:          !multiline,
+		ShortRepeated:    short,
+		OmitMessageColon: !m.doc.colon,
+		Registry:         m.doc.reg,
+	}
+	indent := m.indent()
+	sep := " "
+	if multiline {
+		sep = "\n" + indent
+	}
+
+	r, err := parseFields(tm.Text(pb), m.typ, m.doc)
+	if err != nil {
+		return nil, err
+	}
+	var fix func(r *Message)
+	fix = func(r *Message) {
+		for _, f := range r.fields {
+			for _, t := range f.name {
+				t.space = strings.Replace(t.space, "\n", "\n"+indent, -1)
+			}
+			if v := f.value; v.msg != nil {
+				if m.doc.braces && v.msg.open.text == "<" {
+					v.msg.open.text, v.msg.close.text = "{", "}"
+				}
+				v.msg.open.space = strings.Replace(v.msg.open.space, "\n", "\n"+indent, -1)
+				v.msg.close.space = strings.Replace(v.msg.close.space, "\n", "\n"+indent, -1)
+				fix(v.msg)
+			}
+		}
+	}
+	fix(r)
+	for _, f := range r.fields {
+		f.parent = m
+		f.name[0].space = sep
+	}
+	return r.fields, nil
+}
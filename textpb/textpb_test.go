@@ -0,0 +1,425 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2016 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package textpb_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	pb "github.com/golang/protobuf/proto/testdata"
+	"github.com/golang/protobuf/textpb"
+)
+
+const config = `# Service configuration.
+
+count: 1  # bumped by release tooling
+name: "Dave"
+# The pets.
+pet: ["bunny", "kitty"]
+
+inner {
+  host: "footrest.syd"  # primary
+  # port: 80
+}
+others {
+  key: 2
+}
+bikeshed: BLUE
+[testdata.greeting]: "adg"
+# trailing note
+`
+
+func parse(t *testing.T, s string, pb proto.Message) *textpb.Document {
+	doc, err := textpb.Parse([]byte(s), pb)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return doc
+}
+
+func check(t *testing.T, doc *textpb.Document, want string, pb proto.Message) {
+	if got := doc.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if pb == nil {
+		return
+	}
+	if err := doc.Unmarshal(pb); err != nil {
+		t.Errorf("Unmarshal: %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		config,
+		"",
+		"# nothing but a comment",
+		"count:1 inner:<host:\"h\" > pet:\"a\" pet:'b' \"c\"",
+		"count: 1; others < key: 1, inner { host: 'x' } >\n\n\n",
+		"count: 1\n/* 2 unknown bytes */\n13: 4\n",
+	} {
+		doc := parse(t, s, &pb.MyMessage{})
+		if got := doc.String(); got != s {
+			t.Errorf("got\n%s\nwant\n%s", got, s)
+		}
+	}
+
+	want := new(pb.MyMessage)
+	if err := proto.UnmarshalText(config, want); err != nil {
+		t.Fatal(err)
+	}
+	got := new(pb.MyMessage)
+	if err := parse(t, config, want).Unmarshal(got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Unmarshal: got %v, want %v", got, want)
+	}
+}
+
+func TestSet(t *testing.T) {
+	doc := parse(t, config, &pb.MyMessage{})
+	root := doc.Root()
+	v, err := root.Lookup("count")[0].Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Set("count", v.(int32)+1); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Set("quote", "hi"); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Set("pet", []string{"horsey"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Set("bikeshed", pb.MyMessage_RED); err != nil {
+		t.Fatal(err)
+	}
+	inner, err := root.Message("inner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := inner.Set("port", int32(80)); err != nil {
+		t.Fatal(err)
+	}
+	deeper, err := root.Message("we_must_go_deeper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := deeper.Set("leo_finally_won_an_oscar", &pb.InnerMessage{Host: proto.String("oscar")}); err != nil {
+		t.Fatal(err)
+	}
+	check(t, doc, `# Service configuration.
+
+count: 2  # bumped by release tooling
+name: "Dave"
+# The pets.
+pet: ["horsey"]
+
+inner {
+  host: "footrest.syd"  # primary
+  port: 80
+  # port: 80
+}
+others {
+  key: 2
+}
+bikeshed: RED
+[testdata.greeting]: "adg"
+quote: "hi"
+we_must_go_deeper {
+  leo_finally_won_an_oscar {
+    host: "oscar"
+  }
+}
+# trailing note
+`, new(pb.MyMessage))
+
+	if err := root.Set("nonesuch", 1); err == nil {
+		t.Error("Set of unknown field succeeded")
+	}
+	if err := root.Set("count", "one"); err == nil {
+		t.Error("Set of int32 field to a string succeeded")
+	}
+	if err := root.Set("count", 1<<40); err == nil {
+		t.Error("Set of int32 field to an int64 out of range succeeded")
+	}
+}
+
+// Set and Append convert scalars of another Go type by the same rule.
+func TestSetAppendConvert(t *testing.T) {
+	doc := parse(t, "count: 1\n", &pb.MyMessage{})
+	root := doc.Root()
+	if err := root.Set("count", 7); err != nil {
+		t.Errorf("Set of int32 field to an int: %v", err)
+	}
+	if err := root.Set("bikeshed", int32(pb.MyMessage_RED)); err != nil {
+		t.Errorf("Set of enum field to an int32: %v", err)
+	}
+	if err := root.Set("pet", []interface{}{"bunny"}); err == nil {
+		t.Error("Set of string slice to []interface{} succeeded")
+	}
+	doc3 := parse(t, "", &proto3pb.Message{})
+	if err := doc3.Root().Set("key", []int{1, 2}); err != nil {
+		t.Errorf("Set of uint64 slice to []int: %v", err)
+	}
+	if err := doc3.Root().Append("key", 3); err != nil {
+		t.Errorf("Append of int to uint64 field: %v", err)
+	}
+	if err := doc3.Root().Append("key", -1); err == nil {
+		t.Error("Append of negative int to uint64 field succeeded")
+	}
+	check(t, doc, "count: 7\nbikeshed: RED\n", new(pb.MyMessage))
+	check(t, doc3, "key: 1\nkey: 2\nkey: 3\n", new(proto3pb.Message))
+}
+
+func TestAppend(t *testing.T) {
+	doc := parse(t, config, &pb.MyMessage{})
+	root := doc.Root()
+	if err := root.Append("pet", "horsey"); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Append("others", &pb.OtherMessage{Key: proto.Int64(3)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Append("[testdata.greeting]", "easy"); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Append("rep_bytes", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.Append("count", int32(3)); err == nil {
+		t.Error("Append to non-repeated field succeeded")
+	}
+	check(t, doc, `# Service configuration.
+
+count: 1  # bumped by release tooling
+name: "Dave"
+# The pets.
+pet: ["bunny", "kitty", "horsey"]
+
+inner {
+  host: "footrest.syd"  # primary
+  # port: 80
+}
+others {
+  key: 2
+}
+others {
+  key: 3
+}
+bikeshed: BLUE
+[testdata.greeting]: "adg"
+[testdata.greeting]: "easy"
+rep_bytes: "x"
+# trailing note
+`, new(pb.MyMessage))
+}
+
+func TestDelete(t *testing.T) {
+	doc := parse(t, config, &pb.MyMessage{})
+	root := doc.Root()
+	for name, n := range map[string]int{"pet": 1, "others": 1, "name": 1, "quote": 0} {
+		if got := root.Delete(name); got != n {
+			t.Errorf("Delete(%q) = %d, want %d", name, got, n)
+		}
+	}
+	inner, err := root.Message("inner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner.Delete("host") // host is required, so don't unmarshal the result
+	check(t, doc, `# Service configuration.
+
+count: 1  # bumped by release tooling
+
+inner {
+  # port: 80
+}
+bikeshed: BLUE
+[testdata.greeting]: "adg"
+# trailing note
+`, nil)
+
+	// Only the comments attached to a deleted field go with it.
+	for _, tc := range []struct{ name, in, want string }{
+		{"pet", "# head\n\n# about pet\npet: \"p\"\ncount: 1\n", "# head\n\ncount: 1\n"},
+		{"name", "count: 1\n\n# ---- section ----\n\nname: \"n\"\nquote: \"q\"\n", "count: 1\n\n# ---- section ----\n\nquote: \"q\"\n"},
+		{"name", "count: 1\n\n# ---- section ----\n\n# about name\nname: \"n\" quote: \"q\"\n", "count: 1\n\n# ---- section ----\n\nquote: \"q\"\n"},
+		{"quote", "count: 1\n\n# ---- section ----\n\nquote: \"q\"\n", "count: 1\n\n# ---- section ----\n\n"},
+		{"inner", "count: 1\n\n# ---- section ----\ninner {\n  host: \"h\"\n}\nname: \"n\"\n", "count: 1\nname: \"n\"\n"},
+	} {
+		doc := parse(t, tc.in, &pb.MyMessage{})
+		doc.Root().Delete(tc.name)
+		check(t, doc, tc.want, &pb.MyMessage{})
+	}
+}
+
+func TestComments(t *testing.T) {
+	doc := parse(t, config, &pb.MyMessage{})
+	root := doc.Root()
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"count", nil},
+		{"name", nil},
+		{"pet", []string{"The pets."}},
+		{"inner", nil},
+	}
+	for _, test := range tests {
+		f := root.Lookup(test.name)[0]
+		if got := f.Comments(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: Comments() = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	root.Lookup("count")[0].SetComments()
+	root.Lookup("name")[0].SetComments("Who we are.", "")
+	root.Lookup("pet")[0].SetComments("Pets,", "in order.")
+	inner, _ := root.Message("inner")
+	inner.Lookup("host")[0].SetComments("The host.")
+	if got, want := root.Lookup("inner")[0].Text(), "{\n  # The host.\n  host: \"footrest.syd\"  # primary\n  # port: 80\n}"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+	check(t, doc, `# Service configuration.
+
+count: 1  # bumped by release tooling
+# Who we are.
+#
+name: "Dave"
+# Pets,
+# in order.
+pet: ["bunny", "kitty"]
+
+inner {
+  # The host.
+  host: "footrest.syd"  # primary
+  # port: 80
+}
+others {
+  key: 2
+}
+bikeshed: BLUE
+[testdata.greeting]: "adg"
+# trailing note
+`, new(pb.MyMessage))
+}
+
+func TestOneof(t *testing.T) {
+	doc := parse(t, "make_me_cry: true\n# The number.\nnumber: 5  # five\n", &pb.Communique{})
+	if err := doc.Root().Set("name", "five"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, doc, "make_me_cry: true\n# The number.\nname: \"five\"\n", new(pb.Communique))
+}
+
+func TestNewDocument(t *testing.T) {
+	for _, test := range []struct {
+		in, want string
+	}{
+		{"", "count: 1\ninner: <\n  host: \"h\"\n>\n"},
+		{"# header\n", "# header\ncount: 1\ninner: <\n  host: \"h\"\n>\n"},
+		{"inner:<> ", "inner:<host:\"h\" > count:1 "},
+	} {
+		doc := parse(t, test.in, &pb.MyMessage{})
+		root := doc.Root()
+		if err := root.Set("count", int32(1)); err != nil {
+			t.Fatal(err)
+		}
+		inner, err := root.Message("inner")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := inner.Set("host", "h"); err != nil {
+			t.Fatal(err)
+		}
+		check(t, doc, test.want, new(pb.MyMessage))
+	}
+}
+
+func TestParseFrom(t *testing.T) {
+	reg := proto.NewRegistry()
+	if err := reg.RegisterType(&pb.OtherMessage{}, "textpb.Other"); err != nil {
+		t.Fatal(err)
+	}
+	const in = "anything {\n  [type.googleapis.com/textpb.Other] {\n    key: 2\n  }\n}\n"
+	if _, err := textpb.Parse([]byte(in), &proto3pb.Message{}); err == nil {
+		t.Error("Parse of an Any of a type only in reg succeeded")
+	}
+	doc, err := textpb.ParseFrom(reg, []byte(in), &proto3pb.Message{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	any, err := doc.Root().Message("anything")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := any.Message("[type.googleapis.com/textpb.Other]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Set("key", int64(3)); err != nil {
+		t.Fatal(err)
+	}
+	check(t, doc, strings.Replace(in, "2", "3", 1), new(proto3pb.Message))
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"cont: 1",
+		"count: 1 inner {",
+		"count: \"1",
+		"count: 1 /* 2 unknown bytes",
+		`name: "\0"`,
+	} {
+		if _, err := textpb.Parse([]byte(s), &pb.MyMessage{}); err == nil {
+			t.Errorf("Parse(%q) succeeded", s)
+		}
+	}
+
+	// Without a message type, only the syntax is checked.
+	doc, err := textpb.Parse([]byte("cont: 1\n# about foo\nfoo { bar: 2 }\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.Root().Set("cont", 2); err == nil {
+		t.Error("Set in untyped document succeeded")
+	}
+	if doc.Root().Delete("foo") != 1 || doc.String() != "cont: 1\n" {
+		t.Errorf("Delete in untyped document: got %q", doc.String())
+	}
+}